@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewActivityFinishedV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewActivityFinishedV2(modifiers ...Modifier) (*ActivityFinishedV2, error) {
+	return newActivityFinishedV2(Generator{}, eventTypeTable["EiffelActivityFinishedEvent"][2].latestVersion, modifiers)
+}
+
+// NewActivityFinishedV2 creates a new EiffelActivityFinishedEvent struct pointer like
+// the package-level NewActivityFinishedV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewActivityFinishedV2(modifiers ...Modifier) (*ActivityFinishedV2, error) {
+	return newActivityFinishedV2(f.generator, eventTypeTable["EiffelActivityFinishedEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newActivityFinishedV2 creates a new EiffelActivityFinishedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newActivityFinishedV2(generator Generator, version string, modifiers []Modifier) (*ActivityFinishedV2, error) {
 	var event ActivityFinishedV2
 	event.Meta.Type = "EiffelActivityFinishedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ActivityFinishedV2: %w", err)
@@ -48,33 +61,51 @@ func NewActivityFinishedV2(modifiers ...Modifier) (*ActivityFinishedV2, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ActivityFinishedV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ActFV2Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV2      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ActivityFinishedV2) DeepCopy() *ActivityFinishedV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ActivityFinishedV2) Equal(other *ActivityFinishedV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ActivityFinishedV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ActivityFinishedV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ActivityFinishedV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ActivityFinishedV2) String() string {
 
 var (
 	_ CapabilityTeller = &ActivityFinishedV2{}
+	_ Event            = &ActivityFinishedV2{}
+	_ FieldGetter      = &ActivityFinishedV2{}
 	_ FieldSetter      = &ActivityFinishedV2{}
 	_ MetaTeller       = &ActivityFinishedV2{}
 )
@@ -120,6 +153,47 @@ func (e ActivityFinishedV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ActivityFinishedV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ActivityFinishedV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ActivityFinishedV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ActivityFinishedV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ActivityFinishedV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ActivityFinishedV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ActivityFinishedV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,148 @@ func (e ActivityFinishedV2) SupportsSigning() bool {
 	return false
 }
 
+// ActivityFinishedV2Builder builds EiffelActivityFinishedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildActivityFinishedV2.
+type ActivityFinishedV2Builder struct {
+	event     ActivityFinishedV2
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildActivityFinishedV2 returns a builder for major version 2
+// of EiffelActivityFinishedEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildActivityFinishedV2(modifiers ...Modifier) *ActivityFinishedV2Builder {
+	b := &ActivityFinishedV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelActivityFinishedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildActivityFinishedV2 returns a builder for EiffelActivityFinishedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildActivityFinishedV2 for details.
+func (f *Factory) BuildActivityFinishedV2(modifiers ...Modifier) *ActivityFinishedV2Builder {
+	b := BuildActivityFinishedV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ActivityFinishedV2Builder) CustomData(value ...CustomDataV1) *ActivityFinishedV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// Conclusion sets the data.outcome.conclusion field, which is mandatory.
+func (b *ActivityFinishedV2Builder) Conclusion(value ActFV2DataOutcomeConclusion) *ActivityFinishedV2Builder {
+	b.event.Data.Outcome.Conclusion = value
+	return b
+}
+
+// Description sets the data.outcome.description field.
+func (b *ActivityFinishedV2Builder) Description(value string) *ActivityFinishedV2Builder {
+	b.event.Data.Outcome.Description = value
+	return b
+}
+
+// PersistentLogs sets the data.persistentLogs field.
+func (b *ActivityFinishedV2Builder) PersistentLogs(value ...ActFV2DataPersistentLog) *ActivityFinishedV2Builder {
+	b.event.Data.PersistentLogs = value
+	return b
+}
+
+// ActivityExecution adds a ACTIVITY_EXECUTION link to the target event.
+// At least one such link is required.
+func (b *ActivityFinishedV2Builder) ActivityExecution(target MetaTeller) *ActivityFinishedV2Builder {
+	b.event.Links.Add(LinkType_ActivityExecution, target)
+	return b
+}
+
+// ActivityExecutionByID adds a ACTIVITY_EXECUTION link to the event with the given ID.
+// At least one such link is required.
+func (b *ActivityFinishedV2Builder) ActivityExecutionByID(target string) *ActivityFinishedV2Builder {
+	b.event.Links.AddByID(LinkType_ActivityExecution, target)
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ActivityFinishedV2Builder) Cause(target MetaTeller) *ActivityFinishedV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ActivityFinishedV2Builder) CauseByID(target string) *ActivityFinishedV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ActivityFinishedV2Builder) Context(target MetaTeller) *ActivityFinishedV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ActivityFinishedV2Builder) ContextByID(target string) *ActivityFinishedV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ActivityFinishedV2Builder) FlowContext(target MetaTeller) *ActivityFinishedV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ActivityFinishedV2Builder) FlowContextByID(target string) *ActivityFinishedV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildActivityFinishedV2.
+func (b *ActivityFinishedV2Builder) With(modifiers ...Modifier) *ActivityFinishedV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ActivityFinishedV2Builder) Build() (*ActivityFinishedV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ActivityFinishedV2: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ActivityFinishedV2 struct {
 	// Mandatory fields
 	Data  ActFV2Data   `json:"data"`
@@ -135,6 +351,131 @@ type ActivityFinishedV2 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActivityFinishedV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActivityFinishedV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActivityFinishedV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActivityFinishedV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActivityFinishedV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActivityFinishedV2) deepCopy() ActivityFinishedV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActivityFinishedV2) equal(other *ActivityFinishedV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActivityFinishedV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActivityFinishedV2) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ActFV2Data struct {
@@ -146,6 +487,132 @@ type ActFV2Data struct {
 	PersistentLogs []ActFV2DataPersistentLog `json:"persistentLogs,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActFV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"outcome":`...)
+	if b, err = s.Outcome.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.PersistentLogs) != 0 {
+		b = append(b, `,"persistentLogs":`...)
+		if b, err = appendJSONArray(b, s.PersistentLogs, (*ActFV2DataPersistentLog).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActFV2Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.Outcome.isEmptyJSON() &&
+		len(s.PersistentLogs) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActFV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActFV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActFV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "outcome":
+		return true, s.Outcome.decodeJSON(member, opts)
+	case "persistentLogs":
+		return true, decodeJSONArray(member, &s.PersistentLogs, "[]eiffelevents.ActFV2DataPersistentLog", opts, (*ActFV2DataPersistentLog).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "customData", "outcome", "persistentLogs"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActFV2Data) deepCopy() ActFV2Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Outcome = s.Outcome.deepCopy()
+	c.PersistentLogs = deepCopySlice(s.PersistentLogs, (*ActFV2DataPersistentLog).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActFV2Data) equal(other *ActFV2Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.Outcome.equal(&other.Outcome) &&
+		equalSlices(s.PersistentLogs, other.PersistentLogs, (*ActFV2DataPersistentLog).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActFV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActFV2Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	s.Outcome.validate(pointer+"/outcome", problems)
+	if len(s.PersistentLogs) != 0 {
+		for i := range s.PersistentLogs {
+			elem := &s.PersistentLogs[i]
+			elem.validate(indexPointer(pointer+"/persistentLogs", i), problems)
+		}
+	}
+}
+
 type ActFV2DataOutcome struct {
 	// Mandatory fields
 	Conclusion ActFV2DataOutcomeConclusion `json:"conclusion"`
@@ -154,6 +621,109 @@ type ActFV2DataOutcome struct {
 	Description string `json:"description,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActFV2DataOutcome) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"conclusion":`...)
+	b = appendJSONString(b, string(s.Conclusion))
+	if s.Description != "" {
+		b = append(b, `,"description":`...)
+		b = appendJSONString(b, s.Description)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActFV2DataOutcome) isEmptyJSON() bool {
+	return s.Conclusion == "" &&
+		s.Description == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActFV2DataOutcome) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActFV2DataOutcome")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActFV2DataOutcome) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "conclusion":
+		return true, decodeJSONString(member, (*string)(&s.Conclusion), "eiffelevents.ActFV2DataOutcomeConclusion")
+	case "description":
+		return true, decodeJSONString(member, &s.Description, "string")
+	}
+	if folded, ok := foldJSONName(name, "conclusion", "description"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActFV2DataOutcome) deepCopy() ActFV2DataOutcome {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActFV2DataOutcome) equal(other *ActFV2DataOutcome) bool {
+	return s.Conclusion == other.Conclusion &&
+		s.Description == other.Description
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActFV2DataOutcome) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActFV2DataOutcome) validate(pointer string, problems *[]FieldError) {
+	if s.Conclusion == "" {
+		addFieldProblem(problems, pointer+"/conclusion", "mandatory field is missing")
+	} else {
+		if !s.Conclusion.IsValid() {
+			addFieldProblem(problems, pointer+"/conclusion", "%q is not a valid %s value", string(s.Conclusion), "ActFV2DataOutcomeConclusion")
+		}
+	}
+}
+
 type ActFV2DataOutcomeConclusion string
 
 const (
@@ -165,6 +735,15 @@ const (
 	ActFV2DataOutcomeConclusion_Inconclusive ActFV2DataOutcomeConclusion = "INCONCLUSIVE"
 )
 
+// IsValid returns true if the value is one of the defined ActFV2DataOutcomeConclusion values.
+func (e ActFV2DataOutcomeConclusion) IsValid() bool {
+	switch e {
+	case ActFV2DataOutcomeConclusion_Successful, ActFV2DataOutcomeConclusion_Unsuccessful, ActFV2DataOutcomeConclusion_Failed, ActFV2DataOutcomeConclusion_Aborted, ActFV2DataOutcomeConclusion_TimedOut, ActFV2DataOutcomeConclusion_Inconclusive:
+		return true
+	}
+	return false
+}
+
 type ActFV2DataPersistentLog struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -173,3 +752,103 @@ type ActFV2DataPersistentLog struct {
 	// Optional fields
 
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActFV2DataPersistentLog) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"uri":`...)
+	b = appendJSONString(b, s.URI)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActFV2DataPersistentLog) isEmptyJSON() bool {
+	return s.Name == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActFV2DataPersistentLog) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActFV2DataPersistentLog")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActFV2DataPersistentLog) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "name", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActFV2DataPersistentLog) deepCopy() ActFV2DataPersistentLog {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActFV2DataPersistentLog) equal(other *ActFV2DataPersistentLog) bool {
+	return s.Name == other.Name &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActFV2DataPersistentLog) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActFV2DataPersistentLog) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.URI == "" {
+		addFieldProblem(problems, pointer+"/uri", "mandatory field is missing")
+	}
+}
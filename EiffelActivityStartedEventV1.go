@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewActivityStartedV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewActivityStartedV1(modifiers ...Modifier) (*ActivityStartedV1, error) {
+	return newActivityStartedV1(Generator{}, eventTypeTable["EiffelActivityStartedEvent"][1].latestVersion, modifiers)
+}
+
+// NewActivityStartedV1 creates a new EiffelActivityStartedEvent struct pointer like
+// the package-level NewActivityStartedV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewActivityStartedV1(modifiers ...Modifier) (*ActivityStartedV1, error) {
+	return newActivityStartedV1(f.generator, eventTypeTable["EiffelActivityStartedEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newActivityStartedV1 creates a new EiffelActivityStartedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newActivityStartedV1(generator Generator, version string, modifiers []Modifier) (*ActivityStartedV1, error) {
 	var event ActivityStartedV1
 	event.Meta.Type = "EiffelActivityStartedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ActivityStartedV1: %w", err)
@@ -48,33 +61,51 @@ func NewActivityStartedV1(modifiers ...Modifier) (*ActivityStartedV1, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ActivityStartedV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ActSV1Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ActivityStartedV1) DeepCopy() *ActivityStartedV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ActivityStartedV1) Equal(other *ActivityStartedV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ActivityStartedV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ActivityStartedV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ActivityStartedV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ActivityStartedV1) String() string {
 
 var (
 	_ CapabilityTeller = &ActivityStartedV1{}
+	_ Event            = &ActivityStartedV1{}
+	_ FieldGetter      = &ActivityStartedV1{}
 	_ FieldSetter      = &ActivityStartedV1{}
 	_ MetaTeller       = &ActivityStartedV1{}
 )
@@ -120,6 +153,47 @@ func (e ActivityStartedV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ActivityStartedV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ActivityStartedV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ActivityStartedV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ActivityStartedV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ActivityStartedV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ActivityStartedV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ActivityStartedV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,166 @@ func (e ActivityStartedV1) SupportsSigning() bool {
 	return false
 }
 
+// ActivityStartedV1Builder builds EiffelActivityStartedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildActivityStartedV1.
+type ActivityStartedV1Builder struct {
+	event     ActivityStartedV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildActivityStartedV1 returns a builder for major version 1
+// of EiffelActivityStartedEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildActivityStartedV1(modifiers ...Modifier) *ActivityStartedV1Builder {
+	b := &ActivityStartedV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelActivityStartedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildActivityStartedV1 returns a builder for EiffelActivityStartedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildActivityStartedV1 for details.
+func (f *Factory) BuildActivityStartedV1(modifiers ...Modifier) *ActivityStartedV1Builder {
+	b := BuildActivityStartedV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ActivityStartedV1Builder) CustomData(value ...CustomDataV1) *ActivityStartedV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ExecutionURI sets the data.executionUri field.
+func (b *ActivityStartedV1Builder) ExecutionURI(value string) *ActivityStartedV1Builder {
+	b.event.Data.ExecutionURI = value
+	return b
+}
+
+// LiveLogs sets the data.liveLogs field.
+func (b *ActivityStartedV1Builder) LiveLogs(value ...ActSV1DataLiveLog) *ActivityStartedV1Builder {
+	b.event.Data.LiveLogs = value
+	return b
+}
+
+// ActivityExecution adds a ACTIVITY_EXECUTION link to the target event.
+// At least one such link is required.
+func (b *ActivityStartedV1Builder) ActivityExecution(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_ActivityExecution, target)
+	return b
+}
+
+// ActivityExecutionByID adds a ACTIVITY_EXECUTION link to the event with the given ID.
+// At least one such link is required.
+func (b *ActivityStartedV1Builder) ActivityExecutionByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_ActivityExecution, target)
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ActivityStartedV1Builder) Cause(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ActivityStartedV1Builder) CauseByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ActivityStartedV1Builder) Context(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ActivityStartedV1Builder) ContextByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ActivityStartedV1Builder) FlowContext(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ActivityStartedV1Builder) FlowContextByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousActivityExecution adds a PREVIOUS_ACTIVITY_EXECUTION link to the target event.
+func (b *ActivityStartedV1Builder) PreviousActivityExecution(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_PreviousActivityExecution, target)
+	return b
+}
+
+// PreviousActivityExecutionByID adds a PREVIOUS_ACTIVITY_EXECUTION link to the event with the given ID.
+func (b *ActivityStartedV1Builder) PreviousActivityExecutionByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_PreviousActivityExecution, target)
+	return b
+}
+
+// RuntimeEnvironment adds a RUNTIME_ENVIRONMENT link to the target event.
+func (b *ActivityStartedV1Builder) RuntimeEnvironment(target MetaTeller) *ActivityStartedV1Builder {
+	b.event.Links.Add(LinkType_RuntimeEnvironment, target)
+	return b
+}
+
+// RuntimeEnvironmentByID adds a RUNTIME_ENVIRONMENT link to the event with the given ID.
+func (b *ActivityStartedV1Builder) RuntimeEnvironmentByID(target string) *ActivityStartedV1Builder {
+	b.event.Links.AddByID(LinkType_RuntimeEnvironment, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildActivityStartedV1.
+func (b *ActivityStartedV1Builder) With(modifiers ...Modifier) *ActivityStartedV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ActivityStartedV1Builder) Build() (*ActivityStartedV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ActivityStartedV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ActivityStartedV1 struct {
 	// Mandatory fields
 	Data  ActSV1Data   `json:"data"`
@@ -135,6 +369,131 @@ type ActivityStartedV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActivityStartedV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActivityStartedV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActivityStartedV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActivityStartedV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActivityStartedV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActivityStartedV1) deepCopy() ActivityStartedV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActivityStartedV1) equal(other *ActivityStartedV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActivityStartedV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActivityStartedV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ActSV1Data struct {
@@ -146,6 +505,130 @@ type ActSV1Data struct {
 	LiveLogs     []ActSV1DataLiveLog `json:"liveLogs,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActSV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.ExecutionURI != "" {
+		b = append(b, `,"executionUri":`...)
+		b = appendJSONString(b, s.ExecutionURI)
+	}
+	if len(s.LiveLogs) != 0 {
+		b = append(b, `,"liveLogs":`...)
+		if b, err = appendJSONArray(b, s.LiveLogs, (*ActSV1DataLiveLog).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActSV1Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.ExecutionURI == "" &&
+		len(s.LiveLogs) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActSV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActSV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActSV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "executionUri":
+		return true, decodeJSONString(member, &s.ExecutionURI, "string")
+	case "liveLogs":
+		return true, decodeJSONArray(member, &s.LiveLogs, "[]eiffelevents.ActSV1DataLiveLog", opts, (*ActSV1DataLiveLog).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "customData", "executionUri", "liveLogs"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActSV1Data) deepCopy() ActSV1Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.LiveLogs = deepCopySlice(s.LiveLogs, (*ActSV1DataLiveLog).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActSV1Data) equal(other *ActSV1Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ExecutionURI == other.ExecutionURI &&
+		equalSlices(s.LiveLogs, other.LiveLogs, (*ActSV1DataLiveLog).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActSV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActSV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if len(s.LiveLogs) != 0 {
+		for i := range s.LiveLogs {
+			elem := &s.LiveLogs[i]
+			elem.validate(indexPointer(pointer+"/liveLogs", i), problems)
+		}
+	}
+}
+
 type ActSV1DataLiveLog struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -154,3 +637,103 @@ type ActSV1DataLiveLog struct {
 	// Optional fields
 
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActSV1DataLiveLog) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"uri":`...)
+	b = appendJSONString(b, s.URI)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActSV1DataLiveLog) isEmptyJSON() bool {
+	return s.Name == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActSV1DataLiveLog) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActSV1DataLiveLog")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActSV1DataLiveLog) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "name", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActSV1DataLiveLog) deepCopy() ActSV1DataLiveLog {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActSV1DataLiveLog) equal(other *ActSV1DataLiveLog) bool {
+	return s.Name == other.Name &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActSV1DataLiveLog) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActSV1DataLiveLog) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.URI == "" {
+		addFieldProblem(problems, pointer+"/uri", "mandatory field is missing")
+	}
+}
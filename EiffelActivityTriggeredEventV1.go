@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewActivityTriggeredV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewActivityTriggeredV1(modifiers ...Modifier) (*ActivityTriggeredV1, error) {
+	return newActivityTriggeredV1(Generator{}, eventTypeTable["EiffelActivityTriggeredEvent"][1].latestVersion, modifiers)
+}
+
+// NewActivityTriggeredV1 creates a new EiffelActivityTriggeredEvent struct pointer like
+// the package-level NewActivityTriggeredV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewActivityTriggeredV1(modifiers ...Modifier) (*ActivityTriggeredV1, error) {
+	return newActivityTriggeredV1(f.generator, eventTypeTable["EiffelActivityTriggeredEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newActivityTriggeredV1 creates a new EiffelActivityTriggeredEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newActivityTriggeredV1(generator Generator, version string, modifiers []Modifier) (*ActivityTriggeredV1, error) {
 	var event ActivityTriggeredV1
 	event.Meta.Type = "EiffelActivityTriggeredEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ActivityTriggeredV1: %w", err)
@@ -48,33 +61,51 @@ func NewActivityTriggeredV1(modifiers ...Modifier) (*ActivityTriggeredV1, error)
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ActivityTriggeredV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ActTV1Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ActivityTriggeredV1) DeepCopy() *ActivityTriggeredV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ActivityTriggeredV1) Equal(other *ActivityTriggeredV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ActivityTriggeredV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ActivityTriggeredV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ActivityTriggeredV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ActivityTriggeredV1) String() string {
 
 var (
 	_ CapabilityTeller = &ActivityTriggeredV1{}
+	_ Event            = &ActivityTriggeredV1{}
+	_ FieldGetter      = &ActivityTriggeredV1{}
 	_ FieldSetter      = &ActivityTriggeredV1{}
 	_ MetaTeller       = &ActivityTriggeredV1{}
 )
@@ -120,6 +153,47 @@ func (e ActivityTriggeredV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ActivityTriggeredV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ActivityTriggeredV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ActivityTriggeredV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ActivityTriggeredV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ActivityTriggeredV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ActivityTriggeredV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ActivityTriggeredV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,140 @@ func (e ActivityTriggeredV1) SupportsSigning() bool {
 	return false
 }
 
+// ActivityTriggeredV1Builder builds EiffelActivityTriggeredEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildActivityTriggeredV1.
+type ActivityTriggeredV1Builder struct {
+	event     ActivityTriggeredV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildActivityTriggeredV1 returns a builder for major version 1
+// of EiffelActivityTriggeredEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildActivityTriggeredV1(modifiers ...Modifier) *ActivityTriggeredV1Builder {
+	b := &ActivityTriggeredV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelActivityTriggeredEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildActivityTriggeredV1 returns a builder for EiffelActivityTriggeredEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildActivityTriggeredV1 for details.
+func (f *Factory) BuildActivityTriggeredV1(modifiers ...Modifier) *ActivityTriggeredV1Builder {
+	b := BuildActivityTriggeredV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Categories sets the data.categories field.
+func (b *ActivityTriggeredV1Builder) Categories(value ...string) *ActivityTriggeredV1Builder {
+	b.event.Data.Categories = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ActivityTriggeredV1Builder) CustomData(value ...CustomDataV1) *ActivityTriggeredV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ExecutionType sets the data.executionType field.
+func (b *ActivityTriggeredV1Builder) ExecutionType(value ActTV1DataExecutionType) *ActivityTriggeredV1Builder {
+	b.event.Data.ExecutionType = value
+	return b
+}
+
+// Name sets the data.name field, which is mandatory.
+func (b *ActivityTriggeredV1Builder) Name(value string) *ActivityTriggeredV1Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// Triggers sets the data.triggers field.
+func (b *ActivityTriggeredV1Builder) Triggers(value ...ActTV1DataTrigger) *ActivityTriggeredV1Builder {
+	b.event.Data.Triggers = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ActivityTriggeredV1Builder) Cause(target MetaTeller) *ActivityTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ActivityTriggeredV1Builder) CauseByID(target string) *ActivityTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ActivityTriggeredV1Builder) Context(target MetaTeller) *ActivityTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ActivityTriggeredV1Builder) ContextByID(target string) *ActivityTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ActivityTriggeredV1Builder) FlowContext(target MetaTeller) *ActivityTriggeredV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ActivityTriggeredV1Builder) FlowContextByID(target string) *ActivityTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildActivityTriggeredV1.
+func (b *ActivityTriggeredV1Builder) With(modifiers ...Modifier) *ActivityTriggeredV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ActivityTriggeredV1Builder) Build() (*ActivityTriggeredV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ActivityTriggeredV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ActivityTriggeredV1 struct {
 	// Mandatory fields
 	Data  ActTV1Data   `json:"data"`
@@ -135,6 +343,131 @@ type ActivityTriggeredV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActivityTriggeredV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActivityTriggeredV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActivityTriggeredV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActivityTriggeredV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActivityTriggeredV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActivityTriggeredV1) deepCopy() ActivityTriggeredV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActivityTriggeredV1) equal(other *ActivityTriggeredV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActivityTriggeredV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActivityTriggeredV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ActTV1Data struct {
@@ -148,6 +481,162 @@ type ActTV1Data struct {
 	Triggers      []ActTV1DataTrigger     `json:"triggers,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActTV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if len(s.Categories) != 0 {
+		b = append(b, `,"categories":`...)
+		if b, err = appendJSONArray(b, s.Categories, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.ExecutionType != "" {
+		b = append(b, `,"executionType":`...)
+		b = appendJSONString(b, string(s.ExecutionType))
+	}
+	if len(s.Triggers) != 0 {
+		b = append(b, `,"triggers":`...)
+		if b, err = appendJSONArray(b, s.Triggers, (*ActTV1DataTrigger).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActTV1Data) isEmptyJSON() bool {
+	return len(s.Categories) == 0 &&
+		len(s.CustomData) == 0 &&
+		s.ExecutionType == "" &&
+		s.Name == "" &&
+		len(s.Triggers) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActTV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActTV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActTV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "categories":
+		return true, decodeJSONArray(member, &s.Categories, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "executionType":
+		return true, decodeJSONString(member, (*string)(&s.ExecutionType), "eiffelevents.ActTV1DataExecutionType")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "triggers":
+		return true, decodeJSONArray(member, &s.Triggers, "[]eiffelevents.ActTV1DataTrigger", opts, (*ActTV1DataTrigger).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "categories", "customData", "executionType", "name", "triggers"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActTV1Data) deepCopy() ActTV1Data {
+	c := *s
+	c.Categories = deepCopySlice(s.Categories, nil)
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Triggers = deepCopySlice(s.Triggers, (*ActTV1DataTrigger).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActTV1Data) equal(other *ActTV1Data) bool {
+	return equalSlices(s.Categories, other.Categories, func(a *string, b *string) bool {
+		return *a == *b
+	}) &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ExecutionType == other.ExecutionType &&
+		s.Name == other.Name &&
+		equalSlices(s.Triggers, other.Triggers, (*ActTV1DataTrigger).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActTV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActTV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.ExecutionType != "" {
+		if !s.ExecutionType.IsValid() {
+			addFieldProblem(problems, pointer+"/executionType", "%q is not a valid %s value", string(s.ExecutionType), "ActTV1DataExecutionType")
+		}
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if len(s.Triggers) != 0 {
+		for i := range s.Triggers {
+			elem := &s.Triggers[i]
+			elem.validate(indexPointer(pointer+"/triggers", i), problems)
+		}
+	}
+}
+
 type ActTV1DataExecutionType string
 
 const (
@@ -157,6 +646,15 @@ const (
 	ActTV1DataExecutionType_Other         ActTV1DataExecutionType = "OTHER"
 )
 
+// IsValid returns true if the value is one of the defined ActTV1DataExecutionType values.
+func (e ActTV1DataExecutionType) IsValid() bool {
+	switch e {
+	case ActTV1DataExecutionType_Manual, ActTV1DataExecutionType_SemiAutomated, ActTV1DataExecutionType_Automated, ActTV1DataExecutionType_Other:
+		return true
+	}
+	return false
+}
+
 type ActTV1DataTrigger struct {
 	// Mandatory fields
 	Type ActTV1DataTriggerType `json:"type"`
@@ -165,6 +663,109 @@ type ActTV1DataTrigger struct {
 	Description string `json:"description,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActTV1DataTrigger) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, string(s.Type))
+	if s.Description != "" {
+		b = append(b, `,"description":`...)
+		b = appendJSONString(b, s.Description)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActTV1DataTrigger) isEmptyJSON() bool {
+	return s.Description == "" &&
+		s.Type == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActTV1DataTrigger) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActTV1DataTrigger")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActTV1DataTrigger) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "description":
+		return true, decodeJSONString(member, &s.Description, "string")
+	case "type":
+		return true, decodeJSONString(member, (*string)(&s.Type), "eiffelevents.ActTV1DataTriggerType")
+	}
+	if folded, ok := foldJSONName(name, "description", "type"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActTV1DataTrigger) deepCopy() ActTV1DataTrigger {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActTV1DataTrigger) equal(other *ActTV1DataTrigger) bool {
+	return s.Description == other.Description &&
+		s.Type == other.Type
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActTV1DataTrigger) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActTV1DataTrigger) validate(pointer string, problems *[]FieldError) {
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	} else {
+		if !s.Type.IsValid() {
+			addFieldProblem(problems, pointer+"/type", "%q is not a valid %s value", string(s.Type), "ActTV1DataTriggerType")
+		}
+	}
+}
+
 type ActTV1DataTriggerType string
 
 const (
@@ -174,3 +775,12 @@ const (
 	ActTV1DataTriggerType_Timer        ActTV1DataTriggerType = "TIMER"
 	ActTV1DataTriggerType_Other        ActTV1DataTriggerType = "OTHER"
 )
+
+// IsValid returns true if the value is one of the defined ActTV1DataTriggerType values.
+func (e ActTV1DataTriggerType) IsValid() bool {
+	switch e {
+	case ActTV1DataTriggerType_Manual, ActTV1DataTriggerType_EiffelEvent, ActTV1DataTriggerType_SourceChange, ActTV1DataTriggerType_Timer, ActTV1DataTriggerType_Other:
+		return true
+	}
+	return false
+}
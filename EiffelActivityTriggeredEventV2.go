@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewActivityTriggeredV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewActivityTriggeredV2(modifiers ...Modifier) (*ActivityTriggeredV2, error) {
+	return newActivityTriggeredV2(Generator{}, eventTypeTable["EiffelActivityTriggeredEvent"][2].latestVersion, modifiers)
+}
+
+// NewActivityTriggeredV2 creates a new EiffelActivityTriggeredEvent struct pointer like
+// the package-level NewActivityTriggeredV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewActivityTriggeredV2(modifiers ...Modifier) (*ActivityTriggeredV2, error) {
+	return newActivityTriggeredV2(f.generator, eventTypeTable["EiffelActivityTriggeredEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newActivityTriggeredV2 creates a new EiffelActivityTriggeredEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newActivityTriggeredV2(generator Generator, version string, modifiers []Modifier) (*ActivityTriggeredV2, error) {
 	var event ActivityTriggeredV2
 	event.Meta.Type = "EiffelActivityTriggeredEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ActivityTriggeredV2: %w", err)
@@ -48,33 +61,51 @@ func NewActivityTriggeredV2(modifiers ...Modifier) (*ActivityTriggeredV2, error)
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ActivityTriggeredV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ActTV2Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV2      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ActivityTriggeredV2) DeepCopy() *ActivityTriggeredV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ActivityTriggeredV2) Equal(other *ActivityTriggeredV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ActivityTriggeredV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ActivityTriggeredV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ActivityTriggeredV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ActivityTriggeredV2) String() string {
 
 var (
 	_ CapabilityTeller = &ActivityTriggeredV2{}
+	_ Event            = &ActivityTriggeredV2{}
+	_ FieldGetter      = &ActivityTriggeredV2{}
 	_ FieldSetter      = &ActivityTriggeredV2{}
 	_ MetaTeller       = &ActivityTriggeredV2{}
 )
@@ -120,6 +153,47 @@ func (e ActivityTriggeredV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ActivityTriggeredV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ActivityTriggeredV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ActivityTriggeredV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ActivityTriggeredV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ActivityTriggeredV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ActivityTriggeredV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ActivityTriggeredV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,140 @@ func (e ActivityTriggeredV2) SupportsSigning() bool {
 	return false
 }
 
+// ActivityTriggeredV2Builder builds EiffelActivityTriggeredEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildActivityTriggeredV2.
+type ActivityTriggeredV2Builder struct {
+	event     ActivityTriggeredV2
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildActivityTriggeredV2 returns a builder for major version 2
+// of EiffelActivityTriggeredEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildActivityTriggeredV2(modifiers ...Modifier) *ActivityTriggeredV2Builder {
+	b := &ActivityTriggeredV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelActivityTriggeredEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildActivityTriggeredV2 returns a builder for EiffelActivityTriggeredEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildActivityTriggeredV2 for details.
+func (f *Factory) BuildActivityTriggeredV2(modifiers ...Modifier) *ActivityTriggeredV2Builder {
+	b := BuildActivityTriggeredV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Categories sets the data.categories field.
+func (b *ActivityTriggeredV2Builder) Categories(value ...string) *ActivityTriggeredV2Builder {
+	b.event.Data.Categories = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ActivityTriggeredV2Builder) CustomData(value ...CustomDataV1) *ActivityTriggeredV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ExecutionType sets the data.executionType field.
+func (b *ActivityTriggeredV2Builder) ExecutionType(value ActTV2DataExecutionType) *ActivityTriggeredV2Builder {
+	b.event.Data.ExecutionType = value
+	return b
+}
+
+// Name sets the data.name field, which is mandatory.
+func (b *ActivityTriggeredV2Builder) Name(value string) *ActivityTriggeredV2Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// Triggers sets the data.triggers field.
+func (b *ActivityTriggeredV2Builder) Triggers(value ...ActTV2DataTrigger) *ActivityTriggeredV2Builder {
+	b.event.Data.Triggers = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ActivityTriggeredV2Builder) Cause(target MetaTeller) *ActivityTriggeredV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ActivityTriggeredV2Builder) CauseByID(target string) *ActivityTriggeredV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ActivityTriggeredV2Builder) Context(target MetaTeller) *ActivityTriggeredV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ActivityTriggeredV2Builder) ContextByID(target string) *ActivityTriggeredV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ActivityTriggeredV2Builder) FlowContext(target MetaTeller) *ActivityTriggeredV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ActivityTriggeredV2Builder) FlowContextByID(target string) *ActivityTriggeredV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildActivityTriggeredV2.
+func (b *ActivityTriggeredV2Builder) With(modifiers ...Modifier) *ActivityTriggeredV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ActivityTriggeredV2Builder) Build() (*ActivityTriggeredV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ActivityTriggeredV2: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ActivityTriggeredV2 struct {
 	// Mandatory fields
 	Data  ActTV2Data   `json:"data"`
@@ -135,6 +343,131 @@ type ActivityTriggeredV2 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActivityTriggeredV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActivityTriggeredV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActivityTriggeredV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActivityTriggeredV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActivityTriggeredV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActivityTriggeredV2) deepCopy() ActivityTriggeredV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActivityTriggeredV2) equal(other *ActivityTriggeredV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActivityTriggeredV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActivityTriggeredV2) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ActTV2Data struct {
@@ -148,6 +481,162 @@ type ActTV2Data struct {
 	Triggers      []ActTV2DataTrigger     `json:"triggers,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActTV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if len(s.Categories) != 0 {
+		b = append(b, `,"categories":`...)
+		if b, err = appendJSONArray(b, s.Categories, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.ExecutionType != "" {
+		b = append(b, `,"executionType":`...)
+		b = appendJSONString(b, string(s.ExecutionType))
+	}
+	if len(s.Triggers) != 0 {
+		b = append(b, `,"triggers":`...)
+		if b, err = appendJSONArray(b, s.Triggers, (*ActTV2DataTrigger).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActTV2Data) isEmptyJSON() bool {
+	return len(s.Categories) == 0 &&
+		len(s.CustomData) == 0 &&
+		s.ExecutionType == "" &&
+		s.Name == "" &&
+		len(s.Triggers) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActTV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActTV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActTV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "categories":
+		return true, decodeJSONArray(member, &s.Categories, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "executionType":
+		return true, decodeJSONString(member, (*string)(&s.ExecutionType), "eiffelevents.ActTV2DataExecutionType")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "triggers":
+		return true, decodeJSONArray(member, &s.Triggers, "[]eiffelevents.ActTV2DataTrigger", opts, (*ActTV2DataTrigger).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "categories", "customData", "executionType", "name", "triggers"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActTV2Data) deepCopy() ActTV2Data {
+	c := *s
+	c.Categories = deepCopySlice(s.Categories, nil)
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Triggers = deepCopySlice(s.Triggers, (*ActTV2DataTrigger).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActTV2Data) equal(other *ActTV2Data) bool {
+	return equalSlices(s.Categories, other.Categories, func(a *string, b *string) bool {
+		return *a == *b
+	}) &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ExecutionType == other.ExecutionType &&
+		s.Name == other.Name &&
+		equalSlices(s.Triggers, other.Triggers, (*ActTV2DataTrigger).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActTV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActTV2Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.ExecutionType != "" {
+		if !s.ExecutionType.IsValid() {
+			addFieldProblem(problems, pointer+"/executionType", "%q is not a valid %s value", string(s.ExecutionType), "ActTV2DataExecutionType")
+		}
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if len(s.Triggers) != 0 {
+		for i := range s.Triggers {
+			elem := &s.Triggers[i]
+			elem.validate(indexPointer(pointer+"/triggers", i), problems)
+		}
+	}
+}
+
 type ActTV2DataExecutionType string
 
 const (
@@ -157,6 +646,15 @@ const (
 	ActTV2DataExecutionType_Other         ActTV2DataExecutionType = "OTHER"
 )
 
+// IsValid returns true if the value is one of the defined ActTV2DataExecutionType values.
+func (e ActTV2DataExecutionType) IsValid() bool {
+	switch e {
+	case ActTV2DataExecutionType_Manual, ActTV2DataExecutionType_SemiAutomated, ActTV2DataExecutionType_Automated, ActTV2DataExecutionType_Other:
+		return true
+	}
+	return false
+}
+
 type ActTV2DataTrigger struct {
 	// Mandatory fields
 	Type ActTV2DataTriggerType `json:"type"`
@@ -165,6 +663,109 @@ type ActTV2DataTrigger struct {
 	Description string `json:"description,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ActTV2DataTrigger) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, string(s.Type))
+	if s.Description != "" {
+		b = append(b, `,"description":`...)
+		b = appendJSONString(b, s.Description)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ActTV2DataTrigger) isEmptyJSON() bool {
+	return s.Description == "" &&
+		s.Type == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ActTV2DataTrigger) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ActTV2DataTrigger")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ActTV2DataTrigger) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "description":
+		return true, decodeJSONString(member, &s.Description, "string")
+	case "type":
+		return true, decodeJSONString(member, (*string)(&s.Type), "eiffelevents.ActTV2DataTriggerType")
+	}
+	if folded, ok := foldJSONName(name, "description", "type"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ActTV2DataTrigger) deepCopy() ActTV2DataTrigger {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ActTV2DataTrigger) equal(other *ActTV2DataTrigger) bool {
+	return s.Description == other.Description &&
+		s.Type == other.Type
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ActTV2DataTrigger) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ActTV2DataTrigger) validate(pointer string, problems *[]FieldError) {
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	} else {
+		if !s.Type.IsValid() {
+			addFieldProblem(problems, pointer+"/type", "%q is not a valid %s value", string(s.Type), "ActTV2DataTriggerType")
+		}
+	}
+}
+
 type ActTV2DataTriggerType string
 
 const (
@@ -174,3 +775,12 @@ const (
 	ActTV2DataTriggerType_Timer        ActTV2DataTriggerType = "TIMER"
 	ActTV2DataTriggerType_Other        ActTV2DataTriggerType = "OTHER"
 )
+
+// IsValid returns true if the value is one of the defined ActTV2DataTriggerType values.
+func (e ActTV2DataTriggerType) IsValid() bool {
+	switch e {
+	case ActTV2DataTriggerType_Manual, ActTV2DataTriggerType_EiffelEvent, ActTV2DataTriggerType_SourceChange, ActTV2DataTriggerType_Timer, ActTV2DataTriggerType_Other:
+		return true
+	}
+	return false
+}
@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewAnnouncementPublishedV3 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 3.x.x
 // currently known by this SDK.
 func NewAnnouncementPublishedV3(modifiers ...Modifier) (*AnnouncementPublishedV3, error) {
+	return newAnnouncementPublishedV3(Generator{}, eventTypeTable["EiffelAnnouncementPublishedEvent"][3].latestVersion, modifiers)
+}
+
+// NewAnnouncementPublishedV3 creates a new EiffelAnnouncementPublishedEvent struct pointer like
+// the package-level NewAnnouncementPublishedV3, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewAnnouncementPublishedV3(modifiers ...Modifier) (*AnnouncementPublishedV3, error) {
+	return newAnnouncementPublishedV3(f.generator, eventTypeTable["EiffelAnnouncementPublishedEvent"][3].latestVersion, f.withModifiers(modifiers))
+}
+
+// newAnnouncementPublishedV3 creates a new EiffelAnnouncementPublishedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newAnnouncementPublishedV3(generator Generator, version string, modifiers []Modifier) (*AnnouncementPublishedV3, error) {
 	var event AnnouncementPublishedV3
 	event.Meta.Type = "EiffelAnnouncementPublishedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][3].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new AnnouncementPublishedV3: %w", err)
@@ -48,33 +61,51 @@ func NewAnnouncementPublishedV3(modifiers ...Modifier) (*AnnouncementPublishedV3
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *AnnouncementPublishedV3) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *AnnPV3Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV3      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *AnnouncementPublishedV3) DeepCopy() *AnnouncementPublishedV3 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *AnnouncementPublishedV3) Equal(other *AnnouncementPublishedV3) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *AnnouncementPublishedV3) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *AnnouncementPublishedV3) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *AnnouncementPublishedV3) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *AnnouncementPublishedV3) String() string {
 
 var (
 	_ CapabilityTeller = &AnnouncementPublishedV3{}
+	_ Event            = &AnnouncementPublishedV3{}
+	_ FieldGetter      = &AnnouncementPublishedV3{}
 	_ FieldSetter      = &AnnouncementPublishedV3{}
 	_ MetaTeller       = &AnnouncementPublishedV3{}
 )
@@ -120,6 +153,47 @@ func (e AnnouncementPublishedV3) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e AnnouncementPublishedV3) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *AnnouncementPublishedV3) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *AnnouncementPublishedV3) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *AnnouncementPublishedV3) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e AnnouncementPublishedV3) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e AnnouncementPublishedV3) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e AnnouncementPublishedV3) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,152 @@ func (e AnnouncementPublishedV3) SupportsSigning() bool {
 	return true
 }
 
+// AnnouncementPublishedV3Builder builds EiffelAnnouncementPublishedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildAnnouncementPublishedV3.
+type AnnouncementPublishedV3Builder struct {
+	event     AnnouncementPublishedV3
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildAnnouncementPublishedV3 returns a builder for major version 3
+// of EiffelAnnouncementPublishedEvent. The event version is set to the most recent
+// 3.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildAnnouncementPublishedV3(modifiers ...Modifier) *AnnouncementPublishedV3Builder {
+	b := &AnnouncementPublishedV3Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelAnnouncementPublishedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][3].latestVersion
+	return b
+}
+
+// BuildAnnouncementPublishedV3 returns a builder for EiffelAnnouncementPublishedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildAnnouncementPublishedV3 for details.
+func (f *Factory) BuildAnnouncementPublishedV3(modifiers ...Modifier) *AnnouncementPublishedV3Builder {
+	b := BuildAnnouncementPublishedV3(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Body sets the data.body field, which is mandatory.
+func (b *AnnouncementPublishedV3Builder) Body(value string) *AnnouncementPublishedV3Builder {
+	b.event.Data.Body = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *AnnouncementPublishedV3Builder) CustomData(value ...CustomDataV1) *AnnouncementPublishedV3Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// Heading sets the data.heading field, which is mandatory.
+func (b *AnnouncementPublishedV3Builder) Heading(value string) *AnnouncementPublishedV3Builder {
+	b.event.Data.Heading = value
+	return b
+}
+
+// Severity sets the data.severity field, which is mandatory.
+func (b *AnnouncementPublishedV3Builder) Severity(value AnnPV3DataSeverity) *AnnouncementPublishedV3Builder {
+	b.event.Data.Severity = value
+	return b
+}
+
+// URI sets the data.uri field.
+func (b *AnnouncementPublishedV3Builder) URI(value string) *AnnouncementPublishedV3Builder {
+	b.event.Data.URI = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *AnnouncementPublishedV3Builder) Cause(target MetaTeller) *AnnouncementPublishedV3Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *AnnouncementPublishedV3Builder) CauseByID(target string) *AnnouncementPublishedV3Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *AnnouncementPublishedV3Builder) Context(target MetaTeller) *AnnouncementPublishedV3Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *AnnouncementPublishedV3Builder) ContextByID(target string) *AnnouncementPublishedV3Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *AnnouncementPublishedV3Builder) FlowContext(target MetaTeller) *AnnouncementPublishedV3Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *AnnouncementPublishedV3Builder) FlowContextByID(target string) *AnnouncementPublishedV3Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// ModifiedAnnouncement adds a MODIFIED_ANNOUNCEMENT link to the target event.
+func (b *AnnouncementPublishedV3Builder) ModifiedAnnouncement(target MetaTeller) *AnnouncementPublishedV3Builder {
+	b.event.Links.Add(LinkType_ModifiedAnnouncement, target)
+	return b
+}
+
+// ModifiedAnnouncementByID adds a MODIFIED_ANNOUNCEMENT link to the event with the given ID.
+func (b *AnnouncementPublishedV3Builder) ModifiedAnnouncementByID(target string) *AnnouncementPublishedV3Builder {
+	b.event.Links.AddByID(LinkType_ModifiedAnnouncement, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildAnnouncementPublishedV3.
+func (b *AnnouncementPublishedV3Builder) With(modifiers ...Modifier) *AnnouncementPublishedV3Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *AnnouncementPublishedV3Builder) Build() (*AnnouncementPublishedV3, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new AnnouncementPublishedV3: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type AnnouncementPublishedV3 struct {
 	// Mandatory fields
 	Data  AnnPV3Data   `json:"data"`
@@ -135,6 +355,131 @@ type AnnouncementPublishedV3 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *AnnouncementPublishedV3) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *AnnouncementPublishedV3) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *AnnouncementPublishedV3) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.AnnouncementPublishedV3")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *AnnouncementPublishedV3) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *AnnouncementPublishedV3) deepCopy() AnnouncementPublishedV3 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *AnnouncementPublishedV3) equal(other *AnnouncementPublishedV3) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *AnnouncementPublishedV3) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *AnnouncementPublishedV3) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type AnnPV3Data struct {
@@ -148,6 +493,144 @@ type AnnPV3Data struct {
 	URI        string         `json:"uri,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *AnnPV3Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"body":`...)
+	b = appendJSONString(b, s.Body)
+	b = append(b, `,"heading":`...)
+	b = appendJSONString(b, s.Heading)
+	b = append(b, `,"severity":`...)
+	b = appendJSONString(b, string(s.Severity))
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *AnnPV3Data) isEmptyJSON() bool {
+	return s.Body == "" &&
+		len(s.CustomData) == 0 &&
+		s.Heading == "" &&
+		s.Severity == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *AnnPV3Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.AnnPV3Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *AnnPV3Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "body":
+		return true, decodeJSONString(member, &s.Body, "string")
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "heading":
+		return true, decodeJSONString(member, &s.Heading, "string")
+	case "severity":
+		return true, decodeJSONString(member, (*string)(&s.Severity), "eiffelevents.AnnPV3DataSeverity")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "body", "customData", "heading", "severity", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *AnnPV3Data) deepCopy() AnnPV3Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *AnnPV3Data) equal(other *AnnPV3Data) bool {
+	return s.Body == other.Body &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.Heading == other.Heading &&
+		s.Severity == other.Severity &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *AnnPV3Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *AnnPV3Data) validate(pointer string, problems *[]FieldError) {
+	if s.Body == "" {
+		addFieldProblem(problems, pointer+"/body", "mandatory field is missing")
+	}
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.Heading == "" {
+		addFieldProblem(problems, pointer+"/heading", "mandatory field is missing")
+	}
+	if s.Severity == "" {
+		addFieldProblem(problems, pointer+"/severity", "mandatory field is missing")
+	} else {
+		if !s.Severity.IsValid() {
+			addFieldProblem(problems, pointer+"/severity", "%q is not a valid %s value", string(s.Severity), "AnnPV3DataSeverity")
+		}
+	}
+}
+
 type AnnPV3DataSeverity string
 
 const (
@@ -158,3 +641,12 @@ const (
 	AnnPV3DataSeverity_Closed   AnnPV3DataSeverity = "CLOSED"
 	AnnPV3DataSeverity_Canceled AnnPV3DataSeverity = "CANCELED"
 )
+
+// IsValid returns true if the value is one of the defined AnnPV3DataSeverity values.
+func (e AnnPV3DataSeverity) IsValid() bool {
+	switch e {
+	case AnnPV3DataSeverity_Minor, AnnPV3DataSeverity_Major, AnnPV3DataSeverity_Critical, AnnPV3DataSeverity_Blocker, AnnPV3DataSeverity_Closed, AnnPV3DataSeverity_Canceled:
+		return true
+	}
+	return false
+}
@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewArtifactCreatedV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewArtifactCreatedV1(modifiers ...Modifier) (*ArtifactCreatedV1, error) {
+	return newArtifactCreatedV1(Generator{}, eventTypeTable["EiffelArtifactCreatedEvent"][1].latestVersion, modifiers)
+}
+
+// NewArtifactCreatedV1 creates a new EiffelArtifactCreatedEvent struct pointer like
+// the package-level NewArtifactCreatedV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewArtifactCreatedV1(modifiers ...Modifier) (*ArtifactCreatedV1, error) {
+	return newArtifactCreatedV1(f.generator, eventTypeTable["EiffelArtifactCreatedEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newArtifactCreatedV1 creates a new EiffelArtifactCreatedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newArtifactCreatedV1(generator Generator, version string, modifiers []Modifier) (*ArtifactCreatedV1, error) {
 	var event ArtifactCreatedV1
 	event.Meta.Type = "EiffelArtifactCreatedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV1: %w", err)
@@ -48,33 +61,51 @@ func NewArtifactCreatedV1(modifiers ...Modifier) (*ArtifactCreatedV1, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ArtifactCreatedV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ArtCV1Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ArtifactCreatedV1) DeepCopy() *ArtifactCreatedV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ArtifactCreatedV1) Equal(other *ArtifactCreatedV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ArtifactCreatedV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ArtifactCreatedV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ArtifactCreatedV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ArtifactCreatedV1) String() string {
 
 var (
 	_ CapabilityTeller = &ArtifactCreatedV1{}
+	_ Event            = &ArtifactCreatedV1{}
+	_ FieldGetter      = &ArtifactCreatedV1{}
 	_ FieldSetter      = &ArtifactCreatedV1{}
 	_ MetaTeller       = &ArtifactCreatedV1{}
 )
@@ -120,6 +153,47 @@ func (e ArtifactCreatedV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ArtifactCreatedV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ArtifactCreatedV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ArtifactCreatedV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ArtifactCreatedV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ArtifactCreatedV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ArtifactCreatedV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ArtifactCreatedV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,206 @@ func (e ArtifactCreatedV1) SupportsSigning() bool {
 	return false
 }
 
+// ArtifactCreatedV1Builder builds EiffelArtifactCreatedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildArtifactCreatedV1.
+type ArtifactCreatedV1Builder struct {
+	event     ArtifactCreatedV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildArtifactCreatedV1 returns a builder for major version 1
+// of EiffelArtifactCreatedEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildArtifactCreatedV1(modifiers ...Modifier) *ArtifactCreatedV1Builder {
+	b := &ArtifactCreatedV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelArtifactCreatedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildArtifactCreatedV1 returns a builder for EiffelArtifactCreatedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildArtifactCreatedV1 for details.
+func (f *Factory) BuildArtifactCreatedV1(modifiers ...Modifier) *ArtifactCreatedV1Builder {
+	b := BuildArtifactCreatedV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// BuildCommand sets the data.buildCommand field.
+func (b *ArtifactCreatedV1Builder) BuildCommand(value string) *ArtifactCreatedV1Builder {
+	b.event.Data.BuildCommand = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ArtifactCreatedV1Builder) CustomData(value ...CustomDataV1) *ArtifactCreatedV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// DependsOn sets the data.dependsOn field.
+func (b *ArtifactCreatedV1Builder) DependsOn(value ...ArtCV1DataDependsOn) *ArtifactCreatedV1Builder {
+	b.event.Data.DependsOn = value
+	return b
+}
+
+// FileInformation sets the data.fileInformation field.
+func (b *ArtifactCreatedV1Builder) FileInformation(value ...ArtCV1DataFileInformation) *ArtifactCreatedV1Builder {
+	b.event.Data.FileInformation = value
+	return b
+}
+
+// ArtifactID sets the data.gav.artifactId field, which is mandatory.
+func (b *ArtifactCreatedV1Builder) ArtifactID(value string) *ArtifactCreatedV1Builder {
+	b.event.Data.Gav.ArtifactID = value
+	return b
+}
+
+// GroupID sets the data.gav.groupId field, which is mandatory.
+func (b *ArtifactCreatedV1Builder) GroupID(value string) *ArtifactCreatedV1Builder {
+	b.event.Data.Gav.GroupID = value
+	return b
+}
+
+// Version sets the data.gav.version field, which is mandatory.
+func (b *ArtifactCreatedV1Builder) Version(value string) *ArtifactCreatedV1Builder {
+	b.event.Data.Gav.Version = value
+	return b
+}
+
+// Implements sets the data.implements field.
+func (b *ArtifactCreatedV1Builder) Implements(value ...ArtCV1DataImplement) *ArtifactCreatedV1Builder {
+	b.event.Data.Implements = value
+	return b
+}
+
+// Name sets the data.name field.
+func (b *ArtifactCreatedV1Builder) Name(value string) *ArtifactCreatedV1Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// RequiresImplementation sets the data.requiresImplementation field.
+func (b *ArtifactCreatedV1Builder) RequiresImplementation(value ArtCV1DataRequiresImplementation) *ArtifactCreatedV1Builder {
+	b.event.Data.RequiresImplementation = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ArtifactCreatedV1Builder) Cause(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) CauseByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Composition adds a COMPOSITION link to the target event.
+func (b *ArtifactCreatedV1Builder) Composition(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_Composition, target)
+	return b
+}
+
+// CompositionByID adds a COMPOSITION link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) CompositionByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_Composition, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ArtifactCreatedV1Builder) Context(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) ContextByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// Environment adds a ENVIRONMENT link to the target event.
+func (b *ArtifactCreatedV1Builder) Environment(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_Environment, target)
+	return b
+}
+
+// EnvironmentByID adds a ENVIRONMENT link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) EnvironmentByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_Environment, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ArtifactCreatedV1Builder) FlowContext(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) FlowContextByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *ArtifactCreatedV1Builder) PreviousVersion(target MetaTeller) *ArtifactCreatedV1Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *ArtifactCreatedV1Builder) PreviousVersionByID(target string) *ArtifactCreatedV1Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildArtifactCreatedV1.
+func (b *ArtifactCreatedV1Builder) With(modifiers ...Modifier) *ArtifactCreatedV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ArtifactCreatedV1Builder) Build() (*ArtifactCreatedV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ArtifactCreatedV1 struct {
 	// Mandatory fields
 	Data  ArtCV1Data   `json:"data"`
@@ -135,6 +409,131 @@ type ArtifactCreatedV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtifactCreatedV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtifactCreatedV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtifactCreatedV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtifactCreatedV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtifactCreatedV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtifactCreatedV1) deepCopy() ArtifactCreatedV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtifactCreatedV1) equal(other *ArtifactCreatedV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtifactCreatedV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtifactCreatedV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ArtCV1Data struct {
@@ -151,6 +550,195 @@ type ArtCV1Data struct {
 	RequiresImplementation ArtCV1DataRequiresImplementation `json:"requiresImplementation,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"gav":`...)
+	if b, err = s.Gav.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if s.BuildCommand != "" {
+		b = append(b, `,"buildCommand":`...)
+		b = appendJSONString(b, s.BuildCommand)
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		b = append(b, `,"dependsOn":`...)
+		if b, err = appendJSONArray(b, s.DependsOn, (*ArtCV1DataDependsOn).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		b = append(b, `,"fileInformation":`...)
+		if b, err = appendJSONArray(b, s.FileInformation, (*ArtCV1DataFileInformation).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Implements) != 0 {
+		b = append(b, `,"implements":`...)
+		if b, err = appendJSONArray(b, s.Implements, (*ArtCV1DataImplement).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if s.RequiresImplementation != "" {
+		b = append(b, `,"requiresImplementation":`...)
+		b = appendJSONString(b, string(s.RequiresImplementation))
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV1Data) isEmptyJSON() bool {
+	return s.BuildCommand == "" &&
+		len(s.CustomData) == 0 &&
+		len(s.DependsOn) == 0 &&
+		len(s.FileInformation) == 0 &&
+		s.Gav.isEmptyJSON() &&
+		len(s.Implements) == 0 &&
+		s.Name == "" &&
+		s.RequiresImplementation == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "buildCommand":
+		return true, decodeJSONString(member, &s.BuildCommand, "string")
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "dependsOn":
+		return true, decodeJSONArray(member, &s.DependsOn, "[]eiffelevents.ArtCV1DataDependsOn", opts, (*ArtCV1DataDependsOn).decodeJSON)
+	case "fileInformation":
+		return true, decodeJSONArray(member, &s.FileInformation, "[]eiffelevents.ArtCV1DataFileInformation", opts, (*ArtCV1DataFileInformation).decodeJSON)
+	case "gav":
+		return true, s.Gav.decodeJSON(member, opts)
+	case "implements":
+		return true, decodeJSONArray(member, &s.Implements, "[]eiffelevents.ArtCV1DataImplement", opts, (*ArtCV1DataImplement).decodeJSON)
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "requiresImplementation":
+		return true, decodeJSONString(member, (*string)(&s.RequiresImplementation), "eiffelevents.ArtCV1DataRequiresImplementation")
+	}
+	if folded, ok := foldJSONName(name, "buildCommand", "customData", "dependsOn", "fileInformation", "gav", "implements", "name", "requiresImplementation"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV1Data) deepCopy() ArtCV1Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.DependsOn = deepCopySlice(s.DependsOn, (*ArtCV1DataDependsOn).deepCopy)
+	c.FileInformation = deepCopySlice(s.FileInformation, (*ArtCV1DataFileInformation).deepCopy)
+	c.Gav = s.Gav.deepCopy()
+	c.Implements = deepCopySlice(s.Implements, (*ArtCV1DataImplement).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV1Data) equal(other *ArtCV1Data) bool {
+	return s.BuildCommand == other.BuildCommand &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		equalSlices(s.DependsOn, other.DependsOn, (*ArtCV1DataDependsOn).equal) &&
+		equalSlices(s.FileInformation, other.FileInformation, (*ArtCV1DataFileInformation).equal) &&
+		s.Gav.equal(&other.Gav) &&
+		equalSlices(s.Implements, other.Implements, (*ArtCV1DataImplement).equal) &&
+		s.Name == other.Name &&
+		s.RequiresImplementation == other.RequiresImplementation
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		for i := range s.DependsOn {
+			elem := &s.DependsOn[i]
+			elem.validate(indexPointer(pointer+"/dependsOn", i), problems)
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		for i := range s.FileInformation {
+			elem := &s.FileInformation[i]
+			elem.validate(indexPointer(pointer+"/fileInformation", i), problems)
+		}
+	}
+	s.Gav.validate(pointer+"/gav", problems)
+	if len(s.Implements) != 0 {
+		for i := range s.Implements {
+			elem := &s.Implements[i]
+			elem.validate(indexPointer(pointer+"/implements", i), problems)
+		}
+	}
+	if s.RequiresImplementation != "" {
+		if !s.RequiresImplementation.IsValid() {
+			addFieldProblem(problems, pointer+"/requiresImplementation", "%q is not a valid %s value", string(s.RequiresImplementation), "ArtCV1DataRequiresImplementation")
+		}
+	}
+}
+
 type ArtCV1DataDependsOn struct {
 	// Mandatory fields
 	ArtifactID string `json:"artifactId"`
@@ -161,6 +749,115 @@ type ArtCV1DataDependsOn struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV1DataDependsOn) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"artifactId":`...)
+	b = appendJSONString(b, s.ArtifactID)
+	b = append(b, `,"groupId":`...)
+	b = appendJSONString(b, s.GroupID)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV1DataDependsOn) isEmptyJSON() bool {
+	return s.ArtifactID == "" &&
+		s.GroupID == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV1DataDependsOn) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV1DataDependsOn")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV1DataDependsOn) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "artifactId":
+		return true, decodeJSONString(member, &s.ArtifactID, "string")
+	case "groupId":
+		return true, decodeJSONString(member, &s.GroupID, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "artifactId", "groupId", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV1DataDependsOn) deepCopy() ArtCV1DataDependsOn {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV1DataDependsOn) equal(other *ArtCV1DataDependsOn) bool {
+	return s.ArtifactID == other.ArtifactID &&
+		s.GroupID == other.GroupID &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV1DataDependsOn) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV1DataDependsOn) validate(pointer string, problems *[]FieldError) {
+	if s.ArtifactID == "" {
+		addFieldProblem(problems, pointer+"/artifactId", "mandatory field is missing")
+	}
+	if s.GroupID == "" {
+		addFieldProblem(problems, pointer+"/groupId", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
+
 type ArtCV1DataFileInformation struct {
 	// Mandatory fields
 	Classifier string `json:"classifier"`
@@ -170,6 +867,106 @@ type ArtCV1DataFileInformation struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV1DataFileInformation) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"classifier":`...)
+	b = appendJSONString(b, s.Classifier)
+	b = append(b, `,"extension":`...)
+	b = appendJSONString(b, s.Extension)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV1DataFileInformation) isEmptyJSON() bool {
+	return s.Classifier == "" &&
+		s.Extension == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV1DataFileInformation) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV1DataFileInformation")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV1DataFileInformation) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "classifier":
+		return true, decodeJSONString(member, &s.Classifier, "string")
+	case "extension":
+		return true, decodeJSONString(member, &s.Extension, "string")
+	}
+	if folded, ok := foldJSONName(name, "classifier", "extension"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV1DataFileInformation) deepCopy() ArtCV1DataFileInformation {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV1DataFileInformation) equal(other *ArtCV1DataFileInformation) bool {
+	return s.Classifier == other.Classifier &&
+		s.Extension == other.Extension
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV1DataFileInformation) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV1DataFileInformation) validate(pointer string, problems *[]FieldError) {
+	if s.Classifier == "" {
+		addFieldProblem(problems, pointer+"/classifier", "mandatory field is missing")
+	}
+	if s.Extension == "" {
+		addFieldProblem(problems, pointer+"/extension", "mandatory field is missing")
+	}
+}
+
 type ArtCV1DataGav struct {
 	// Mandatory fields
 	ArtifactID string `json:"artifactId"`
@@ -180,6 +977,115 @@ type ArtCV1DataGav struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV1DataGav) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"artifactId":`...)
+	b = appendJSONString(b, s.ArtifactID)
+	b = append(b, `,"groupId":`...)
+	b = appendJSONString(b, s.GroupID)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV1DataGav) isEmptyJSON() bool {
+	return s.ArtifactID == "" &&
+		s.GroupID == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV1DataGav) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV1DataGav")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV1DataGav) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "artifactId":
+		return true, decodeJSONString(member, &s.ArtifactID, "string")
+	case "groupId":
+		return true, decodeJSONString(member, &s.GroupID, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "artifactId", "groupId", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV1DataGav) deepCopy() ArtCV1DataGav {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV1DataGav) equal(other *ArtCV1DataGav) bool {
+	return s.ArtifactID == other.ArtifactID &&
+		s.GroupID == other.GroupID &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV1DataGav) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV1DataGav) validate(pointer string, problems *[]FieldError) {
+	if s.ArtifactID == "" {
+		addFieldProblem(problems, pointer+"/artifactId", "mandatory field is missing")
+	}
+	if s.GroupID == "" {
+		addFieldProblem(problems, pointer+"/groupId", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
+
 type ArtCV1DataImplement struct {
 	// Mandatory fields
 	ArtifactID string `json:"artifactId"`
@@ -190,6 +1096,115 @@ type ArtCV1DataImplement struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV1DataImplement) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"artifactId":`...)
+	b = appendJSONString(b, s.ArtifactID)
+	b = append(b, `,"groupId":`...)
+	b = appendJSONString(b, s.GroupID)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV1DataImplement) isEmptyJSON() bool {
+	return s.ArtifactID == "" &&
+		s.GroupID == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV1DataImplement) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV1DataImplement")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV1DataImplement) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "artifactId":
+		return true, decodeJSONString(member, &s.ArtifactID, "string")
+	case "groupId":
+		return true, decodeJSONString(member, &s.GroupID, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "artifactId", "groupId", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV1DataImplement) deepCopy() ArtCV1DataImplement {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV1DataImplement) equal(other *ArtCV1DataImplement) bool {
+	return s.ArtifactID == other.ArtifactID &&
+		s.GroupID == other.GroupID &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV1DataImplement) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV1DataImplement) validate(pointer string, problems *[]FieldError) {
+	if s.ArtifactID == "" {
+		addFieldProblem(problems, pointer+"/artifactId", "mandatory field is missing")
+	}
+	if s.GroupID == "" {
+		addFieldProblem(problems, pointer+"/groupId", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
+
 type ArtCV1DataRequiresImplementation string
 
 const (
@@ -198,3 +1213,12 @@ const (
 	ArtCV1DataRequiresImplementation_ExactlyOne ArtCV1DataRequiresImplementation = "EXACTLY_ONE"
 	ArtCV1DataRequiresImplementation_AtLeastOne ArtCV1DataRequiresImplementation = "AT_LEAST_ONE"
 )
+
+// IsValid returns true if the value is one of the defined ArtCV1DataRequiresImplementation values.
+func (e ArtCV1DataRequiresImplementation) IsValid() bool {
+	switch e {
+	case ArtCV1DataRequiresImplementation_None, ArtCV1DataRequiresImplementation_Any, ArtCV1DataRequiresImplementation_ExactlyOne, ArtCV1DataRequiresImplementation_AtLeastOne:
+		return true
+	}
+	return false
+}
@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewArtifactCreatedV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewArtifactCreatedV2(modifiers ...Modifier) (*ArtifactCreatedV2, error) {
+	return newArtifactCreatedV2(Generator{}, eventTypeTable["EiffelArtifactCreatedEvent"][2].latestVersion, modifiers)
+}
+
+// NewArtifactCreatedV2 creates a new EiffelArtifactCreatedEvent struct pointer like
+// the package-level NewArtifactCreatedV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewArtifactCreatedV2(modifiers ...Modifier) (*ArtifactCreatedV2, error) {
+	return newArtifactCreatedV2(f.generator, eventTypeTable["EiffelArtifactCreatedEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newArtifactCreatedV2 creates a new EiffelArtifactCreatedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newArtifactCreatedV2(generator Generator, version string, modifiers []Modifier) (*ArtifactCreatedV2, error) {
 	var event ArtifactCreatedV2
 	event.Meta.Type = "EiffelArtifactCreatedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV2: %w", err)
@@ -48,33 +61,51 @@ func NewArtifactCreatedV2(modifiers ...Modifier) (*ArtifactCreatedV2, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ArtifactCreatedV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ArtCV2Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV2      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ArtifactCreatedV2) DeepCopy() *ArtifactCreatedV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ArtifactCreatedV2) Equal(other *ArtifactCreatedV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ArtifactCreatedV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ArtifactCreatedV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ArtifactCreatedV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ArtifactCreatedV2) String() string {
 
 var (
 	_ CapabilityTeller = &ArtifactCreatedV2{}
+	_ Event            = &ArtifactCreatedV2{}
+	_ FieldGetter      = &ArtifactCreatedV2{}
 	_ FieldSetter      = &ArtifactCreatedV2{}
 	_ MetaTeller       = &ArtifactCreatedV2{}
 )
@@ -120,6 +153,47 @@ func (e ArtifactCreatedV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ArtifactCreatedV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ArtifactCreatedV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ArtifactCreatedV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ArtifactCreatedV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ArtifactCreatedV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ArtifactCreatedV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ArtifactCreatedV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,194 @@ func (e ArtifactCreatedV2) SupportsSigning() bool {
 	return false
 }
 
+// ArtifactCreatedV2Builder builds EiffelArtifactCreatedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildArtifactCreatedV2.
+type ArtifactCreatedV2Builder struct {
+	event     ArtifactCreatedV2
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildArtifactCreatedV2 returns a builder for major version 2
+// of EiffelArtifactCreatedEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildArtifactCreatedV2(modifiers ...Modifier) *ArtifactCreatedV2Builder {
+	b := &ArtifactCreatedV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelArtifactCreatedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildArtifactCreatedV2 returns a builder for EiffelArtifactCreatedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildArtifactCreatedV2 for details.
+func (f *Factory) BuildArtifactCreatedV2(modifiers ...Modifier) *ArtifactCreatedV2Builder {
+	b := BuildArtifactCreatedV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// BuildCommand sets the data.buildCommand field.
+func (b *ArtifactCreatedV2Builder) BuildCommand(value string) *ArtifactCreatedV2Builder {
+	b.event.Data.BuildCommand = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ArtifactCreatedV2Builder) CustomData(value ...CustomDataV1) *ArtifactCreatedV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// DependsOn sets the data.dependsOn field.
+func (b *ArtifactCreatedV2Builder) DependsOn(value ...string) *ArtifactCreatedV2Builder {
+	b.event.Data.DependsOn = value
+	return b
+}
+
+// FileInformation sets the data.fileInformation field.
+func (b *ArtifactCreatedV2Builder) FileInformation(value ...ArtCV2DataFileInformation) *ArtifactCreatedV2Builder {
+	b.event.Data.FileInformation = value
+	return b
+}
+
+// Identity sets the data.identity field, which is mandatory.
+func (b *ArtifactCreatedV2Builder) Identity(value string) *ArtifactCreatedV2Builder {
+	b.event.Data.Identity = value
+	return b
+}
+
+// Implements sets the data.implements field.
+func (b *ArtifactCreatedV2Builder) Implements(value ...string) *ArtifactCreatedV2Builder {
+	b.event.Data.Implements = value
+	return b
+}
+
+// Name sets the data.name field.
+func (b *ArtifactCreatedV2Builder) Name(value string) *ArtifactCreatedV2Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// RequiresImplementation sets the data.requiresImplementation field.
+func (b *ArtifactCreatedV2Builder) RequiresImplementation(value ArtCV2DataRequiresImplementation) *ArtifactCreatedV2Builder {
+	b.event.Data.RequiresImplementation = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ArtifactCreatedV2Builder) Cause(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) CauseByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Composition adds a COMPOSITION link to the target event.
+func (b *ArtifactCreatedV2Builder) Composition(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_Composition, target)
+	return b
+}
+
+// CompositionByID adds a COMPOSITION link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) CompositionByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Composition, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ArtifactCreatedV2Builder) Context(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) ContextByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// Environment adds a ENVIRONMENT link to the target event.
+func (b *ArtifactCreatedV2Builder) Environment(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_Environment, target)
+	return b
+}
+
+// EnvironmentByID adds a ENVIRONMENT link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) EnvironmentByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Environment, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ArtifactCreatedV2Builder) FlowContext(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) FlowContextByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *ArtifactCreatedV2Builder) PreviousVersion(target MetaTeller) *ArtifactCreatedV2Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *ArtifactCreatedV2Builder) PreviousVersionByID(target string) *ArtifactCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildArtifactCreatedV2.
+func (b *ArtifactCreatedV2Builder) With(modifiers ...Modifier) *ArtifactCreatedV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ArtifactCreatedV2Builder) Build() (*ArtifactCreatedV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV2: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ArtifactCreatedV2 struct {
 	// Mandatory fields
 	Data  ArtCV2Data   `json:"data"`
@@ -135,6 +397,131 @@ type ArtifactCreatedV2 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtifactCreatedV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtifactCreatedV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtifactCreatedV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtifactCreatedV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtifactCreatedV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtifactCreatedV2) deepCopy() ArtifactCreatedV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtifactCreatedV2) equal(other *ArtifactCreatedV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtifactCreatedV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtifactCreatedV2) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ArtCV2Data struct {
@@ -151,6 +538,210 @@ type ArtCV2Data struct {
 	RequiresImplementation ArtCV2DataRequiresImplementation `json:"requiresImplementation,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"identity":`...)
+	b = appendJSONString(b, s.Identity)
+	if s.BuildCommand != "" {
+		b = append(b, `,"buildCommand":`...)
+		b = appendJSONString(b, s.BuildCommand)
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		b = append(b, `,"dependsOn":`...)
+		if b, err = appendJSONArray(b, s.DependsOn, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		b = append(b, `,"fileInformation":`...)
+		if b, err = appendJSONArray(b, s.FileInformation, (*ArtCV2DataFileInformation).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Implements) != 0 {
+		b = append(b, `,"implements":`...)
+		if b, err = appendJSONArray(b, s.Implements, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if s.RequiresImplementation != "" {
+		b = append(b, `,"requiresImplementation":`...)
+		b = appendJSONString(b, string(s.RequiresImplementation))
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV2Data) isEmptyJSON() bool {
+	return s.BuildCommand == "" &&
+		len(s.CustomData) == 0 &&
+		len(s.DependsOn) == 0 &&
+		len(s.FileInformation) == 0 &&
+		s.Identity == "" &&
+		len(s.Implements) == 0 &&
+		s.Name == "" &&
+		s.RequiresImplementation == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "buildCommand":
+		return true, decodeJSONString(member, &s.BuildCommand, "string")
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "dependsOn":
+		return true, decodeJSONArray(member, &s.DependsOn, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "fileInformation":
+		return true, decodeJSONArray(member, &s.FileInformation, "[]eiffelevents.ArtCV2DataFileInformation", opts, (*ArtCV2DataFileInformation).decodeJSON)
+	case "identity":
+		return true, decodeJSONString(member, &s.Identity, "string")
+	case "implements":
+		return true, decodeJSONArray(member, &s.Implements, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "requiresImplementation":
+		return true, decodeJSONString(member, (*string)(&s.RequiresImplementation), "eiffelevents.ArtCV2DataRequiresImplementation")
+	}
+	if folded, ok := foldJSONName(name, "buildCommand", "customData", "dependsOn", "fileInformation", "identity", "implements", "name", "requiresImplementation"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV2Data) deepCopy() ArtCV2Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.DependsOn = deepCopySlice(s.DependsOn, nil)
+	c.FileInformation = deepCopySlice(s.FileInformation, (*ArtCV2DataFileInformation).deepCopy)
+	c.Implements = deepCopySlice(s.Implements, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV2Data) equal(other *ArtCV2Data) bool {
+	return s.BuildCommand == other.BuildCommand &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		equalSlices(s.DependsOn, other.DependsOn, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		equalSlices(s.FileInformation, other.FileInformation, (*ArtCV2DataFileInformation).equal) &&
+		s.Identity == other.Identity &&
+		equalSlices(s.Implements, other.Implements, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		s.Name == other.Name &&
+		s.RequiresImplementation == other.RequiresImplementation
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV2Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		for i := range s.DependsOn {
+			elem := &s.DependsOn[i]
+			checkPattern(problems, indexPointer(pointer+"/dependsOn", i), *elem, "^pkg:")
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		for i := range s.FileInformation {
+			elem := &s.FileInformation[i]
+			elem.validate(indexPointer(pointer+"/fileInformation", i), problems)
+		}
+	}
+	if s.Identity == "" {
+		addFieldProblem(problems, pointer+"/identity", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/identity", s.Identity, "^pkg:")
+	}
+	if len(s.Implements) != 0 {
+		for i := range s.Implements {
+			elem := &s.Implements[i]
+			checkPattern(problems, indexPointer(pointer+"/implements", i), *elem, "^pkg:")
+		}
+	}
+	if s.RequiresImplementation != "" {
+		if !s.RequiresImplementation.IsValid() {
+			addFieldProblem(problems, pointer+"/requiresImplementation", "%q is not a valid %s value", string(s.RequiresImplementation), "ArtCV2DataRequiresImplementation")
+		}
+	}
+}
+
 type ArtCV2DataFileInformation struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -159,6 +750,115 @@ type ArtCV2DataFileInformation struct {
 	Tags []string `json:"tags,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV2DataFileInformation) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if len(s.Tags) != 0 {
+		b = append(b, `,"tags":`...)
+		if b, err = appendJSONArray(b, s.Tags, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV2DataFileInformation) isEmptyJSON() bool {
+	return s.Name == "" &&
+		len(s.Tags) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV2DataFileInformation) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV2DataFileInformation")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV2DataFileInformation) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "tags":
+		return true, decodeJSONArray(member, &s.Tags, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	}
+	if folded, ok := foldJSONName(name, "name", "tags"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV2DataFileInformation) deepCopy() ArtCV2DataFileInformation {
+	c := *s
+	c.Tags = deepCopySlice(s.Tags, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV2DataFileInformation) equal(other *ArtCV2DataFileInformation) bool {
+	return s.Name == other.Name &&
+		equalSlices(s.Tags, other.Tags, func(a *string, b *string) bool {
+			return *a == *b
+		})
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV2DataFileInformation) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV2DataFileInformation) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+}
+
 type ArtCV2DataRequiresImplementation string
 
 const (
@@ -167,3 +867,12 @@ const (
 	ArtCV2DataRequiresImplementation_ExactlyOne ArtCV2DataRequiresImplementation = "EXACTLY_ONE"
 	ArtCV2DataRequiresImplementation_AtLeastOne ArtCV2DataRequiresImplementation = "AT_LEAST_ONE"
 )
+
+// IsValid returns true if the value is one of the defined ArtCV2DataRequiresImplementation values.
+func (e ArtCV2DataRequiresImplementation) IsValid() bool {
+	switch e {
+	case ArtCV2DataRequiresImplementation_None, ArtCV2DataRequiresImplementation_Any, ArtCV2DataRequiresImplementation_ExactlyOne, ArtCV2DataRequiresImplementation_AtLeastOne:
+		return true
+	}
+	return false
+}
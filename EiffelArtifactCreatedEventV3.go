@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewArtifactCreatedV3 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 3.x.x
 // currently known by this SDK.
 func NewArtifactCreatedV3(modifiers ...Modifier) (*ArtifactCreatedV3, error) {
+	return newArtifactCreatedV3(Generator{}, eventTypeTable["EiffelArtifactCreatedEvent"][3].latestVersion, modifiers)
+}
+
+// NewArtifactCreatedV3 creates a new EiffelArtifactCreatedEvent struct pointer like
+// the package-level NewArtifactCreatedV3, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewArtifactCreatedV3(modifiers ...Modifier) (*ArtifactCreatedV3, error) {
+	return newArtifactCreatedV3(f.generator, eventTypeTable["EiffelArtifactCreatedEvent"][3].latestVersion, f.withModifiers(modifiers))
+}
+
+// newArtifactCreatedV3 creates a new EiffelArtifactCreatedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newArtifactCreatedV3(generator Generator, version string, modifiers []Modifier) (*ArtifactCreatedV3, error) {
 	var event ArtifactCreatedV3
 	event.Meta.Type = "EiffelArtifactCreatedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][3].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV3: %w", err)
@@ -48,33 +61,51 @@ func NewArtifactCreatedV3(modifiers ...Modifier) (*ArtifactCreatedV3, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ArtifactCreatedV3) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ArtCV3Data  `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV3      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ArtifactCreatedV3) DeepCopy() *ArtifactCreatedV3 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ArtifactCreatedV3) Equal(other *ArtifactCreatedV3) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ArtifactCreatedV3) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ArtifactCreatedV3) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ArtifactCreatedV3) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ArtifactCreatedV3) String() string {
 
 var (
 	_ CapabilityTeller = &ArtifactCreatedV3{}
+	_ Event            = &ArtifactCreatedV3{}
+	_ FieldGetter      = &ArtifactCreatedV3{}
 	_ FieldSetter      = &ArtifactCreatedV3{}
 	_ MetaTeller       = &ArtifactCreatedV3{}
 )
@@ -120,6 +153,47 @@ func (e ArtifactCreatedV3) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ArtifactCreatedV3) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ArtifactCreatedV3) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ArtifactCreatedV3) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ArtifactCreatedV3) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ArtifactCreatedV3) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ArtifactCreatedV3) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ArtifactCreatedV3) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,194 @@ func (e ArtifactCreatedV3) SupportsSigning() bool {
 	return true
 }
 
+// ArtifactCreatedV3Builder builds EiffelArtifactCreatedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildArtifactCreatedV3.
+type ArtifactCreatedV3Builder struct {
+	event     ArtifactCreatedV3
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildArtifactCreatedV3 returns a builder for major version 3
+// of EiffelArtifactCreatedEvent. The event version is set to the most recent
+// 3.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildArtifactCreatedV3(modifiers ...Modifier) *ArtifactCreatedV3Builder {
+	b := &ArtifactCreatedV3Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelArtifactCreatedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][3].latestVersion
+	return b
+}
+
+// BuildArtifactCreatedV3 returns a builder for EiffelArtifactCreatedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildArtifactCreatedV3 for details.
+func (f *Factory) BuildArtifactCreatedV3(modifiers ...Modifier) *ArtifactCreatedV3Builder {
+	b := BuildArtifactCreatedV3(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// BuildCommand sets the data.buildCommand field.
+func (b *ArtifactCreatedV3Builder) BuildCommand(value string) *ArtifactCreatedV3Builder {
+	b.event.Data.BuildCommand = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ArtifactCreatedV3Builder) CustomData(value ...CustomDataV1) *ArtifactCreatedV3Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// DependsOn sets the data.dependsOn field.
+func (b *ArtifactCreatedV3Builder) DependsOn(value ...string) *ArtifactCreatedV3Builder {
+	b.event.Data.DependsOn = value
+	return b
+}
+
+// FileInformation sets the data.fileInformation field.
+func (b *ArtifactCreatedV3Builder) FileInformation(value ...ArtCV3DataFileInformation) *ArtifactCreatedV3Builder {
+	b.event.Data.FileInformation = value
+	return b
+}
+
+// Identity sets the data.identity field, which is mandatory.
+func (b *ArtifactCreatedV3Builder) Identity(value string) *ArtifactCreatedV3Builder {
+	b.event.Data.Identity = value
+	return b
+}
+
+// Implements sets the data.implements field.
+func (b *ArtifactCreatedV3Builder) Implements(value ...string) *ArtifactCreatedV3Builder {
+	b.event.Data.Implements = value
+	return b
+}
+
+// Name sets the data.name field.
+func (b *ArtifactCreatedV3Builder) Name(value string) *ArtifactCreatedV3Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// RequiresImplementation sets the data.requiresImplementation field.
+func (b *ArtifactCreatedV3Builder) RequiresImplementation(value ArtCV3DataRequiresImplementation) *ArtifactCreatedV3Builder {
+	b.event.Data.RequiresImplementation = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ArtifactCreatedV3Builder) Cause(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) CauseByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Composition adds a COMPOSITION link to the target event.
+func (b *ArtifactCreatedV3Builder) Composition(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_Composition, target)
+	return b
+}
+
+// CompositionByID adds a COMPOSITION link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) CompositionByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_Composition, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ArtifactCreatedV3Builder) Context(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) ContextByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// Environment adds a ENVIRONMENT link to the target event.
+func (b *ArtifactCreatedV3Builder) Environment(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_Environment, target)
+	return b
+}
+
+// EnvironmentByID adds a ENVIRONMENT link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) EnvironmentByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_Environment, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ArtifactCreatedV3Builder) FlowContext(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) FlowContextByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *ArtifactCreatedV3Builder) PreviousVersion(target MetaTeller) *ArtifactCreatedV3Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *ArtifactCreatedV3Builder) PreviousVersionByID(target string) *ArtifactCreatedV3Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildArtifactCreatedV3.
+func (b *ArtifactCreatedV3Builder) With(modifiers ...Modifier) *ArtifactCreatedV3Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ArtifactCreatedV3Builder) Build() (*ArtifactCreatedV3, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ArtifactCreatedV3: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ArtifactCreatedV3 struct {
 	// Mandatory fields
 	Data  ArtCV3Data   `json:"data"`
@@ -135,6 +397,131 @@ type ArtifactCreatedV3 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtifactCreatedV3) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtifactCreatedV3) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtifactCreatedV3) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtifactCreatedV3")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtifactCreatedV3) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtifactCreatedV3) deepCopy() ArtifactCreatedV3 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtifactCreatedV3) equal(other *ArtifactCreatedV3) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtifactCreatedV3) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtifactCreatedV3) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ArtCV3Data struct {
@@ -151,6 +538,210 @@ type ArtCV3Data struct {
 	RequiresImplementation ArtCV3DataRequiresImplementation `json:"requiresImplementation,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV3Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"identity":`...)
+	b = appendJSONString(b, s.Identity)
+	if s.BuildCommand != "" {
+		b = append(b, `,"buildCommand":`...)
+		b = appendJSONString(b, s.BuildCommand)
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		b = append(b, `,"dependsOn":`...)
+		if b, err = appendJSONArray(b, s.DependsOn, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		b = append(b, `,"fileInformation":`...)
+		if b, err = appendJSONArray(b, s.FileInformation, (*ArtCV3DataFileInformation).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Implements) != 0 {
+		b = append(b, `,"implements":`...)
+		if b, err = appendJSONArray(b, s.Implements, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if s.RequiresImplementation != "" {
+		b = append(b, `,"requiresImplementation":`...)
+		b = appendJSONString(b, string(s.RequiresImplementation))
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV3Data) isEmptyJSON() bool {
+	return s.BuildCommand == "" &&
+		len(s.CustomData) == 0 &&
+		len(s.DependsOn) == 0 &&
+		len(s.FileInformation) == 0 &&
+		s.Identity == "" &&
+		len(s.Implements) == 0 &&
+		s.Name == "" &&
+		s.RequiresImplementation == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV3Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV3Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV3Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "buildCommand":
+		return true, decodeJSONString(member, &s.BuildCommand, "string")
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "dependsOn":
+		return true, decodeJSONArray(member, &s.DependsOn, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "fileInformation":
+		return true, decodeJSONArray(member, &s.FileInformation, "[]eiffelevents.ArtCV3DataFileInformation", opts, (*ArtCV3DataFileInformation).decodeJSON)
+	case "identity":
+		return true, decodeJSONString(member, &s.Identity, "string")
+	case "implements":
+		return true, decodeJSONArray(member, &s.Implements, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "requiresImplementation":
+		return true, decodeJSONString(member, (*string)(&s.RequiresImplementation), "eiffelevents.ArtCV3DataRequiresImplementation")
+	}
+	if folded, ok := foldJSONName(name, "buildCommand", "customData", "dependsOn", "fileInformation", "identity", "implements", "name", "requiresImplementation"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV3Data) deepCopy() ArtCV3Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.DependsOn = deepCopySlice(s.DependsOn, nil)
+	c.FileInformation = deepCopySlice(s.FileInformation, (*ArtCV3DataFileInformation).deepCopy)
+	c.Implements = deepCopySlice(s.Implements, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV3Data) equal(other *ArtCV3Data) bool {
+	return s.BuildCommand == other.BuildCommand &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		equalSlices(s.DependsOn, other.DependsOn, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		equalSlices(s.FileInformation, other.FileInformation, (*ArtCV3DataFileInformation).equal) &&
+		s.Identity == other.Identity &&
+		equalSlices(s.Implements, other.Implements, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		s.Name == other.Name &&
+		s.RequiresImplementation == other.RequiresImplementation
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV3Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV3Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if len(s.DependsOn) != 0 {
+		for i := range s.DependsOn {
+			elem := &s.DependsOn[i]
+			checkPattern(problems, indexPointer(pointer+"/dependsOn", i), *elem, "^pkg:")
+		}
+	}
+	if len(s.FileInformation) != 0 {
+		for i := range s.FileInformation {
+			elem := &s.FileInformation[i]
+			elem.validate(indexPointer(pointer+"/fileInformation", i), problems)
+		}
+	}
+	if s.Identity == "" {
+		addFieldProblem(problems, pointer+"/identity", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/identity", s.Identity, "^pkg:")
+	}
+	if len(s.Implements) != 0 {
+		for i := range s.Implements {
+			elem := &s.Implements[i]
+			checkPattern(problems, indexPointer(pointer+"/implements", i), *elem, "^pkg:")
+		}
+	}
+	if s.RequiresImplementation != "" {
+		if !s.RequiresImplementation.IsValid() {
+			addFieldProblem(problems, pointer+"/requiresImplementation", "%q is not a valid %s value", string(s.RequiresImplementation), "ArtCV3DataRequiresImplementation")
+		}
+	}
+}
+
 type ArtCV3DataFileInformation struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -160,6 +751,129 @@ type ArtCV3DataFileInformation struct {
 	Tags                []string                                     `json:"tags,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV3DataFileInformation) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if !s.IntegrityProtection.isEmptyJSON() {
+		b = append(b, `,"integrityProtection":`...)
+		if b, err = s.IntegrityProtection.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Tags) != 0 {
+		b = append(b, `,"tags":`...)
+		if b, err = appendJSONArray(b, s.Tags, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV3DataFileInformation) isEmptyJSON() bool {
+	return s.IntegrityProtection.isEmptyJSON() &&
+		s.Name == "" &&
+		len(s.Tags) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV3DataFileInformation) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV3DataFileInformation")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV3DataFileInformation) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "integrityProtection":
+		return true, s.IntegrityProtection.decodeJSON(member, opts)
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "tags":
+		return true, decodeJSONArray(member, &s.Tags, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	}
+	if folded, ok := foldJSONName(name, "integrityProtection", "name", "tags"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV3DataFileInformation) deepCopy() ArtCV3DataFileInformation {
+	c := *s
+	c.IntegrityProtection = s.IntegrityProtection.deepCopy()
+	c.Tags = deepCopySlice(s.Tags, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV3DataFileInformation) equal(other *ArtCV3DataFileInformation) bool {
+	return s.IntegrityProtection.equal(&other.IntegrityProtection) &&
+		s.Name == other.Name &&
+		equalSlices(s.Tags, other.Tags, func(a *string, b *string) bool {
+			return *a == *b
+		})
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV3DataFileInformation) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV3DataFileInformation) validate(pointer string, problems *[]FieldError) {
+	if !s.IntegrityProtection.isEmptyJSON() {
+		s.IntegrityProtection.validate(pointer+"/integrityProtection", problems)
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+}
+
 type ArtCV3DataFileInformationIntegrityProtection struct {
 	// Mandatory fields
 	Alg    ArtCV3DataFileInformationIntegrityProtectionAlg `json:"alg"`
@@ -169,6 +883,112 @@ type ArtCV3DataFileInformationIntegrityProtection struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtCV3DataFileInformationIntegrityProtection) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"alg":`...)
+	b = appendJSONString(b, string(s.Alg))
+	b = append(b, `,"digest":`...)
+	b = appendJSONString(b, s.Digest)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtCV3DataFileInformationIntegrityProtection) isEmptyJSON() bool {
+	return s.Alg == "" &&
+		s.Digest == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtCV3DataFileInformationIntegrityProtection) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtCV3DataFileInformationIntegrityProtection")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtCV3DataFileInformationIntegrityProtection) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "alg":
+		return true, decodeJSONString(member, (*string)(&s.Alg), "eiffelevents.ArtCV3DataFileInformationIntegrityProtectionAlg")
+	case "digest":
+		return true, decodeJSONString(member, &s.Digest, "string")
+	}
+	if folded, ok := foldJSONName(name, "alg", "digest"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtCV3DataFileInformationIntegrityProtection) deepCopy() ArtCV3DataFileInformationIntegrityProtection {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtCV3DataFileInformationIntegrityProtection) equal(other *ArtCV3DataFileInformationIntegrityProtection) bool {
+	return s.Alg == other.Alg &&
+		s.Digest == other.Digest
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtCV3DataFileInformationIntegrityProtection) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtCV3DataFileInformationIntegrityProtection) validate(pointer string, problems *[]FieldError) {
+	if s.Alg == "" {
+		addFieldProblem(problems, pointer+"/alg", "mandatory field is missing")
+	} else {
+		if !s.Alg.IsValid() {
+			addFieldProblem(problems, pointer+"/alg", "%q is not a valid %s value", string(s.Alg), "ArtCV3DataFileInformationIntegrityProtectionAlg")
+		}
+	}
+	if s.Digest == "" {
+		addFieldProblem(problems, pointer+"/digest", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/digest", s.Digest, "^[0-9a-f]+$")
+	}
+}
+
 type ArtCV3DataFileInformationIntegrityProtectionAlg string
 
 const (
@@ -180,6 +1000,15 @@ const (
 	ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_512_256 ArtCV3DataFileInformationIntegrityProtectionAlg = "SHA-512/256"
 )
 
+// IsValid returns true if the value is one of the defined ArtCV3DataFileInformationIntegrityProtectionAlg values.
+func (e ArtCV3DataFileInformationIntegrityProtectionAlg) IsValid() bool {
+	switch e {
+	case ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_224, ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_256, ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_384, ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_512, ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_512_224, ArtCV3DataFileInformationIntegrityProtectionAlg_SHA_512_256:
+		return true
+	}
+	return false
+}
+
 type ArtCV3DataRequiresImplementation string
 
 const (
@@ -188,3 +1017,12 @@ const (
 	ArtCV3DataRequiresImplementation_ExactlyOne ArtCV3DataRequiresImplementation = "EXACTLY_ONE"
 	ArtCV3DataRequiresImplementation_AtLeastOne ArtCV3DataRequiresImplementation = "AT_LEAST_ONE"
 )
+
+// IsValid returns true if the value is one of the defined ArtCV3DataRequiresImplementation values.
+func (e ArtCV3DataRequiresImplementation) IsValid() bool {
+	switch e {
+	case ArtCV3DataRequiresImplementation_None, ArtCV3DataRequiresImplementation_Any, ArtCV3DataRequiresImplementation_ExactlyOne, ArtCV3DataRequiresImplementation_AtLeastOne:
+		return true
+	}
+	return false
+}
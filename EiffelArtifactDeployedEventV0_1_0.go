@@ -21,21 +21,34 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewArtifactDeployedV0_1_0 creates a new struct pointer that represents version
 // 0.1.0 of EiffelArtifactDeployedEvent. The returned struct has all required
 // meta members populated.
 func NewArtifactDeployedV0_1_0(modifiers ...Modifier) (*ArtifactDeployedV0_1_0, error) {
+	return newArtifactDeployedV0_1_0(Generator{}, eventTypeTable["EiffelArtifactDeployedEvent"][0].latestVersion, modifiers)
+}
+
+// NewArtifactDeployedV0_1_0 creates a new EiffelArtifactDeployedEvent struct pointer like
+// the package-level NewArtifactDeployedV0_1_0, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewArtifactDeployedV0_1_0(modifiers ...Modifier) (*ArtifactDeployedV0_1_0, error) {
+	return newArtifactDeployedV0_1_0(f.generator, eventTypeTable["EiffelArtifactDeployedEvent"][0].latestVersion, f.withModifiers(modifiers))
+}
+
+// newArtifactDeployedV0_1_0 creates a new EiffelArtifactDeployedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newArtifactDeployedV0_1_0(generator Generator, version string, modifiers []Modifier) (*ArtifactDeployedV0_1_0, error) {
 	var event ArtifactDeployedV0_1_0
 	event.Meta.Type = "EiffelArtifactDeployedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][0].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ArtifactDeployedV0_1_0: %w", err)
@@ -46,33 +59,51 @@ func NewArtifactDeployedV0_1_0(modifiers ...Modifier) (*ArtifactDeployedV0_1_0,
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ArtifactDeployedV0_1_0) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *ArtDV0_1_0Data `json:"data"`
-		Links EventLinksV1    `json:"links"`
-		Meta  *MetaV3         `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ArtifactDeployedV0_1_0) DeepCopy() *ArtifactDeployedV0_1_0 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ArtifactDeployedV0_1_0) Equal(other *ArtifactDeployedV0_1_0) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ArtifactDeployedV0_1_0) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ArtifactDeployedV0_1_0) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ArtifactDeployedV0_1_0) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -89,6 +120,8 @@ func (e *ArtifactDeployedV0_1_0) String() string {
 
 var (
 	_ CapabilityTeller = &ArtifactDeployedV0_1_0{}
+	_ Event            = &ArtifactDeployedV0_1_0{}
+	_ FieldGetter      = &ArtifactDeployedV0_1_0{}
 	_ FieldSetter      = &ArtifactDeployedV0_1_0{}
 	_ MetaTeller       = &ArtifactDeployedV0_1_0{}
 )
@@ -118,6 +151,47 @@ func (e ArtifactDeployedV0_1_0) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ArtifactDeployedV0_1_0) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ArtifactDeployedV0_1_0) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ArtifactDeployedV0_1_0) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ArtifactDeployedV0_1_0) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ArtifactDeployedV0_1_0) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ArtifactDeployedV0_1_0) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ArtifactDeployedV0_1_0) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -125,6 +199,155 @@ func (e ArtifactDeployedV0_1_0) SupportsSigning() bool {
 	return true
 }
 
+// ArtifactDeployedV0_1_0Builder builds EiffelArtifactDeployedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildArtifactDeployedV0_1_0.
+type ArtifactDeployedV0_1_0Builder struct {
+	event     ArtifactDeployedV0_1_0
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildArtifactDeployedV0_1_0 returns a builder for version 0.1.0
+// of EiffelArtifactDeployedEvent.
+// The modifiers are applied to the event when it's built.
+func BuildArtifactDeployedV0_1_0(modifiers ...Modifier) *ArtifactDeployedV0_1_0Builder {
+	b := &ArtifactDeployedV0_1_0Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelArtifactDeployedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][0].latestVersion
+	return b
+}
+
+// BuildArtifactDeployedV0_1_0 returns a builder for EiffelArtifactDeployedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildArtifactDeployedV0_1_0 for details.
+func (f *Factory) BuildArtifactDeployedV0_1_0(modifiers ...Modifier) *ArtifactDeployedV0_1_0Builder {
+	b := BuildArtifactDeployedV0_1_0(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ArtifactDeployedV0_1_0Builder) CustomData(value ...CustomDataV1) *ArtifactDeployedV0_1_0Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// Description sets the data.description field.
+func (b *ArtifactDeployedV0_1_0Builder) Description(value string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Data.Description = value
+	return b
+}
+
+// URI sets the data.uri field.
+func (b *ArtifactDeployedV0_1_0Builder) URI(value string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Data.URI = value
+	return b
+}
+
+// Artifact adds a ARTIFACT link to the target event.
+// At least one such link is required.
+func (b *ArtifactDeployedV0_1_0Builder) Artifact(target MetaTeller) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.Add(LinkType_Artifact, target)
+	return b
+}
+
+// ArtifactByID adds a ARTIFACT link to the event with the given ID.
+// At least one such link is required.
+func (b *ArtifactDeployedV0_1_0Builder) ArtifactByID(target string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.AddByID(LinkType_Artifact, target)
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ArtifactDeployedV0_1_0Builder) Cause(target MetaTeller) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ArtifactDeployedV0_1_0Builder) CauseByID(target string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ArtifactDeployedV0_1_0Builder) Context(target MetaTeller) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ArtifactDeployedV0_1_0Builder) ContextByID(target string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// Environment adds a ENVIRONMENT link to the target event.
+// At least one such link is required.
+func (b *ArtifactDeployedV0_1_0Builder) Environment(target MetaTeller) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.Add(LinkType_Environment, target)
+	return b
+}
+
+// EnvironmentByID adds a ENVIRONMENT link to the event with the given ID.
+// At least one such link is required.
+func (b *ArtifactDeployedV0_1_0Builder) EnvironmentByID(target string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.AddByID(LinkType_Environment, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ArtifactDeployedV0_1_0Builder) FlowContext(target MetaTeller) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ArtifactDeployedV0_1_0Builder) FlowContextByID(target string) *ArtifactDeployedV0_1_0Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildArtifactDeployedV0_1_0.
+func (b *ArtifactDeployedV0_1_0Builder) With(modifiers ...Modifier) *ArtifactDeployedV0_1_0Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ArtifactDeployedV0_1_0Builder) Build() (*ArtifactDeployedV0_1_0, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ArtifactDeployedV0_1_0: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ArtifactDeployedV0_1_0 struct {
 	// Mandatory fields
 	Data  ArtDV0_1_0Data `json:"data"`
@@ -133,6 +356,131 @@ type ArtifactDeployedV0_1_0 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtifactDeployedV0_1_0) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtifactDeployedV0_1_0) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtifactDeployedV0_1_0) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtifactDeployedV0_1_0")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtifactDeployedV0_1_0) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtifactDeployedV0_1_0) deepCopy() ArtifactDeployedV0_1_0 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtifactDeployedV0_1_0) equal(other *ArtifactDeployedV0_1_0) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtifactDeployedV0_1_0) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtifactDeployedV0_1_0) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type ArtDV0_1_0Data struct {
@@ -143,3 +491,118 @@ type ArtDV0_1_0Data struct {
 	Description string         `json:"description,omitempty"`
 	URI         string         `json:"uri,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ArtDV0_1_0Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.Description != "" {
+		b = append(b, `,"description":`...)
+		b = appendJSONString(b, s.Description)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ArtDV0_1_0Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.Description == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ArtDV0_1_0Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ArtDV0_1_0Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ArtDV0_1_0Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "description":
+		return true, decodeJSONString(member, &s.Description, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "customData", "description", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ArtDV0_1_0Data) deepCopy() ArtDV0_1_0Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ArtDV0_1_0Data) equal(other *ArtDV0_1_0Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.Description == other.Description &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ArtDV0_1_0Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ArtDV0_1_0Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+}
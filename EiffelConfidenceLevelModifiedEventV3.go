@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewConfidenceLevelModifiedV3 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 3.x.x
 // currently known by this SDK.
 func NewConfidenceLevelModifiedV3(modifiers ...Modifier) (*ConfidenceLevelModifiedV3, error) {
+	return newConfidenceLevelModifiedV3(Generator{}, eventTypeTable["EiffelConfidenceLevelModifiedEvent"][3].latestVersion, modifiers)
+}
+
+// NewConfidenceLevelModifiedV3 creates a new EiffelConfidenceLevelModifiedEvent struct pointer like
+// the package-level NewConfidenceLevelModifiedV3, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewConfidenceLevelModifiedV3(modifiers ...Modifier) (*ConfidenceLevelModifiedV3, error) {
+	return newConfidenceLevelModifiedV3(f.generator, eventTypeTable["EiffelConfidenceLevelModifiedEvent"][3].latestVersion, f.withModifiers(modifiers))
+}
+
+// newConfidenceLevelModifiedV3 creates a new EiffelConfidenceLevelModifiedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newConfidenceLevelModifiedV3(generator Generator, version string, modifiers []Modifier) (*ConfidenceLevelModifiedV3, error) {
 	var event ConfidenceLevelModifiedV3
 	event.Meta.Type = "EiffelConfidenceLevelModifiedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][3].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new ConfidenceLevelModifiedV3: %w", err)
@@ -48,33 +61,51 @@ func NewConfidenceLevelModifiedV3(modifiers ...Modifier) (*ConfidenceLevelModifi
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *ConfidenceLevelModifiedV3) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *CLMV3Data   `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV3      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *ConfidenceLevelModifiedV3) DeepCopy() *ConfidenceLevelModifiedV3 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *ConfidenceLevelModifiedV3) Equal(other *ConfidenceLevelModifiedV3) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *ConfidenceLevelModifiedV3) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *ConfidenceLevelModifiedV3) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *ConfidenceLevelModifiedV3) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *ConfidenceLevelModifiedV3) String() string {
 
 var (
 	_ CapabilityTeller = &ConfidenceLevelModifiedV3{}
+	_ Event            = &ConfidenceLevelModifiedV3{}
+	_ FieldGetter      = &ConfidenceLevelModifiedV3{}
 	_ FieldSetter      = &ConfidenceLevelModifiedV3{}
 	_ MetaTeller       = &ConfidenceLevelModifiedV3{}
 )
@@ -120,6 +153,47 @@ func (e ConfidenceLevelModifiedV3) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e ConfidenceLevelModifiedV3) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *ConfidenceLevelModifiedV3) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *ConfidenceLevelModifiedV3) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *ConfidenceLevelModifiedV3) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e ConfidenceLevelModifiedV3) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e ConfidenceLevelModifiedV3) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e ConfidenceLevelModifiedV3) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,178 @@ func (e ConfidenceLevelModifiedV3) SupportsSigning() bool {
 	return true
 }
 
+// ConfidenceLevelModifiedV3Builder builds EiffelConfidenceLevelModifiedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildConfidenceLevelModifiedV3.
+type ConfidenceLevelModifiedV3Builder struct {
+	event     ConfidenceLevelModifiedV3
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildConfidenceLevelModifiedV3 returns a builder for major version 3
+// of EiffelConfidenceLevelModifiedEvent. The event version is set to the most recent
+// 3.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildConfidenceLevelModifiedV3(modifiers ...Modifier) *ConfidenceLevelModifiedV3Builder {
+	b := &ConfidenceLevelModifiedV3Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelConfidenceLevelModifiedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][3].latestVersion
+	return b
+}
+
+// BuildConfidenceLevelModifiedV3 returns a builder for EiffelConfidenceLevelModifiedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildConfidenceLevelModifiedV3 for details.
+func (f *Factory) BuildConfidenceLevelModifiedV3(modifiers ...Modifier) *ConfidenceLevelModifiedV3Builder {
+	b := BuildConfidenceLevelModifiedV3(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *ConfidenceLevelModifiedV3Builder) CustomData(value ...CustomDataV1) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// Email sets the data.issuer.email field.
+func (b *ConfidenceLevelModifiedV3Builder) Email(value string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Issuer.Email = value
+	return b
+}
+
+// Group sets the data.issuer.group field.
+func (b *ConfidenceLevelModifiedV3Builder) Group(value string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Issuer.Group = value
+	return b
+}
+
+// ID sets the data.issuer.id field.
+func (b *ConfidenceLevelModifiedV3Builder) ID(value string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Issuer.ID = value
+	return b
+}
+
+// IssuerName sets the data.issuer.name field.
+func (b *ConfidenceLevelModifiedV3Builder) IssuerName(value string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Issuer.Name = value
+	return b
+}
+
+// Name sets the data.name field, which is mandatory.
+func (b *ConfidenceLevelModifiedV3Builder) Name(value string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// Value sets the data.value field, which is mandatory.
+func (b *ConfidenceLevelModifiedV3Builder) Value(value CLMV3DataValue) *ConfidenceLevelModifiedV3Builder {
+	b.event.Data.Value = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *ConfidenceLevelModifiedV3Builder) Cause(target MetaTeller) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *ConfidenceLevelModifiedV3Builder) CauseByID(target string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *ConfidenceLevelModifiedV3Builder) Context(target MetaTeller) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *ConfidenceLevelModifiedV3Builder) ContextByID(target string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *ConfidenceLevelModifiedV3Builder) FlowContext(target MetaTeller) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *ConfidenceLevelModifiedV3Builder) FlowContextByID(target string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// SubConfidenceLevel adds a SUB_CONFIDENCE_LEVEL link to the target event.
+func (b *ConfidenceLevelModifiedV3Builder) SubConfidenceLevel(target MetaTeller) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.Add(LinkType_SubConfidenceLevel, target)
+	return b
+}
+
+// SubConfidenceLevelByID adds a SUB_CONFIDENCE_LEVEL link to the event with the given ID.
+func (b *ConfidenceLevelModifiedV3Builder) SubConfidenceLevelByID(target string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.AddByID(LinkType_SubConfidenceLevel, target)
+	return b
+}
+
+// Subject adds a SUBJECT link to the target event.
+// At least one such link is required.
+func (b *ConfidenceLevelModifiedV3Builder) Subject(target MetaTeller) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.Add(LinkType_Subject, target)
+	return b
+}
+
+// SubjectByID adds a SUBJECT link to the event with the given ID.
+// At least one such link is required.
+func (b *ConfidenceLevelModifiedV3Builder) SubjectByID(target string) *ConfidenceLevelModifiedV3Builder {
+	b.event.Links.AddByID(LinkType_Subject, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildConfidenceLevelModifiedV3.
+func (b *ConfidenceLevelModifiedV3Builder) With(modifiers ...Modifier) *ConfidenceLevelModifiedV3Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *ConfidenceLevelModifiedV3Builder) Build() (*ConfidenceLevelModifiedV3, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new ConfidenceLevelModifiedV3: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type ConfidenceLevelModifiedV3 struct {
 	// Mandatory fields
 	Data  CLMV3Data    `json:"data"`
@@ -135,6 +381,131 @@ type ConfidenceLevelModifiedV3 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *ConfidenceLevelModifiedV3) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *ConfidenceLevelModifiedV3) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *ConfidenceLevelModifiedV3) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.ConfidenceLevelModifiedV3")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *ConfidenceLevelModifiedV3) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *ConfidenceLevelModifiedV3) deepCopy() ConfidenceLevelModifiedV3 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *ConfidenceLevelModifiedV3) equal(other *ConfidenceLevelModifiedV3) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *ConfidenceLevelModifiedV3) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *ConfidenceLevelModifiedV3) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type CLMV3Data struct {
@@ -147,6 +518,141 @@ type CLMV3Data struct {
 	Issuer     CLMV3DataIssuer `json:"issuer,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *CLMV3Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, string(s.Value))
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Issuer.isEmptyJSON() {
+		b = append(b, `,"issuer":`...)
+		if b, err = s.Issuer.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *CLMV3Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.Issuer.isEmptyJSON() &&
+		s.Name == "" &&
+		s.Value == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *CLMV3Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.CLMV3Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *CLMV3Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "issuer":
+		return true, s.Issuer.decodeJSON(member, opts)
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "value":
+		return true, decodeJSONString(member, (*string)(&s.Value), "eiffelevents.CLMV3DataValue")
+	}
+	if folded, ok := foldJSONName(name, "customData", "issuer", "name", "value"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *CLMV3Data) deepCopy() CLMV3Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Issuer = s.Issuer.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *CLMV3Data) equal(other *CLMV3Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.Issuer.equal(&other.Issuer) &&
+		s.Name == other.Name &&
+		s.Value == other.Value
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *CLMV3Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *CLMV3Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if !s.Issuer.isEmptyJSON() {
+		s.Issuer.validate(pointer+"/issuer", problems)
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.Value == "" {
+		addFieldProblem(problems, pointer+"/value", "mandatory field is missing")
+	} else {
+		if !s.Value.IsValid() {
+			addFieldProblem(problems, pointer+"/value", "%q is not a valid %s value", string(s.Value), "CLMV3DataValue")
+		}
+	}
+}
+
 type CLMV3DataIssuer struct {
 	// Mandatory fields
 
@@ -157,6 +663,120 @@ type CLMV3DataIssuer struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *CLMV3DataIssuer) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.Email != "" {
+		b = append(b, `,"email":`...)
+		b = appendJSONString(b, s.Email)
+	}
+	if s.Group != "" {
+		b = append(b, `,"group":`...)
+		b = appendJSONString(b, s.Group)
+	}
+	if s.ID != "" {
+		b = append(b, `,"id":`...)
+		b = appendJSONString(b, s.ID)
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *CLMV3DataIssuer) isEmptyJSON() bool {
+	return s.Email == "" &&
+		s.Group == "" &&
+		s.ID == "" &&
+		s.Name == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *CLMV3DataIssuer) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.CLMV3DataIssuer")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *CLMV3DataIssuer) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "email":
+		return true, decodeJSONString(member, &s.Email, "string")
+	case "group":
+		return true, decodeJSONString(member, &s.Group, "string")
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	}
+	if folded, ok := foldJSONName(name, "email", "group", "id", "name"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *CLMV3DataIssuer) deepCopy() CLMV3DataIssuer {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *CLMV3DataIssuer) equal(other *CLMV3DataIssuer) bool {
+	return s.Email == other.Email &&
+		s.Group == other.Group &&
+		s.ID == other.ID &&
+		s.Name == other.Name
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *CLMV3DataIssuer) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *CLMV3DataIssuer) validate(pointer string, problems *[]FieldError) {
+}
+
 type CLMV3DataValue string
 
 const (
@@ -164,3 +784,12 @@ const (
 	CLMV3DataValue_Failure      CLMV3DataValue = "FAILURE"
 	CLMV3DataValue_Inconclusive CLMV3DataValue = "INCONCLUSIVE"
 )
+
+// IsValid returns true if the value is one of the defined CLMV3DataValue values.
+func (e CLMV3DataValue) IsValid() bool {
+	switch e {
+	case CLMV3DataValue_Success, CLMV3DataValue_Failure, CLMV3DataValue_Inconclusive:
+		return true
+	}
+	return false
+}
@@ -18,6 +18,8 @@
 
 package eiffelevents
 
+import "github.com/tidwall/gjson"
+
 type CustomDataV1 struct {
 	// Mandatory fields
 	Key   string      `json:"key"`
@@ -26,3 +28,106 @@ type CustomDataV1 struct {
 	// Optional fields
 
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *CustomDataV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"key":`...)
+	b = appendJSONString(b, s.Key)
+	b = append(b, `,"value":`...)
+	if b, err = appendJSONValue(b, s.Value); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *CustomDataV1) isEmptyJSON() bool {
+	return s.Key == "" &&
+		s.Value == nil
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *CustomDataV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.CustomDataV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *CustomDataV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "key":
+		return true, decodeJSONString(member, &s.Key, "string")
+	case "value":
+		return true, decodeJSONValue(member, &s.Value)
+	}
+	if folded, ok := foldJSONName(name, "key", "value"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *CustomDataV1) deepCopy() CustomDataV1 {
+	c := *s
+	c.Value = deepCopyValue(s.Value)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *CustomDataV1) equal(other *CustomDataV1) bool {
+	return s.Key == other.Key &&
+		equalValues(s.Value, other.Value)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *CustomDataV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *CustomDataV1) validate(pointer string, problems *[]FieldError) {
+	if s.Key == "" {
+		addFieldProblem(problems, pointer+"/key", "mandatory field is missing")
+	}
+	if s.Value == nil {
+		addFieldProblem(problems, pointer+"/value", "mandatory field is missing")
+	}
+}
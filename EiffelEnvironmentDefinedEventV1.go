@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewEnvironmentDefinedV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewEnvironmentDefinedV1(modifiers ...Modifier) (*EnvironmentDefinedV1, error) {
+	return newEnvironmentDefinedV1(Generator{}, eventTypeTable["EiffelEnvironmentDefinedEvent"][1].latestVersion, modifiers)
+}
+
+// NewEnvironmentDefinedV1 creates a new EiffelEnvironmentDefinedEvent struct pointer like
+// the package-level NewEnvironmentDefinedV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewEnvironmentDefinedV1(modifiers ...Modifier) (*EnvironmentDefinedV1, error) {
+	return newEnvironmentDefinedV1(f.generator, eventTypeTable["EiffelEnvironmentDefinedEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newEnvironmentDefinedV1 creates a new EiffelEnvironmentDefinedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newEnvironmentDefinedV1(generator Generator, version string, modifiers []Modifier) (*EnvironmentDefinedV1, error) {
 	var event EnvironmentDefinedV1
 	event.Meta.Type = "EiffelEnvironmentDefinedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new EnvironmentDefinedV1: %w", err)
@@ -48,33 +61,51 @@ func NewEnvironmentDefinedV1(modifiers ...Modifier) (*EnvironmentDefinedV1, erro
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *EnvironmentDefinedV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *EDV1Data    `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *EnvironmentDefinedV1) DeepCopy() *EnvironmentDefinedV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *EnvironmentDefinedV1) Equal(other *EnvironmentDefinedV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *EnvironmentDefinedV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *EnvironmentDefinedV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *EnvironmentDefinedV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *EnvironmentDefinedV1) String() string {
 
 var (
 	_ CapabilityTeller = &EnvironmentDefinedV1{}
+	_ Event            = &EnvironmentDefinedV1{}
+	_ FieldGetter      = &EnvironmentDefinedV1{}
 	_ FieldSetter      = &EnvironmentDefinedV1{}
 	_ MetaTeller       = &EnvironmentDefinedV1{}
 )
@@ -120,6 +153,47 @@ func (e EnvironmentDefinedV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e EnvironmentDefinedV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *EnvironmentDefinedV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *EnvironmentDefinedV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *EnvironmentDefinedV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e EnvironmentDefinedV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e EnvironmentDefinedV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e EnvironmentDefinedV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,164 @@ func (e EnvironmentDefinedV1) SupportsSigning() bool {
 	return false
 }
 
+// EnvironmentDefinedV1Builder builds EiffelEnvironmentDefinedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildEnvironmentDefinedV1.
+type EnvironmentDefinedV1Builder struct {
+	event     EnvironmentDefinedV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildEnvironmentDefinedV1 returns a builder for major version 1
+// of EiffelEnvironmentDefinedEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildEnvironmentDefinedV1(modifiers ...Modifier) *EnvironmentDefinedV1Builder {
+	b := &EnvironmentDefinedV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelEnvironmentDefinedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildEnvironmentDefinedV1 returns a builder for EiffelEnvironmentDefinedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildEnvironmentDefinedV1 for details.
+func (f *Factory) BuildEnvironmentDefinedV1(modifiers ...Modifier) *EnvironmentDefinedV1Builder {
+	b := BuildEnvironmentDefinedV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *EnvironmentDefinedV1Builder) CustomData(value ...CustomDataV1) *EnvironmentDefinedV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// HostName sets the data.host.name field.
+func (b *EnvironmentDefinedV1Builder) HostName(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.Host.Name = value
+	return b
+}
+
+// User sets the data.host.user field.
+func (b *EnvironmentDefinedV1Builder) User(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.Host.User = value
+	return b
+}
+
+// Image sets the data.image field.
+func (b *EnvironmentDefinedV1Builder) Image(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.Image = value
+	return b
+}
+
+// Name sets the data.name field, which is mandatory.
+func (b *EnvironmentDefinedV1Builder) Name(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// URI sets the data.uri field.
+func (b *EnvironmentDefinedV1Builder) URI(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.URI = value
+	return b
+}
+
+// Version sets the data.version field.
+func (b *EnvironmentDefinedV1Builder) Version(value string) *EnvironmentDefinedV1Builder {
+	b.event.Data.Version = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *EnvironmentDefinedV1Builder) Cause(target MetaTeller) *EnvironmentDefinedV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *EnvironmentDefinedV1Builder) CauseByID(target string) *EnvironmentDefinedV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *EnvironmentDefinedV1Builder) Context(target MetaTeller) *EnvironmentDefinedV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *EnvironmentDefinedV1Builder) ContextByID(target string) *EnvironmentDefinedV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *EnvironmentDefinedV1Builder) FlowContext(target MetaTeller) *EnvironmentDefinedV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *EnvironmentDefinedV1Builder) FlowContextByID(target string) *EnvironmentDefinedV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *EnvironmentDefinedV1Builder) PreviousVersion(target MetaTeller) *EnvironmentDefinedV1Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *EnvironmentDefinedV1Builder) PreviousVersionByID(target string) *EnvironmentDefinedV1Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildEnvironmentDefinedV1.
+func (b *EnvironmentDefinedV1Builder) With(modifiers ...Modifier) *EnvironmentDefinedV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *EnvironmentDefinedV1Builder) Build() (*EnvironmentDefinedV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new EnvironmentDefinedV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type EnvironmentDefinedV1 struct {
 	// Mandatory fields
 	Data  EDV1Data     `json:"data"`
@@ -135,6 +367,131 @@ type EnvironmentDefinedV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *EnvironmentDefinedV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *EnvironmentDefinedV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *EnvironmentDefinedV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.EnvironmentDefinedV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *EnvironmentDefinedV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *EnvironmentDefinedV1) deepCopy() EnvironmentDefinedV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *EnvironmentDefinedV1) equal(other *EnvironmentDefinedV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *EnvironmentDefinedV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *EnvironmentDefinedV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type EDV1Data struct {
@@ -149,6 +506,152 @@ type EDV1Data struct {
 	Version    string         `json:"version,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *EDV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Host.isEmptyJSON() {
+		b = append(b, `,"host":`...)
+		if b, err = s.Host.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if s.Image != "" {
+		b = append(b, `,"image":`...)
+		b = appendJSONString(b, s.Image)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if s.Version != "" {
+		b = append(b, `,"version":`...)
+		b = appendJSONString(b, s.Version)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *EDV1Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.Host.isEmptyJSON() &&
+		s.Image == "" &&
+		s.Name == "" &&
+		s.URI == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *EDV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.EDV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *EDV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "host":
+		return true, s.Host.decodeJSON(member, opts)
+	case "image":
+		return true, decodeJSONString(member, &s.Image, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "customData", "host", "image", "name", "uri", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *EDV1Data) deepCopy() EDV1Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Host = s.Host.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *EDV1Data) equal(other *EDV1Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.Host.equal(&other.Host) &&
+		s.Image == other.Image &&
+		s.Name == other.Name &&
+		s.URI == other.URI &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *EDV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *EDV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if !s.Host.isEmptyJSON() {
+		s.Host.validate(pointer+"/host", problems)
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+}
+
 type EDV1DataHost struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -157,3 +660,103 @@ type EDV1DataHost struct {
 	// Optional fields
 
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *EDV1DataHost) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"user":`...)
+	b = appendJSONString(b, s.User)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *EDV1DataHost) isEmptyJSON() bool {
+	return s.Name == "" &&
+		s.User == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *EDV1DataHost) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.EDV1DataHost")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *EDV1DataHost) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "user":
+		return true, decodeJSONString(member, &s.User, "string")
+	}
+	if folded, ok := foldJSONName(name, "name", "user"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *EDV1DataHost) deepCopy() EDV1DataHost {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *EDV1DataHost) equal(other *EDV1DataHost) bool {
+	return s.Name == other.Name &&
+		s.User == other.User
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *EDV1DataHost) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *EDV1DataHost) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.User == "" {
+		addFieldProblem(problems, pointer+"/user", "mandatory field is missing")
+	}
+}
@@ -18,6 +18,8 @@
 
 package eiffelevents
 
+import "github.com/tidwall/gjson"
+
 // EventLinksV1 represents a slice of EventLinkV1 values with helper methods
 // for adding new links.
 type EventLinksV1 []EventLinkV1
@@ -25,21 +27,21 @@ type EventLinksV1 []EventLinkV1
 var _ LinkFinder = &EventLinksV1{}
 
 // Add adds a new link of the specified type to a target event.
-func (links *EventLinksV1) Add(linkType string, target MetaTeller) {
-	*links = append(*links, EventLinkV1{Target: target.ID(), Type: linkType})
+func (links *EventLinksV1) Add(linkType LinkType, target MetaTeller) {
+	*links = append(*links, EventLinkV1{Target: target.ID(), Type: string(linkType)})
 }
 
 // AddByID adds a new link of the specified type to a target event identified by an ID.
-func (links *EventLinksV1) AddByID(linkType string, target string) {
-	*links = append(*links, EventLinkV1{Target: target, Type: linkType})
+func (links *EventLinksV1) AddByID(linkType LinkType, target string) {
+	*links = append(*links, EventLinkV1{Target: target, Type: string(linkType)})
 }
 
 // FindAll returns the IDs of all links of the specified type, or an empty
 // slice if no such links are found.
-func (links EventLinksV1) FindAll(linkType string) []string {
+func (links EventLinksV1) FindAll(linkType LinkType) []string {
 	result := make([]string, 0, len(links))
 	for _, link := range links {
-		if link.Type == linkType {
+		if link.Type == string(linkType) {
 			result = append(result, link.Target)
 		}
 	}
@@ -48,9 +50,9 @@ func (links EventLinksV1) FindAll(linkType string) []string {
 
 // FindFirst returns the ID of the first encountered link of the specified
 // type, or an empty string if no such link is found.
-func (links EventLinksV1) FindFirst(linkType string) string {
+func (links EventLinksV1) FindFirst(linkType LinkType) string {
 	for _, link := range links {
-		if link.Type == linkType {
+		if link.Type == string(linkType) {
 			return link.Target
 		}
 	}
@@ -65,3 +67,113 @@ type EventLinkV1 struct {
 	// Optional fields
 	DomainID string `json:"domainId,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *EventLinkV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"target":`...)
+	b = appendJSONString(b, s.Target)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, s.Type)
+	if s.DomainID != "" {
+		b = append(b, `,"domainId":`...)
+		b = appendJSONString(b, s.DomainID)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *EventLinkV1) isEmptyJSON() bool {
+	return s.DomainID == "" &&
+		s.Target == "" &&
+		s.Type == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *EventLinkV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.EventLinkV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *EventLinkV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "domainId":
+		return true, decodeJSONString(member, &s.DomainID, "string")
+	case "target":
+		return true, decodeJSONString(member, &s.Target, "string")
+	case "type":
+		return true, decodeJSONString(member, &s.Type, "string")
+	}
+	if folded, ok := foldJSONName(name, "domainId", "target", "type"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *EventLinkV1) deepCopy() EventLinkV1 {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *EventLinkV1) equal(other *EventLinkV1) bool {
+	return s.DomainID == other.DomainID &&
+		s.Target == other.Target &&
+		s.Type == other.Type
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *EventLinkV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *EventLinkV1) validate(pointer string, problems *[]FieldError) {
+	if s.Target == "" {
+		addFieldProblem(problems, pointer+"/target", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/target", s.Target, "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	}
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	}
+}
@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewIssueDefinedV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewIssueDefinedV2(modifiers ...Modifier) (*IssueDefinedV2, error) {
+	return newIssueDefinedV2(Generator{}, eventTypeTable["EiffelIssueDefinedEvent"][2].latestVersion, modifiers)
+}
+
+// NewIssueDefinedV2 creates a new EiffelIssueDefinedEvent struct pointer like
+// the package-level NewIssueDefinedV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewIssueDefinedV2(modifiers ...Modifier) (*IssueDefinedV2, error) {
+	return newIssueDefinedV2(f.generator, eventTypeTable["EiffelIssueDefinedEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newIssueDefinedV2 creates a new EiffelIssueDefinedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newIssueDefinedV2(generator Generator, version string, modifiers []Modifier) (*IssueDefinedV2, error) {
 	var event IssueDefinedV2
 	event.Meta.Type = "EiffelIssueDefinedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new IssueDefinedV2: %w", err)
@@ -48,33 +61,51 @@ func NewIssueDefinedV2(modifiers ...Modifier) (*IssueDefinedV2, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *IssueDefinedV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *IDV2Data    `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV2      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *IssueDefinedV2) DeepCopy() *IssueDefinedV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *IssueDefinedV2) Equal(other *IssueDefinedV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *IssueDefinedV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *IssueDefinedV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *IssueDefinedV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *IssueDefinedV2) String() string {
 
 var (
 	_ CapabilityTeller = &IssueDefinedV2{}
+	_ Event            = &IssueDefinedV2{}
+	_ FieldGetter      = &IssueDefinedV2{}
 	_ FieldSetter      = &IssueDefinedV2{}
 	_ MetaTeller       = &IssueDefinedV2{}
 )
@@ -120,6 +153,47 @@ func (e IssueDefinedV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e IssueDefinedV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *IssueDefinedV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *IssueDefinedV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *IssueDefinedV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e IssueDefinedV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e IssueDefinedV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e IssueDefinedV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,146 @@ func (e IssueDefinedV2) SupportsSigning() bool {
 	return false
 }
 
+// IssueDefinedV2Builder builds EiffelIssueDefinedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildIssueDefinedV2.
+type IssueDefinedV2Builder struct {
+	event     IssueDefinedV2
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildIssueDefinedV2 returns a builder for major version 2
+// of EiffelIssueDefinedEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildIssueDefinedV2(modifiers ...Modifier) *IssueDefinedV2Builder {
+	b := &IssueDefinedV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelIssueDefinedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildIssueDefinedV2 returns a builder for EiffelIssueDefinedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildIssueDefinedV2 for details.
+func (f *Factory) BuildIssueDefinedV2(modifiers ...Modifier) *IssueDefinedV2Builder {
+	b := BuildIssueDefinedV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *IssueDefinedV2Builder) CustomData(value ...CustomDataV1) *IssueDefinedV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ID sets the data.id field.
+func (b *IssueDefinedV2Builder) ID(value string) *IssueDefinedV2Builder {
+	b.event.Data.ID = value
+	return b
+}
+
+// Title sets the data.title field.
+func (b *IssueDefinedV2Builder) Title(value string) *IssueDefinedV2Builder {
+	b.event.Data.Title = value
+	return b
+}
+
+// Tracker sets the data.tracker field.
+func (b *IssueDefinedV2Builder) Tracker(value string) *IssueDefinedV2Builder {
+	b.event.Data.Tracker = value
+	return b
+}
+
+// Type sets the data.type field.
+func (b *IssueDefinedV2Builder) Type(value IDV2DataType) *IssueDefinedV2Builder {
+	b.event.Data.Type = value
+	return b
+}
+
+// URI sets the data.uri field.
+func (b *IssueDefinedV2Builder) URI(value string) *IssueDefinedV2Builder {
+	b.event.Data.URI = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *IssueDefinedV2Builder) Cause(target MetaTeller) *IssueDefinedV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *IssueDefinedV2Builder) CauseByID(target string) *IssueDefinedV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *IssueDefinedV2Builder) Context(target MetaTeller) *IssueDefinedV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *IssueDefinedV2Builder) ContextByID(target string) *IssueDefinedV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *IssueDefinedV2Builder) FlowContext(target MetaTeller) *IssueDefinedV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *IssueDefinedV2Builder) FlowContextByID(target string) *IssueDefinedV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildIssueDefinedV2.
+func (b *IssueDefinedV2Builder) With(modifiers ...Modifier) *IssueDefinedV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *IssueDefinedV2Builder) Build() (*IssueDefinedV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new IssueDefinedV2: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type IssueDefinedV2 struct {
 	// Mandatory fields
 
@@ -134,6 +348,138 @@ type IssueDefinedV2 struct {
 	Data  IDV2Data     `json:"data,omitempty"`
 	Links EventLinksV1 `json:"links,omitempty"`
 	Meta  MetaV2       `json:"meta,omitempty"`
+
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *IssueDefinedV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *IssueDefinedV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *IssueDefinedV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.IssueDefinedV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *IssueDefinedV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *IssueDefinedV2) deepCopy() IssueDefinedV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *IssueDefinedV2) equal(other *IssueDefinedV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *IssueDefinedV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *IssueDefinedV2) validate(pointer string, problems *[]FieldError) {
+	if !s.Data.isEmptyJSON() {
+		s.Data.validate(pointer+"/data", problems)
+	}
+	if len(s.Links) != 0 {
+		for i := range s.Links {
+			elem := &s.Links[i]
+			elem.validate(indexPointer(pointer+"/links", i), problems)
+		}
+	}
+	if !s.Meta.isEmptyJSON() {
+		s.Meta.validate(pointer+"/meta", problems)
+	}
 }
 
 type IDV2Data struct {
@@ -148,6 +494,153 @@ type IDV2Data struct {
 	Title      string         `json:"title,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *IDV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	b = append(b, `,"tracker":`...)
+	b = appendJSONString(b, s.Tracker)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, string(s.Type))
+	b = append(b, `,"uri":`...)
+	b = appendJSONString(b, s.URI)
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.Title != "" {
+		b = append(b, `,"title":`...)
+		b = appendJSONString(b, s.Title)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *IDV2Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.ID == "" &&
+		s.Title == "" &&
+		s.Tracker == "" &&
+		s.Type == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *IDV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.IDV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *IDV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "title":
+		return true, decodeJSONString(member, &s.Title, "string")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	case "type":
+		return true, decodeJSONString(member, (*string)(&s.Type), "eiffelevents.IDV2DataType")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "customData", "id", "title", "tracker", "type", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *IDV2Data) deepCopy() IDV2Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *IDV2Data) equal(other *IDV2Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ID == other.ID &&
+		s.Title == other.Title &&
+		s.Tracker == other.Tracker &&
+		s.Type == other.Type &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *IDV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *IDV2Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	}
+	if s.Tracker == "" {
+		addFieldProblem(problems, pointer+"/tracker", "mandatory field is missing")
+	}
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	} else {
+		if !s.Type.IsValid() {
+			addFieldProblem(problems, pointer+"/type", "%q is not a valid %s value", string(s.Type), "IDV2DataType")
+		}
+	}
+	if s.URI == "" {
+		addFieldProblem(problems, pointer+"/uri", "mandatory field is missing")
+	}
+}
+
 type IDV2DataType string
 
 const (
@@ -158,3 +651,12 @@ const (
 	IDV2DataType_Requirement IDV2DataType = "REQUIREMENT"
 	IDV2DataType_Other       IDV2DataType = "OTHER"
 )
+
+// IsValid returns true if the value is one of the defined IDV2DataType values.
+func (e IDV2DataType) IsValid() bool {
+	switch e {
+	case IDV2DataType_Bug, IDV2DataType_Improvement, IDV2DataType_Feature, IDV2DataType_WorkItem, IDV2DataType_Requirement, IDV2DataType_Other:
+		return true
+	}
+	return false
+}
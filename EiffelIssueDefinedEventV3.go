@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewIssueDefinedV3 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 3.x.x
 // currently known by this SDK.
 func NewIssueDefinedV3(modifiers ...Modifier) (*IssueDefinedV3, error) {
+	return newIssueDefinedV3(Generator{}, eventTypeTable["EiffelIssueDefinedEvent"][3].latestVersion, modifiers)
+}
+
+// NewIssueDefinedV3 creates a new EiffelIssueDefinedEvent struct pointer like
+// the package-level NewIssueDefinedV3, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewIssueDefinedV3(modifiers ...Modifier) (*IssueDefinedV3, error) {
+	return newIssueDefinedV3(f.generator, eventTypeTable["EiffelIssueDefinedEvent"][3].latestVersion, f.withModifiers(modifiers))
+}
+
+// newIssueDefinedV3 creates a new EiffelIssueDefinedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newIssueDefinedV3(generator Generator, version string, modifiers []Modifier) (*IssueDefinedV3, error) {
 	var event IssueDefinedV3
 	event.Meta.Type = "EiffelIssueDefinedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][3].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new IssueDefinedV3: %w", err)
@@ -48,33 +61,51 @@ func NewIssueDefinedV3(modifiers ...Modifier) (*IssueDefinedV3, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *IssueDefinedV3) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *IDV3Data    `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV3      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *IssueDefinedV3) DeepCopy() *IssueDefinedV3 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *IssueDefinedV3) Equal(other *IssueDefinedV3) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *IssueDefinedV3) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *IssueDefinedV3) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *IssueDefinedV3) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *IssueDefinedV3) String() string {
 
 var (
 	_ CapabilityTeller = &IssueDefinedV3{}
+	_ Event            = &IssueDefinedV3{}
+	_ FieldGetter      = &IssueDefinedV3{}
 	_ FieldSetter      = &IssueDefinedV3{}
 	_ MetaTeller       = &IssueDefinedV3{}
 )
@@ -120,6 +153,47 @@ func (e IssueDefinedV3) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e IssueDefinedV3) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *IssueDefinedV3) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *IssueDefinedV3) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *IssueDefinedV3) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e IssueDefinedV3) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e IssueDefinedV3) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e IssueDefinedV3) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: e.Meta.Source.Serializer,
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,146 @@ func (e IssueDefinedV3) SupportsSigning() bool {
 	return true
 }
 
+// IssueDefinedV3Builder builds EiffelIssueDefinedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildIssueDefinedV3.
+type IssueDefinedV3Builder struct {
+	event     IssueDefinedV3
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildIssueDefinedV3 returns a builder for major version 3
+// of EiffelIssueDefinedEvent. The event version is set to the most recent
+// 3.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildIssueDefinedV3(modifiers ...Modifier) *IssueDefinedV3Builder {
+	b := &IssueDefinedV3Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelIssueDefinedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][3].latestVersion
+	return b
+}
+
+// BuildIssueDefinedV3 returns a builder for EiffelIssueDefinedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildIssueDefinedV3 for details.
+func (f *Factory) BuildIssueDefinedV3(modifiers ...Modifier) *IssueDefinedV3Builder {
+	b := BuildIssueDefinedV3(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *IssueDefinedV3Builder) CustomData(value ...CustomDataV1) *IssueDefinedV3Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ID sets the data.id field.
+func (b *IssueDefinedV3Builder) ID(value string) *IssueDefinedV3Builder {
+	b.event.Data.ID = value
+	return b
+}
+
+// Title sets the data.title field.
+func (b *IssueDefinedV3Builder) Title(value string) *IssueDefinedV3Builder {
+	b.event.Data.Title = value
+	return b
+}
+
+// Tracker sets the data.tracker field.
+func (b *IssueDefinedV3Builder) Tracker(value string) *IssueDefinedV3Builder {
+	b.event.Data.Tracker = value
+	return b
+}
+
+// Type sets the data.type field.
+func (b *IssueDefinedV3Builder) Type(value IDV3DataType) *IssueDefinedV3Builder {
+	b.event.Data.Type = value
+	return b
+}
+
+// URI sets the data.uri field.
+func (b *IssueDefinedV3Builder) URI(value string) *IssueDefinedV3Builder {
+	b.event.Data.URI = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *IssueDefinedV3Builder) Cause(target MetaTeller) *IssueDefinedV3Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *IssueDefinedV3Builder) CauseByID(target string) *IssueDefinedV3Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *IssueDefinedV3Builder) Context(target MetaTeller) *IssueDefinedV3Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *IssueDefinedV3Builder) ContextByID(target string) *IssueDefinedV3Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *IssueDefinedV3Builder) FlowContext(target MetaTeller) *IssueDefinedV3Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *IssueDefinedV3Builder) FlowContextByID(target string) *IssueDefinedV3Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildIssueDefinedV3.
+func (b *IssueDefinedV3Builder) With(modifiers ...Modifier) *IssueDefinedV3Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *IssueDefinedV3Builder) Build() (*IssueDefinedV3, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new IssueDefinedV3: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type IssueDefinedV3 struct {
 	// Mandatory fields
 
@@ -134,6 +348,138 @@ type IssueDefinedV3 struct {
 	Data  IDV3Data     `json:"data,omitempty"`
 	Links EventLinksV1 `json:"links,omitempty"`
 	Meta  MetaV3       `json:"meta,omitempty"`
+
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *IssueDefinedV3) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *IssueDefinedV3) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *IssueDefinedV3) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.IssueDefinedV3")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *IssueDefinedV3) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *IssueDefinedV3) deepCopy() IssueDefinedV3 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *IssueDefinedV3) equal(other *IssueDefinedV3) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *IssueDefinedV3) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *IssueDefinedV3) validate(pointer string, problems *[]FieldError) {
+	if !s.Data.isEmptyJSON() {
+		s.Data.validate(pointer+"/data", problems)
+	}
+	if len(s.Links) != 0 {
+		for i := range s.Links {
+			elem := &s.Links[i]
+			elem.validate(indexPointer(pointer+"/links", i), problems)
+		}
+	}
+	if !s.Meta.isEmptyJSON() {
+		s.Meta.validate(pointer+"/meta", problems)
+	}
 }
 
 type IDV3Data struct {
@@ -148,6 +494,153 @@ type IDV3Data struct {
 	Title      string         `json:"title,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *IDV3Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	b = append(b, `,"tracker":`...)
+	b = appendJSONString(b, s.Tracker)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, string(s.Type))
+	b = append(b, `,"uri":`...)
+	b = appendJSONString(b, s.URI)
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.Title != "" {
+		b = append(b, `,"title":`...)
+		b = appendJSONString(b, s.Title)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *IDV3Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.ID == "" &&
+		s.Title == "" &&
+		s.Tracker == "" &&
+		s.Type == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *IDV3Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.IDV3Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *IDV3Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "title":
+		return true, decodeJSONString(member, &s.Title, "string")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	case "type":
+		return true, decodeJSONString(member, (*string)(&s.Type), "eiffelevents.IDV3DataType")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "customData", "id", "title", "tracker", "type", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *IDV3Data) deepCopy() IDV3Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *IDV3Data) equal(other *IDV3Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ID == other.ID &&
+		s.Title == other.Title &&
+		s.Tracker == other.Tracker &&
+		s.Type == other.Type &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *IDV3Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *IDV3Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	}
+	if s.Tracker == "" {
+		addFieldProblem(problems, pointer+"/tracker", "mandatory field is missing")
+	}
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	} else {
+		if !s.Type.IsValid() {
+			addFieldProblem(problems, pointer+"/type", "%q is not a valid %s value", string(s.Type), "IDV3DataType")
+		}
+	}
+	if s.URI == "" {
+		addFieldProblem(problems, pointer+"/uri", "mandatory field is missing")
+	}
+}
+
 type IDV3DataType string
 
 const (
@@ -158,3 +651,12 @@ const (
 	IDV3DataType_Requirement IDV3DataType = "REQUIREMENT"
 	IDV3DataType_Other       IDV3DataType = "OTHER"
 )
+
+// IsValid returns true if the value is one of the defined IDV3DataType values.
+func (e IDV3DataType) IsValid() bool {
+	switch e {
+	case IDV3DataType_Bug, IDV3DataType_Improvement, IDV3DataType_Feature, IDV3DataType_WorkItem, IDV3DataType_Requirement, IDV3DataType_Other:
+		return true
+	}
+	return false
+}
@@ -18,6 +18,8 @@
 
 package eiffelevents
 
+import "github.com/tidwall/gjson"
+
 type MetaV1 struct {
 	// Mandatory fields
 	ID      string `json:"id"`
@@ -31,6 +33,169 @@ type MetaV1 struct {
 	Tags     []string       `json:"tags,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	b = append(b, `,"time":`...)
+	b = appendJSONInt(b, s.Time)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, s.Type)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if !s.Security.isEmptyJSON() {
+		b = append(b, `,"security":`...)
+		if b, err = s.Security.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Source.isEmptyJSON() {
+		b = append(b, `,"source":`...)
+		if b, err = s.Source.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Tags) != 0 {
+		b = append(b, `,"tags":`...)
+		if b, err = appendJSONArray(b, s.Tags, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV1) isEmptyJSON() bool {
+	return s.ID == "" &&
+		s.Security.isEmptyJSON() &&
+		s.Source.isEmptyJSON() &&
+		len(s.Tags) == 0 &&
+		s.Time == 0 &&
+		s.Type == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "security":
+		return true, s.Security.decodeJSON(member, opts)
+	case "source":
+		return true, s.Source.decodeJSON(member, opts)
+	case "tags":
+		return true, decodeJSONArray(member, &s.Tags, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "time":
+		return true, decodeJSONInt(member, &s.Time, "int64")
+	case "type":
+		return true, decodeJSONString(member, &s.Type, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "id", "security", "source", "tags", "time", "type", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV1) deepCopy() MetaV1 {
+	c := *s
+	c.Security = s.Security.deepCopy()
+	c.Source = s.Source.deepCopy()
+	c.Tags = deepCopySlice(s.Tags, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV1) equal(other *MetaV1) bool {
+	return s.ID == other.ID &&
+		s.Security.equal(&other.Security) &&
+		s.Source.equal(&other.Source) &&
+		equalSlices(s.Tags, other.Tags, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		s.Time == other.Time &&
+		s.Type == other.Type &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV1) validate(pointer string, problems *[]FieldError) {
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/id", s.ID, "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	}
+	if !s.Security.isEmptyJSON() {
+		s.Security.validate(pointer+"/security", problems)
+	}
+	if !s.Source.isEmptyJSON() {
+		s.Source.validate(pointer+"/source", problems)
+	}
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
+
 type MetaV1Security struct {
 	// Mandatory fields
 
@@ -38,6 +203,102 @@ type MetaV1Security struct {
 	SDM MetaV1SecuritySDM `json:"sdm,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV1Security) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if !s.SDM.isEmptyJSON() {
+		b = append(b, `,"sdm":`...)
+		if b, err = s.SDM.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV1Security) isEmptyJSON() bool {
+	return s.SDM.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV1Security) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV1Security")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV1Security) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "sdm":
+		return true, s.SDM.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "sdm"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV1Security) deepCopy() MetaV1Security {
+	c := *s
+	c.SDM = s.SDM.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV1Security) equal(other *MetaV1Security) bool {
+	return s.SDM.equal(&other.SDM)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV1Security) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV1Security) validate(pointer string, problems *[]FieldError) {
+	if !s.SDM.isEmptyJSON() {
+		s.SDM.validate(pointer+"/sdm", problems)
+	}
+}
+
 type MetaV1SecuritySDM struct {
 	// Mandatory fields
 	AuthorIdentity  string `json:"authorIdentity"`
@@ -47,6 +308,106 @@ type MetaV1SecuritySDM struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV1SecuritySDM) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"authorIdentity":`...)
+	b = appendJSONString(b, s.AuthorIdentity)
+	b = append(b, `,"encryptedDigest":`...)
+	b = appendJSONString(b, s.EncryptedDigest)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV1SecuritySDM) isEmptyJSON() bool {
+	return s.AuthorIdentity == "" &&
+		s.EncryptedDigest == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV1SecuritySDM) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV1SecuritySDM")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV1SecuritySDM) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "authorIdentity":
+		return true, decodeJSONString(member, &s.AuthorIdentity, "string")
+	case "encryptedDigest":
+		return true, decodeJSONString(member, &s.EncryptedDigest, "string")
+	}
+	if folded, ok := foldJSONName(name, "authorIdentity", "encryptedDigest"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV1SecuritySDM) deepCopy() MetaV1SecuritySDM {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV1SecuritySDM) equal(other *MetaV1SecuritySDM) bool {
+	return s.AuthorIdentity == other.AuthorIdentity &&
+		s.EncryptedDigest == other.EncryptedDigest
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV1SecuritySDM) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV1SecuritySDM) validate(pointer string, problems *[]FieldError) {
+	if s.AuthorIdentity == "" {
+		addFieldProblem(problems, pointer+"/authorIdentity", "mandatory field is missing")
+	}
+	if s.EncryptedDigest == "" {
+		addFieldProblem(problems, pointer+"/encryptedDigest", "mandatory field is missing")
+	}
+}
+
 type MetaV1Source struct {
 	// Mandatory fields
 
@@ -58,6 +419,134 @@ type MetaV1Source struct {
 	URI        string                 `json:"uri,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV1Source) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.DomainID != "" {
+		b = append(b, `,"domainId":`...)
+		b = appendJSONString(b, s.DomainID)
+	}
+	if s.Host != "" {
+		b = append(b, `,"host":`...)
+		b = appendJSONString(b, s.Host)
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if !s.Serializer.isEmptyJSON() {
+		b = append(b, `,"serializer":`...)
+		if b, err = s.Serializer.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV1Source) isEmptyJSON() bool {
+	return s.DomainID == "" &&
+		s.Host == "" &&
+		s.Name == "" &&
+		s.Serializer.isEmptyJSON() &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV1Source) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV1Source")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV1Source) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "domainId":
+		return true, decodeJSONString(member, &s.DomainID, "string")
+	case "host":
+		return true, decodeJSONString(member, &s.Host, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "serializer":
+		return true, s.Serializer.decodeJSON(member, opts)
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "domainId", "host", "name", "serializer", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV1Source) deepCopy() MetaV1Source {
+	c := *s
+	c.Serializer = s.Serializer.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV1Source) equal(other *MetaV1Source) bool {
+	return s.DomainID == other.DomainID &&
+		s.Host == other.Host &&
+		s.Name == other.Name &&
+		s.Serializer.equal(&other.Serializer) &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV1Source) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV1Source) validate(pointer string, problems *[]FieldError) {
+	if !s.Serializer.isEmptyJSON() {
+		s.Serializer.validate(pointer+"/serializer", problems)
+	}
+}
+
 type MetaV1SourceSerializer struct {
 	// Mandatory fields
 	ArtifactID string `json:"artifactId"`
@@ -67,3 +556,112 @@ type MetaV1SourceSerializer struct {
 	// Optional fields
 
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV1SourceSerializer) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"artifactId":`...)
+	b = appendJSONString(b, s.ArtifactID)
+	b = append(b, `,"groupId":`...)
+	b = appendJSONString(b, s.GroupID)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV1SourceSerializer) isEmptyJSON() bool {
+	return s.ArtifactID == "" &&
+		s.GroupID == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV1SourceSerializer) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV1SourceSerializer")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV1SourceSerializer) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "artifactId":
+		return true, decodeJSONString(member, &s.ArtifactID, "string")
+	case "groupId":
+		return true, decodeJSONString(member, &s.GroupID, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "artifactId", "groupId", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV1SourceSerializer) deepCopy() MetaV1SourceSerializer {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV1SourceSerializer) equal(other *MetaV1SourceSerializer) bool {
+	return s.ArtifactID == other.ArtifactID &&
+		s.GroupID == other.GroupID &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV1SourceSerializer) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV1SourceSerializer) validate(pointer string, problems *[]FieldError) {
+	if s.ArtifactID == "" {
+		addFieldProblem(problems, pointer+"/artifactId", "mandatory field is missing")
+	}
+	if s.GroupID == "" {
+		addFieldProblem(problems, pointer+"/groupId", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
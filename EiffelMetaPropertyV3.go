@@ -18,6 +18,8 @@
 
 package eiffelevents
 
+import "github.com/tidwall/gjson"
+
 type MetaV3 struct {
 	// Mandatory fields
 	ID      string `json:"id"`
@@ -32,6 +34,177 @@ type MetaV3 struct {
 	Tags      []string       `json:"tags,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV3) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	b = append(b, `,"time":`...)
+	b = appendJSONInt(b, s.Time)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, s.Type)
+	b = append(b, `,"version":`...)
+	b = appendJSONString(b, s.Version)
+	if s.SchemaURI != "" {
+		b = append(b, `,"schemaUri":`...)
+		b = appendJSONString(b, s.SchemaURI)
+	}
+	if !s.Security.isEmptyJSON() {
+		b = append(b, `,"security":`...)
+		if b, err = s.Security.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Source.isEmptyJSON() {
+		b = append(b, `,"source":`...)
+		if b, err = s.Source.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Tags) != 0 {
+		b = append(b, `,"tags":`...)
+		if b, err = appendJSONArray(b, s.Tags, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV3) isEmptyJSON() bool {
+	return s.ID == "" &&
+		s.SchemaURI == "" &&
+		s.Security.isEmptyJSON() &&
+		s.Source.isEmptyJSON() &&
+		len(s.Tags) == 0 &&
+		s.Time == 0 &&
+		s.Type == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV3) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV3")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV3) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "schemaUri":
+		return true, decodeJSONString(member, &s.SchemaURI, "string")
+	case "security":
+		return true, s.Security.decodeJSON(member, opts)
+	case "source":
+		return true, s.Source.decodeJSON(member, opts)
+	case "tags":
+		return true, decodeJSONArray(member, &s.Tags, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "time":
+		return true, decodeJSONInt(member, &s.Time, "int64")
+	case "type":
+		return true, decodeJSONString(member, &s.Type, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "id", "schemaUri", "security", "source", "tags", "time", "type", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV3) deepCopy() MetaV3 {
+	c := *s
+	c.Security = s.Security.deepCopy()
+	c.Source = s.Source.deepCopy()
+	c.Tags = deepCopySlice(s.Tags, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV3) equal(other *MetaV3) bool {
+	return s.ID == other.ID &&
+		s.SchemaURI == other.SchemaURI &&
+		s.Security.equal(&other.Security) &&
+		s.Source.equal(&other.Source) &&
+		equalSlices(s.Tags, other.Tags, func(a *string, b *string) bool {
+			return *a == *b
+		}) &&
+		s.Time == other.Time &&
+		s.Type == other.Type &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV3) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV3) validate(pointer string, problems *[]FieldError) {
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/id", s.ID, "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	}
+	if !s.Security.isEmptyJSON() {
+		s.Security.validate(pointer+"/security", problems)
+	}
+	if !s.Source.isEmptyJSON() {
+		s.Source.validate(pointer+"/source", problems)
+	}
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	}
+	if s.Version == "" {
+		addFieldProblem(problems, pointer+"/version", "mandatory field is missing")
+	}
+}
+
 type MetaV3Security struct {
 	// Mandatory fields
 	AuthorIdentity string `json:"authorIdentity"`
@@ -41,6 +214,128 @@ type MetaV3Security struct {
 	SequenceProtection  []MetaV3SecuritySequenceProtection `json:"sequenceProtection,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV3Security) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"authorIdentity":`...)
+	b = appendJSONString(b, s.AuthorIdentity)
+	if !s.IntegrityProtection.isEmptyJSON() {
+		b = append(b, `,"integrityProtection":`...)
+		if b, err = s.IntegrityProtection.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.SequenceProtection) != 0 {
+		b = append(b, `,"sequenceProtection":`...)
+		if b, err = appendJSONArray(b, s.SequenceProtection, (*MetaV3SecuritySequenceProtection).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV3Security) isEmptyJSON() bool {
+	return s.AuthorIdentity == "" &&
+		s.IntegrityProtection.isEmptyJSON() &&
+		len(s.SequenceProtection) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV3Security) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV3Security")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV3Security) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "authorIdentity":
+		return true, decodeJSONString(member, &s.AuthorIdentity, "string")
+	case "integrityProtection":
+		return true, s.IntegrityProtection.decodeJSON(member, opts)
+	case "sequenceProtection":
+		return true, decodeJSONArray(member, &s.SequenceProtection, "[]eiffelevents.MetaV3SecuritySequenceProtection", opts, (*MetaV3SecuritySequenceProtection).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "authorIdentity", "integrityProtection", "sequenceProtection"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV3Security) deepCopy() MetaV3Security {
+	c := *s
+	c.IntegrityProtection = s.IntegrityProtection.deepCopy()
+	c.SequenceProtection = deepCopySlice(s.SequenceProtection, (*MetaV3SecuritySequenceProtection).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV3Security) equal(other *MetaV3Security) bool {
+	return s.AuthorIdentity == other.AuthorIdentity &&
+		s.IntegrityProtection.equal(&other.IntegrityProtection) &&
+		equalSlices(s.SequenceProtection, other.SequenceProtection, (*MetaV3SecuritySequenceProtection).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV3Security) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV3Security) validate(pointer string, problems *[]FieldError) {
+	if s.AuthorIdentity == "" {
+		addFieldProblem(problems, pointer+"/authorIdentity", "mandatory field is missing")
+	}
+	if !s.IntegrityProtection.isEmptyJSON() {
+		s.IntegrityProtection.validate(pointer+"/integrityProtection", problems)
+	}
+	if len(s.SequenceProtection) != 0 {
+		for i := range s.SequenceProtection {
+			elem := &s.SequenceProtection[i]
+			elem.validate(indexPointer(pointer+"/sequenceProtection", i), problems)
+		}
+	}
+}
+
 type MetaV3SecurityIntegrityProtection struct {
 	// Mandatory fields
 	Alg       MetaV3SecurityIntegrityProtectionAlg `json:"alg"`
@@ -50,6 +345,118 @@ type MetaV3SecurityIntegrityProtection struct {
 	PublicKey string `json:"publicKey,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV3SecurityIntegrityProtection) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"alg":`...)
+	b = appendJSONString(b, string(s.Alg))
+	b = append(b, `,"signature":`...)
+	b = appendJSONString(b, s.Signature)
+	if s.PublicKey != "" {
+		b = append(b, `,"publicKey":`...)
+		b = appendJSONString(b, s.PublicKey)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV3SecurityIntegrityProtection) isEmptyJSON() bool {
+	return s.Alg == "" &&
+		s.PublicKey == "" &&
+		s.Signature == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV3SecurityIntegrityProtection) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV3SecurityIntegrityProtection")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV3SecurityIntegrityProtection) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "alg":
+		return true, decodeJSONString(member, (*string)(&s.Alg), "eiffelevents.MetaV3SecurityIntegrityProtectionAlg")
+	case "publicKey":
+		return true, decodeJSONString(member, &s.PublicKey, "string")
+	case "signature":
+		return true, decodeJSONString(member, &s.Signature, "string")
+	}
+	if folded, ok := foldJSONName(name, "alg", "publicKey", "signature"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV3SecurityIntegrityProtection) deepCopy() MetaV3SecurityIntegrityProtection {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV3SecurityIntegrityProtection) equal(other *MetaV3SecurityIntegrityProtection) bool {
+	return s.Alg == other.Alg &&
+		s.PublicKey == other.PublicKey &&
+		s.Signature == other.Signature
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV3SecurityIntegrityProtection) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV3SecurityIntegrityProtection) validate(pointer string, problems *[]FieldError) {
+	if s.Alg == "" {
+		addFieldProblem(problems, pointer+"/alg", "mandatory field is missing")
+	} else {
+		if !s.Alg.IsValid() {
+			addFieldProblem(problems, pointer+"/alg", "%q is not a valid %s value", string(s.Alg), "MetaV3SecurityIntegrityProtectionAlg")
+		}
+	}
+	if s.Signature == "" {
+		addFieldProblem(problems, pointer+"/signature", "mandatory field is missing")
+	}
+}
+
 type MetaV3SecurityIntegrityProtectionAlg string
 
 const (
@@ -67,6 +474,15 @@ const (
 	MetaV3SecurityIntegrityProtectionAlg_PS512 MetaV3SecurityIntegrityProtectionAlg = "PS512"
 )
 
+// IsValid returns true if the value is one of the defined MetaV3SecurityIntegrityProtectionAlg values.
+func (e MetaV3SecurityIntegrityProtectionAlg) IsValid() bool {
+	switch e {
+	case MetaV3SecurityIntegrityProtectionAlg_HS256, MetaV3SecurityIntegrityProtectionAlg_HS384, MetaV3SecurityIntegrityProtectionAlg_HS512, MetaV3SecurityIntegrityProtectionAlg_RS256, MetaV3SecurityIntegrityProtectionAlg_RS384, MetaV3SecurityIntegrityProtectionAlg_RS512, MetaV3SecurityIntegrityProtectionAlg_ES256, MetaV3SecurityIntegrityProtectionAlg_ES384, MetaV3SecurityIntegrityProtectionAlg_ES512, MetaV3SecurityIntegrityProtectionAlg_PS256, MetaV3SecurityIntegrityProtectionAlg_PS384, MetaV3SecurityIntegrityProtectionAlg_PS512:
+		return true
+	}
+	return false
+}
+
 type MetaV3SecuritySequenceProtection struct {
 	// Mandatory fields
 	Position     int64  `json:"position"`
@@ -76,6 +492,103 @@ type MetaV3SecuritySequenceProtection struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV3SecuritySequenceProtection) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"position":`...)
+	b = appendJSONInt(b, s.Position)
+	b = append(b, `,"sequenceName":`...)
+	b = appendJSONString(b, s.SequenceName)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV3SecuritySequenceProtection) isEmptyJSON() bool {
+	return s.Position == 0 &&
+		s.SequenceName == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV3SecuritySequenceProtection) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV3SecuritySequenceProtection")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV3SecuritySequenceProtection) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "position":
+		return true, decodeJSONInt(member, &s.Position, "int64")
+	case "sequenceName":
+		return true, decodeJSONString(member, &s.SequenceName, "string")
+	}
+	if folded, ok := foldJSONName(name, "position", "sequenceName"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV3SecuritySequenceProtection) deepCopy() MetaV3SecuritySequenceProtection {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV3SecuritySequenceProtection) equal(other *MetaV3SecuritySequenceProtection) bool {
+	return s.Position == other.Position &&
+		s.SequenceName == other.SequenceName
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV3SecuritySequenceProtection) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV3SecuritySequenceProtection) validate(pointer string, problems *[]FieldError) {
+	if s.SequenceName == "" {
+		addFieldProblem(problems, pointer+"/sequenceName", "mandatory field is missing")
+	}
+}
+
 type MetaV3Source struct {
 	// Mandatory fields
 
@@ -86,3 +599,128 @@ type MetaV3Source struct {
 	Serializer string `json:"serializer,omitempty"`
 	URI        string `json:"uri,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *MetaV3Source) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.DomainID != "" {
+		b = append(b, `,"domainId":`...)
+		b = appendJSONString(b, s.DomainID)
+	}
+	if s.Host != "" {
+		b = append(b, `,"host":`...)
+		b = appendJSONString(b, s.Host)
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if s.Serializer != "" {
+		b = append(b, `,"serializer":`...)
+		b = appendJSONString(b, s.Serializer)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *MetaV3Source) isEmptyJSON() bool {
+	return s.DomainID == "" &&
+		s.Host == "" &&
+		s.Name == "" &&
+		s.Serializer == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *MetaV3Source) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.MetaV3Source")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *MetaV3Source) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "domainId":
+		return true, decodeJSONString(member, &s.DomainID, "string")
+	case "host":
+		return true, decodeJSONString(member, &s.Host, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "serializer":
+		return true, decodeJSONString(member, &s.Serializer, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "domainId", "host", "name", "serializer", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *MetaV3Source) deepCopy() MetaV3Source {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *MetaV3Source) equal(other *MetaV3Source) bool {
+	return s.DomainID == other.DomainID &&
+		s.Host == other.Host &&
+		s.Name == other.Name &&
+		s.Serializer == other.Serializer &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *MetaV3Source) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *MetaV3Source) validate(pointer string, problems *[]FieldError) {
+	if s.Serializer != "" {
+		checkPattern(problems, pointer+"/serializer", s.Serializer, "^pkg:")
+	}
+}
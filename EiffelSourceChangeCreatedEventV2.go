@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewSourceChangeCreatedV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewSourceChangeCreatedV2(modifiers ...Modifier) (*SourceChangeCreatedV2, error) {
+	return newSourceChangeCreatedV2(Generator{}, eventTypeTable["EiffelSourceChangeCreatedEvent"][2].latestVersion, modifiers)
+}
+
+// NewSourceChangeCreatedV2 creates a new EiffelSourceChangeCreatedEvent struct pointer like
+// the package-level NewSourceChangeCreatedV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewSourceChangeCreatedV2(modifiers ...Modifier) (*SourceChangeCreatedV2, error) {
+	return newSourceChangeCreatedV2(f.generator, eventTypeTable["EiffelSourceChangeCreatedEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newSourceChangeCreatedV2 creates a new EiffelSourceChangeCreatedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newSourceChangeCreatedV2(generator Generator, version string, modifiers []Modifier) (*SourceChangeCreatedV2, error) {
 	var event SourceChangeCreatedV2
 	event.Meta.Type = "EiffelSourceChangeCreatedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new SourceChangeCreatedV2: %w", err)
@@ -48,33 +61,51 @@ func NewSourceChangeCreatedV2(modifiers ...Modifier) (*SourceChangeCreatedV2, er
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *SourceChangeCreatedV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *SCCV2Data   `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *SourceChangeCreatedV2) DeepCopy() *SourceChangeCreatedV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *SourceChangeCreatedV2) Equal(other *SourceChangeCreatedV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *SourceChangeCreatedV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *SourceChangeCreatedV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *SourceChangeCreatedV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *SourceChangeCreatedV2) String() string {
 
 var (
 	_ CapabilityTeller = &SourceChangeCreatedV2{}
+	_ Event            = &SourceChangeCreatedV2{}
+	_ FieldGetter      = &SourceChangeCreatedV2{}
 	_ FieldSetter      = &SourceChangeCreatedV2{}
 	_ MetaTeller       = &SourceChangeCreatedV2{}
 )
@@ -120,6 +153,47 @@ func (e SourceChangeCreatedV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e SourceChangeCreatedV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *SourceChangeCreatedV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *SourceChangeCreatedV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *SourceChangeCreatedV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e SourceChangeCreatedV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e SourceChangeCreatedV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e SourceChangeCreatedV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,335 @@ func (e SourceChangeCreatedV2) SupportsSigning() bool {
 	return false
 }
 
+// SourceChangeCreatedV2Builder builds EiffelSourceChangeCreatedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildSourceChangeCreatedV2.
+type SourceChangeCreatedV2Builder struct {
+	event       SourceChangeCreatedV2
+	generator   Generator
+	modifiers   []Modifier
+	setRevision bool // Has Revision been called?
+}
+
+// BuildSourceChangeCreatedV2 returns a builder for major version 2
+// of EiffelSourceChangeCreatedEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildSourceChangeCreatedV2(modifiers ...Modifier) *SourceChangeCreatedV2Builder {
+	b := &SourceChangeCreatedV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelSourceChangeCreatedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildSourceChangeCreatedV2 returns a builder for EiffelSourceChangeCreatedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildSourceChangeCreatedV2 for details.
+func (f *Factory) BuildSourceChangeCreatedV2(modifiers ...Modifier) *SourceChangeCreatedV2Builder {
+	b := BuildSourceChangeCreatedV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Email sets the data.author.email field.
+func (b *SourceChangeCreatedV2Builder) Email(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Author.Email = value
+	return b
+}
+
+// Group sets the data.author.group field.
+func (b *SourceChangeCreatedV2Builder) Group(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Author.Group = value
+	return b
+}
+
+// AuthorID sets the data.author.id field.
+func (b *SourceChangeCreatedV2Builder) AuthorID(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Author.ID = value
+	return b
+}
+
+// Name sets the data.author.name field.
+func (b *SourceChangeCreatedV2Builder) Name(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Author.Name = value
+	return b
+}
+
+// CcCompositeIdentifierBranch sets the data.ccCompositeIdentifier.branch field.
+func (b *SourceChangeCreatedV2Builder) CcCompositeIdentifierBranch(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.CcCompositeIdentifier.Branch = value
+	return b
+}
+
+// ConfigSpec sets the data.ccCompositeIdentifier.configSpec field.
+func (b *SourceChangeCreatedV2Builder) ConfigSpec(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.CcCompositeIdentifier.ConfigSpec = value
+	return b
+}
+
+// Vobs sets the data.ccCompositeIdentifier.vobs field.
+func (b *SourceChangeCreatedV2Builder) Vobs(value ...string) *SourceChangeCreatedV2Builder {
+	b.event.Data.CcCompositeIdentifier.Vobs = value
+	return b
+}
+
+// Deletions sets the data.change.deletions field.
+func (b *SourceChangeCreatedV2Builder) Deletions(value int64) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.Deletions = value
+	return b
+}
+
+// Details sets the data.change.details field.
+func (b *SourceChangeCreatedV2Builder) Details(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.Details = value
+	return b
+}
+
+// Files sets the data.change.files field.
+func (b *SourceChangeCreatedV2Builder) Files(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.Files = value
+	return b
+}
+
+// ChangeID sets the data.change.id field.
+func (b *SourceChangeCreatedV2Builder) ChangeID(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.ID = value
+	return b
+}
+
+// Insertions sets the data.change.insertions field.
+func (b *SourceChangeCreatedV2Builder) Insertions(value int64) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.Insertions = value
+	return b
+}
+
+// Tracker sets the data.change.tracker field.
+func (b *SourceChangeCreatedV2Builder) Tracker(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.Change.Tracker = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *SourceChangeCreatedV2Builder) CustomData(value ...CustomDataV1) *SourceChangeCreatedV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// GitIdentifierBranch sets the data.gitIdentifier.branch field.
+func (b *SourceChangeCreatedV2Builder) GitIdentifierBranch(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.GitIdentifier.Branch = value
+	return b
+}
+
+// GitIdentifierCommitID sets the data.gitIdentifier.commitId field.
+func (b *SourceChangeCreatedV2Builder) GitIdentifierCommitID(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.GitIdentifier.CommitID = value
+	return b
+}
+
+// GitIdentifierRepoName sets the data.gitIdentifier.repoName field.
+func (b *SourceChangeCreatedV2Builder) GitIdentifierRepoName(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.GitIdentifier.RepoName = value
+	return b
+}
+
+// GitIdentifierRepoURI sets the data.gitIdentifier.repoUri field.
+func (b *SourceChangeCreatedV2Builder) GitIdentifierRepoURI(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.GitIdentifier.RepoURI = value
+	return b
+}
+
+// HgIdentifierBranch sets the data.hgIdentifier.branch field.
+func (b *SourceChangeCreatedV2Builder) HgIdentifierBranch(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.HgIdentifier.Branch = value
+	return b
+}
+
+// HgIdentifierCommitID sets the data.hgIdentifier.commitId field.
+func (b *SourceChangeCreatedV2Builder) HgIdentifierCommitID(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.HgIdentifier.CommitID = value
+	return b
+}
+
+// HgIdentifierRepoName sets the data.hgIdentifier.repoName field.
+func (b *SourceChangeCreatedV2Builder) HgIdentifierRepoName(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.HgIdentifier.RepoName = value
+	return b
+}
+
+// HgIdentifierRepoURI sets the data.hgIdentifier.repoUri field.
+func (b *SourceChangeCreatedV2Builder) HgIdentifierRepoURI(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.HgIdentifier.RepoURI = value
+	return b
+}
+
+// Directory sets the data.svnIdentifier.directory field.
+func (b *SourceChangeCreatedV2Builder) Directory(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.SvnIdentifier.Directory = value
+	return b
+}
+
+// SvnIdentifierRepoName sets the data.svnIdentifier.repoName field.
+func (b *SourceChangeCreatedV2Builder) SvnIdentifierRepoName(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.SvnIdentifier.RepoName = value
+	return b
+}
+
+// SvnIdentifierRepoURI sets the data.svnIdentifier.repoUri field.
+func (b *SourceChangeCreatedV2Builder) SvnIdentifierRepoURI(value string) *SourceChangeCreatedV2Builder {
+	b.event.Data.SvnIdentifier.RepoURI = value
+	return b
+}
+
+// Revision sets the data.svnIdentifier.revision field.
+func (b *SourceChangeCreatedV2Builder) Revision(value int64) *SourceChangeCreatedV2Builder {
+	b.event.Data.SvnIdentifier.Revision = value
+	b.setRevision = true
+	return b
+}
+
+// Base adds a BASE link to the target event.
+func (b *SourceChangeCreatedV2Builder) Base(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_Base, target)
+	return b
+}
+
+// BaseByID adds a BASE link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) BaseByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Base, target)
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *SourceChangeCreatedV2Builder) Cause(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) CauseByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *SourceChangeCreatedV2Builder) Context(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) ContextByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// DeresolvedIssue adds a DERESOLVED_ISSUE link to the target event.
+func (b *SourceChangeCreatedV2Builder) DeresolvedIssue(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_DeresolvedIssue, target)
+	return b
+}
+
+// DeresolvedIssueByID adds a DERESOLVED_ISSUE link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) DeresolvedIssueByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_DeresolvedIssue, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *SourceChangeCreatedV2Builder) FlowContext(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) FlowContextByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PartiallyResolvedIssue adds a PARTIALLY_RESOLVED_ISSUE link to the target event.
+func (b *SourceChangeCreatedV2Builder) PartiallyResolvedIssue(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_PartiallyResolvedIssue, target)
+	return b
+}
+
+// PartiallyResolvedIssueByID adds a PARTIALLY_RESOLVED_ISSUE link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) PartiallyResolvedIssueByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_PartiallyResolvedIssue, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *SourceChangeCreatedV2Builder) PreviousVersion(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) PreviousVersionByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// ResolvedIssue adds a RESOLVED_ISSUE link to the target event.
+func (b *SourceChangeCreatedV2Builder) ResolvedIssue(target MetaTeller) *SourceChangeCreatedV2Builder {
+	b.event.Links.Add(LinkType_ResolvedIssue, target)
+	return b
+}
+
+// ResolvedIssueByID adds a RESOLVED_ISSUE link to the event with the given ID.
+func (b *SourceChangeCreatedV2Builder) ResolvedIssueByID(target string) *SourceChangeCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_ResolvedIssue, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildSourceChangeCreatedV2.
+func (b *SourceChangeCreatedV2Builder) With(modifiers ...Modifier) *SourceChangeCreatedV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *SourceChangeCreatedV2Builder) Build() (*SourceChangeCreatedV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	var recorder fieldRecorder
+	event.fieldRecorder = &recorder
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new SourceChangeCreatedV2: %w", err)
+		}
+	}
+	event.fieldRecorder = nil
+	var unsetFields []string
+	if !b.setRevision && !recorder.hasRecorded("data.svnIdentifier.revision") {
+		unsetFields = append(unsetFields, "data.svnIdentifier.revision")
+	}
+	if err := checkEventCompleteness(&event, unsetFields); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type SourceChangeCreatedV2 struct {
 	// Mandatory fields
 	Data  SCCV2Data    `json:"data"`
@@ -135,6 +538,131 @@ type SourceChangeCreatedV2 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SourceChangeCreatedV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SourceChangeCreatedV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SourceChangeCreatedV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SourceChangeCreatedV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SourceChangeCreatedV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SourceChangeCreatedV2) deepCopy() SourceChangeCreatedV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SourceChangeCreatedV2) equal(other *SourceChangeCreatedV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SourceChangeCreatedV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SourceChangeCreatedV2) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type SCCV2Data struct {
@@ -150,6 +678,189 @@ type SCCV2Data struct {
 	SvnIdentifier         SCCV2DataSvnIdentifier         `json:"svnIdentifier,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if !s.Author.isEmptyJSON() {
+		b = append(b, `,"author":`...)
+		if b, err = s.Author.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.CcCompositeIdentifier.isEmptyJSON() {
+		b = append(b, `,"ccCompositeIdentifier":`...)
+		if b, err = s.CcCompositeIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Change.isEmptyJSON() {
+		b = append(b, `,"change":`...)
+		if b, err = s.Change.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if !s.GitIdentifier.isEmptyJSON() {
+		b = append(b, `,"gitIdentifier":`...)
+		if b, err = s.GitIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.HgIdentifier.isEmptyJSON() {
+		b = append(b, `,"hgIdentifier":`...)
+		if b, err = s.HgIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.SvnIdentifier.isEmptyJSON() {
+		b = append(b, `,"svnIdentifier":`...)
+		if b, err = s.SvnIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2Data) isEmptyJSON() bool {
+	return s.Author.isEmptyJSON() &&
+		s.CcCompositeIdentifier.isEmptyJSON() &&
+		s.Change.isEmptyJSON() &&
+		len(s.CustomData) == 0 &&
+		s.GitIdentifier.isEmptyJSON() &&
+		s.HgIdentifier.isEmptyJSON() &&
+		s.SvnIdentifier.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "author":
+		return true, s.Author.decodeJSON(member, opts)
+	case "ccCompositeIdentifier":
+		return true, s.CcCompositeIdentifier.decodeJSON(member, opts)
+	case "change":
+		return true, s.Change.decodeJSON(member, opts)
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "gitIdentifier":
+		return true, s.GitIdentifier.decodeJSON(member, opts)
+	case "hgIdentifier":
+		return true, s.HgIdentifier.decodeJSON(member, opts)
+	case "svnIdentifier":
+		return true, s.SvnIdentifier.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "author", "ccCompositeIdentifier", "change", "customData", "gitIdentifier", "hgIdentifier", "svnIdentifier"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2Data) deepCopy() SCCV2Data {
+	c := *s
+	c.Author = s.Author.deepCopy()
+	c.CcCompositeIdentifier = s.CcCompositeIdentifier.deepCopy()
+	c.Change = s.Change.deepCopy()
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.GitIdentifier = s.GitIdentifier.deepCopy()
+	c.HgIdentifier = s.HgIdentifier.deepCopy()
+	c.SvnIdentifier = s.SvnIdentifier.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2Data) equal(other *SCCV2Data) bool {
+	return s.Author.equal(&other.Author) &&
+		s.CcCompositeIdentifier.equal(&other.CcCompositeIdentifier) &&
+		s.Change.equal(&other.Change) &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.GitIdentifier.equal(&other.GitIdentifier) &&
+		s.HgIdentifier.equal(&other.HgIdentifier) &&
+		s.SvnIdentifier.equal(&other.SvnIdentifier)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2Data) validate(pointer string, problems *[]FieldError) {
+	if !s.Author.isEmptyJSON() {
+		s.Author.validate(pointer+"/author", problems)
+	}
+	if !s.CcCompositeIdentifier.isEmptyJSON() {
+		s.CcCompositeIdentifier.validate(pointer+"/ccCompositeIdentifier", problems)
+	}
+	if !s.Change.isEmptyJSON() {
+		s.Change.validate(pointer+"/change", problems)
+	}
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if !s.GitIdentifier.isEmptyJSON() {
+		s.GitIdentifier.validate(pointer+"/gitIdentifier", problems)
+	}
+	if !s.HgIdentifier.isEmptyJSON() {
+		s.HgIdentifier.validate(pointer+"/hgIdentifier", problems)
+	}
+	if !s.SvnIdentifier.isEmptyJSON() {
+		s.SvnIdentifier.validate(pointer+"/svnIdentifier", problems)
+	}
+}
+
 type SCCV2DataAuthor struct {
 	// Mandatory fields
 
@@ -160,6 +871,120 @@ type SCCV2DataAuthor struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataAuthor) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.Email != "" {
+		b = append(b, `,"email":`...)
+		b = appendJSONString(b, s.Email)
+	}
+	if s.Group != "" {
+		b = append(b, `,"group":`...)
+		b = appendJSONString(b, s.Group)
+	}
+	if s.ID != "" {
+		b = append(b, `,"id":`...)
+		b = appendJSONString(b, s.ID)
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataAuthor) isEmptyJSON() bool {
+	return s.Email == "" &&
+		s.Group == "" &&
+		s.ID == "" &&
+		s.Name == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataAuthor) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataAuthor")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataAuthor) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "email":
+		return true, decodeJSONString(member, &s.Email, "string")
+	case "group":
+		return true, decodeJSONString(member, &s.Group, "string")
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	}
+	if folded, ok := foldJSONName(name, "email", "group", "id", "name"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataAuthor) deepCopy() SCCV2DataAuthor {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataAuthor) equal(other *SCCV2DataAuthor) bool {
+	return s.Email == other.Email &&
+		s.Group == other.Group &&
+		s.ID == other.ID &&
+		s.Name == other.Name
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataAuthor) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataAuthor) validate(pointer string, problems *[]FieldError) {
+}
+
 type SCCV2DataCcCompositeIdentifier struct {
 	// Mandatory fields
 	Branch     string   `json:"branch"`
@@ -170,6 +995,125 @@ type SCCV2DataCcCompositeIdentifier struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataCcCompositeIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"branch":`...)
+	b = appendJSONString(b, s.Branch)
+	b = append(b, `,"configSpec":`...)
+	b = appendJSONString(b, s.ConfigSpec)
+	b = append(b, `,"vobs":`...)
+	if b, err = appendJSONArray(b, s.Vobs, func(elem *string, b []byte) (_ []byte, err error) {
+		b = appendJSONString(b, *elem)
+		return b, nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataCcCompositeIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.ConfigSpec == "" &&
+		len(s.Vobs) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataCcCompositeIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataCcCompositeIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataCcCompositeIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "configSpec":
+		return true, decodeJSONString(member, &s.ConfigSpec, "string")
+	case "vobs":
+		return true, decodeJSONArray(member, &s.Vobs, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	}
+	if folded, ok := foldJSONName(name, "branch", "configSpec", "vobs"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataCcCompositeIdentifier) deepCopy() SCCV2DataCcCompositeIdentifier {
+	c := *s
+	c.Vobs = deepCopySlice(s.Vobs, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataCcCompositeIdentifier) equal(other *SCCV2DataCcCompositeIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.ConfigSpec == other.ConfigSpec &&
+		equalSlices(s.Vobs, other.Vobs, func(a *string, b *string) bool {
+			return *a == *b
+		})
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataCcCompositeIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataCcCompositeIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.Branch == "" {
+		addFieldProblem(problems, pointer+"/branch", "mandatory field is missing")
+	}
+	if s.ConfigSpec == "" {
+		addFieldProblem(problems, pointer+"/configSpec", "mandatory field is missing")
+	}
+	if s.Vobs == nil {
+		addFieldProblem(problems, pointer+"/vobs", "mandatory field is missing")
+	}
+}
+
 type SCCV2DataChange struct {
 	// Mandatory fields
 
@@ -182,6 +1126,136 @@ type SCCV2DataChange struct {
 	Tracker    string `json:"tracker,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataChange) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.Deletions != 0 {
+		b = append(b, `,"deletions":`...)
+		b = appendJSONInt(b, s.Deletions)
+	}
+	if s.Details != "" {
+		b = append(b, `,"details":`...)
+		b = appendJSONString(b, s.Details)
+	}
+	if s.Files != "" {
+		b = append(b, `,"files":`...)
+		b = appendJSONString(b, s.Files)
+	}
+	if s.ID != "" {
+		b = append(b, `,"id":`...)
+		b = appendJSONString(b, s.ID)
+	}
+	if s.Insertions != 0 {
+		b = append(b, `,"insertions":`...)
+		b = appendJSONInt(b, s.Insertions)
+	}
+	if s.Tracker != "" {
+		b = append(b, `,"tracker":`...)
+		b = appendJSONString(b, s.Tracker)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataChange) isEmptyJSON() bool {
+	return s.Deletions == 0 &&
+		s.Details == "" &&
+		s.Files == "" &&
+		s.ID == "" &&
+		s.Insertions == 0 &&
+		s.Tracker == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataChange) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataChange")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataChange) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "deletions":
+		return true, decodeJSONInt(member, &s.Deletions, "int64")
+	case "details":
+		return true, decodeJSONString(member, &s.Details, "string")
+	case "files":
+		return true, decodeJSONString(member, &s.Files, "string")
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "insertions":
+		return true, decodeJSONInt(member, &s.Insertions, "int64")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	}
+	if folded, ok := foldJSONName(name, "deletions", "details", "files", "id", "insertions", "tracker"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataChange) deepCopy() SCCV2DataChange {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataChange) equal(other *SCCV2DataChange) bool {
+	return s.Deletions == other.Deletions &&
+		s.Details == other.Details &&
+		s.Files == other.Files &&
+		s.ID == other.ID &&
+		s.Insertions == other.Insertions &&
+		s.Tracker == other.Tracker
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataChange) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataChange) validate(pointer string, problems *[]FieldError) {
+}
+
 type SCCV2DataGitIdentifier struct {
 	// Mandatory fields
 	CommitID string `json:"commitId"`
@@ -192,6 +1266,122 @@ type SCCV2DataGitIdentifier struct {
 	RepoName string `json:"repoName,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataGitIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"commitId":`...)
+	b = appendJSONString(b, s.CommitID)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	if s.Branch != "" {
+		b = append(b, `,"branch":`...)
+		b = appendJSONString(b, s.Branch)
+	}
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataGitIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.CommitID == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataGitIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataGitIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataGitIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "commitId":
+		return true, decodeJSONString(member, &s.CommitID, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	}
+	if folded, ok := foldJSONName(name, "branch", "commitId", "repoName", "repoUri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataGitIdentifier) deepCopy() SCCV2DataGitIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataGitIdentifier) equal(other *SCCV2DataGitIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.CommitID == other.CommitID &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataGitIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataGitIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.CommitID == "" {
+		addFieldProblem(problems, pointer+"/commitId", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
+
 type SCCV2DataHgIdentifier struct {
 	// Mandatory fields
 	CommitID string `json:"commitId"`
@@ -202,6 +1392,122 @@ type SCCV2DataHgIdentifier struct {
 	RepoName string `json:"repoName,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataHgIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"commitId":`...)
+	b = appendJSONString(b, s.CommitID)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	if s.Branch != "" {
+		b = append(b, `,"branch":`...)
+		b = appendJSONString(b, s.Branch)
+	}
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataHgIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.CommitID == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataHgIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataHgIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataHgIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "commitId":
+		return true, decodeJSONString(member, &s.CommitID, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	}
+	if folded, ok := foldJSONName(name, "branch", "commitId", "repoName", "repoUri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataHgIdentifier) deepCopy() SCCV2DataHgIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataHgIdentifier) equal(other *SCCV2DataHgIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.CommitID == other.CommitID &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataHgIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataHgIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.CommitID == "" {
+		addFieldProblem(problems, pointer+"/commitId", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
+
 type SCCV2DataSvnIdentifier struct {
 	// Mandatory fields
 	Directory string `json:"directory"`
@@ -211,3 +1517,117 @@ type SCCV2DataSvnIdentifier struct {
 	// Optional fields
 	RepoName string `json:"repoName,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCCV2DataSvnIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"directory":`...)
+	b = appendJSONString(b, s.Directory)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	b = append(b, `,"revision":`...)
+	b = appendJSONInt(b, s.Revision)
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCCV2DataSvnIdentifier) isEmptyJSON() bool {
+	return s.Directory == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == "" &&
+		s.Revision == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCCV2DataSvnIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCCV2DataSvnIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCCV2DataSvnIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "directory":
+		return true, decodeJSONString(member, &s.Directory, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	case "revision":
+		return true, decodeJSONInt(member, &s.Revision, "int64")
+	}
+	if folded, ok := foldJSONName(name, "directory", "repoName", "repoUri", "revision"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCCV2DataSvnIdentifier) deepCopy() SCCV2DataSvnIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCCV2DataSvnIdentifier) equal(other *SCCV2DataSvnIdentifier) bool {
+	return s.Directory == other.Directory &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI &&
+		s.Revision == other.Revision
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCCV2DataSvnIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCCV2DataSvnIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.Directory == "" {
+		addFieldProblem(problems, pointer+"/directory", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
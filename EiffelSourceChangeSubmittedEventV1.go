@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewSourceChangeSubmittedV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewSourceChangeSubmittedV1(modifiers ...Modifier) (*SourceChangeSubmittedV1, error) {
+	return newSourceChangeSubmittedV1(Generator{}, eventTypeTable["EiffelSourceChangeSubmittedEvent"][1].latestVersion, modifiers)
+}
+
+// NewSourceChangeSubmittedV1 creates a new EiffelSourceChangeSubmittedEvent struct pointer like
+// the package-level NewSourceChangeSubmittedV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewSourceChangeSubmittedV1(modifiers ...Modifier) (*SourceChangeSubmittedV1, error) {
+	return newSourceChangeSubmittedV1(f.generator, eventTypeTable["EiffelSourceChangeSubmittedEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newSourceChangeSubmittedV1 creates a new EiffelSourceChangeSubmittedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newSourceChangeSubmittedV1(generator Generator, version string, modifiers []Modifier) (*SourceChangeSubmittedV1, error) {
 	var event SourceChangeSubmittedV1
 	event.Meta.Type = "EiffelSourceChangeSubmittedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new SourceChangeSubmittedV1: %w", err)
@@ -48,33 +61,51 @@ func NewSourceChangeSubmittedV1(modifiers ...Modifier) (*SourceChangeSubmittedV1
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *SourceChangeSubmittedV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *SCSV1Data   `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *SourceChangeSubmittedV1) DeepCopy() *SourceChangeSubmittedV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *SourceChangeSubmittedV1) Equal(other *SourceChangeSubmittedV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *SourceChangeSubmittedV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *SourceChangeSubmittedV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *SourceChangeSubmittedV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *SourceChangeSubmittedV1) String() string {
 
 var (
 	_ CapabilityTeller = &SourceChangeSubmittedV1{}
+	_ Event            = &SourceChangeSubmittedV1{}
+	_ FieldGetter      = &SourceChangeSubmittedV1{}
 	_ FieldSetter      = &SourceChangeSubmittedV1{}
 	_ MetaTeller       = &SourceChangeSubmittedV1{}
 )
@@ -120,6 +153,47 @@ func (e SourceChangeSubmittedV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e SourceChangeSubmittedV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *SourceChangeSubmittedV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *SourceChangeSubmittedV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *SourceChangeSubmittedV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e SourceChangeSubmittedV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e SourceChangeSubmittedV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e SourceChangeSubmittedV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,263 @@ func (e SourceChangeSubmittedV1) SupportsSigning() bool {
 	return false
 }
 
+// SourceChangeSubmittedV1Builder builds EiffelSourceChangeSubmittedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildSourceChangeSubmittedV1.
+type SourceChangeSubmittedV1Builder struct {
+	event       SourceChangeSubmittedV1
+	generator   Generator
+	modifiers   []Modifier
+	setRevision bool // Has Revision been called?
+}
+
+// BuildSourceChangeSubmittedV1 returns a builder for major version 1
+// of EiffelSourceChangeSubmittedEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildSourceChangeSubmittedV1(modifiers ...Modifier) *SourceChangeSubmittedV1Builder {
+	b := &SourceChangeSubmittedV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelSourceChangeSubmittedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildSourceChangeSubmittedV1 returns a builder for EiffelSourceChangeSubmittedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildSourceChangeSubmittedV1 for details.
+func (f *Factory) BuildSourceChangeSubmittedV1(modifiers ...Modifier) *SourceChangeSubmittedV1Builder {
+	b := BuildSourceChangeSubmittedV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CcCompositeIdentifierBranch sets the data.ccCompositeIdentifier.branch field.
+func (b *SourceChangeSubmittedV1Builder) CcCompositeIdentifierBranch(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.CcCompositeIdentifier.Branch = value
+	return b
+}
+
+// ConfigSpec sets the data.ccCompositeIdentifier.configSpec field.
+func (b *SourceChangeSubmittedV1Builder) ConfigSpec(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.CcCompositeIdentifier.ConfigSpec = value
+	return b
+}
+
+// Vobs sets the data.ccCompositeIdentifier.vobs field.
+func (b *SourceChangeSubmittedV1Builder) Vobs(value ...string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.CcCompositeIdentifier.Vobs = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *SourceChangeSubmittedV1Builder) CustomData(value ...CustomDataV1) *SourceChangeSubmittedV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// GitIdentifierBranch sets the data.gitIdentifier.branch field.
+func (b *SourceChangeSubmittedV1Builder) GitIdentifierBranch(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.GitIdentifier.Branch = value
+	return b
+}
+
+// GitIdentifierCommitID sets the data.gitIdentifier.commitId field.
+func (b *SourceChangeSubmittedV1Builder) GitIdentifierCommitID(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.GitIdentifier.CommitID = value
+	return b
+}
+
+// GitIdentifierRepoName sets the data.gitIdentifier.repoName field.
+func (b *SourceChangeSubmittedV1Builder) GitIdentifierRepoName(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.GitIdentifier.RepoName = value
+	return b
+}
+
+// GitIdentifierRepoURI sets the data.gitIdentifier.repoUri field.
+func (b *SourceChangeSubmittedV1Builder) GitIdentifierRepoURI(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.GitIdentifier.RepoURI = value
+	return b
+}
+
+// HgIdentifierBranch sets the data.hgIdentifier.branch field.
+func (b *SourceChangeSubmittedV1Builder) HgIdentifierBranch(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.HgIdentifier.Branch = value
+	return b
+}
+
+// HgIdentifierCommitID sets the data.hgIdentifier.commitId field.
+func (b *SourceChangeSubmittedV1Builder) HgIdentifierCommitID(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.HgIdentifier.CommitID = value
+	return b
+}
+
+// HgIdentifierRepoName sets the data.hgIdentifier.repoName field.
+func (b *SourceChangeSubmittedV1Builder) HgIdentifierRepoName(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.HgIdentifier.RepoName = value
+	return b
+}
+
+// HgIdentifierRepoURI sets the data.hgIdentifier.repoUri field.
+func (b *SourceChangeSubmittedV1Builder) HgIdentifierRepoURI(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.HgIdentifier.RepoURI = value
+	return b
+}
+
+// Email sets the data.submitter.email field.
+func (b *SourceChangeSubmittedV1Builder) Email(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.Submitter.Email = value
+	return b
+}
+
+// Group sets the data.submitter.group field.
+func (b *SourceChangeSubmittedV1Builder) Group(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.Submitter.Group = value
+	return b
+}
+
+// ID sets the data.submitter.id field.
+func (b *SourceChangeSubmittedV1Builder) ID(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.Submitter.ID = value
+	return b
+}
+
+// Name sets the data.submitter.name field.
+func (b *SourceChangeSubmittedV1Builder) Name(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.Submitter.Name = value
+	return b
+}
+
+// Directory sets the data.svnIdentifier.directory field.
+func (b *SourceChangeSubmittedV1Builder) Directory(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.SvnIdentifier.Directory = value
+	return b
+}
+
+// SvnIdentifierRepoName sets the data.svnIdentifier.repoName field.
+func (b *SourceChangeSubmittedV1Builder) SvnIdentifierRepoName(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.SvnIdentifier.RepoName = value
+	return b
+}
+
+// SvnIdentifierRepoURI sets the data.svnIdentifier.repoUri field.
+func (b *SourceChangeSubmittedV1Builder) SvnIdentifierRepoURI(value string) *SourceChangeSubmittedV1Builder {
+	b.event.Data.SvnIdentifier.RepoURI = value
+	return b
+}
+
+// Revision sets the data.svnIdentifier.revision field.
+func (b *SourceChangeSubmittedV1Builder) Revision(value int64) *SourceChangeSubmittedV1Builder {
+	b.event.Data.SvnIdentifier.Revision = value
+	b.setRevision = true
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *SourceChangeSubmittedV1Builder) Cause(target MetaTeller) *SourceChangeSubmittedV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *SourceChangeSubmittedV1Builder) CauseByID(target string) *SourceChangeSubmittedV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Change adds a CHANGE link to the target event.
+func (b *SourceChangeSubmittedV1Builder) Change(target MetaTeller) *SourceChangeSubmittedV1Builder {
+	b.event.Links.Add(LinkType_Change, target)
+	return b
+}
+
+// ChangeByID adds a CHANGE link to the event with the given ID.
+func (b *SourceChangeSubmittedV1Builder) ChangeByID(target string) *SourceChangeSubmittedV1Builder {
+	b.event.Links.AddByID(LinkType_Change, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *SourceChangeSubmittedV1Builder) Context(target MetaTeller) *SourceChangeSubmittedV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *SourceChangeSubmittedV1Builder) ContextByID(target string) *SourceChangeSubmittedV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *SourceChangeSubmittedV1Builder) FlowContext(target MetaTeller) *SourceChangeSubmittedV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *SourceChangeSubmittedV1Builder) FlowContextByID(target string) *SourceChangeSubmittedV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// PreviousVersion adds a PREVIOUS_VERSION link to the target event.
+func (b *SourceChangeSubmittedV1Builder) PreviousVersion(target MetaTeller) *SourceChangeSubmittedV1Builder {
+	b.event.Links.Add(LinkType_PreviousVersion, target)
+	return b
+}
+
+// PreviousVersionByID adds a PREVIOUS_VERSION link to the event with the given ID.
+func (b *SourceChangeSubmittedV1Builder) PreviousVersionByID(target string) *SourceChangeSubmittedV1Builder {
+	b.event.Links.AddByID(LinkType_PreviousVersion, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildSourceChangeSubmittedV1.
+func (b *SourceChangeSubmittedV1Builder) With(modifiers ...Modifier) *SourceChangeSubmittedV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *SourceChangeSubmittedV1Builder) Build() (*SourceChangeSubmittedV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	var recorder fieldRecorder
+	event.fieldRecorder = &recorder
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new SourceChangeSubmittedV1: %w", err)
+		}
+	}
+	event.fieldRecorder = nil
+	var unsetFields []string
+	if !b.setRevision && !recorder.hasRecorded("data.svnIdentifier.revision") {
+		unsetFields = append(unsetFields, "data.svnIdentifier.revision")
+	}
+	if err := checkEventCompleteness(&event, unsetFields); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type SourceChangeSubmittedV1 struct {
 	// Mandatory fields
 	Data  SCSV1Data    `json:"data"`
@@ -135,6 +466,131 @@ type SourceChangeSubmittedV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SourceChangeSubmittedV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SourceChangeSubmittedV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SourceChangeSubmittedV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SourceChangeSubmittedV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SourceChangeSubmittedV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SourceChangeSubmittedV1) deepCopy() SourceChangeSubmittedV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SourceChangeSubmittedV1) equal(other *SourceChangeSubmittedV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SourceChangeSubmittedV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SourceChangeSubmittedV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type SCSV1Data struct {
@@ -149,6 +605,175 @@ type SCSV1Data struct {
 	SvnIdentifier         SCSV1DataSvnIdentifier         `json:"svnIdentifier,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if !s.CcCompositeIdentifier.isEmptyJSON() {
+		b = append(b, `,"ccCompositeIdentifier":`...)
+		if b, err = s.CcCompositeIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if !s.GitIdentifier.isEmptyJSON() {
+		b = append(b, `,"gitIdentifier":`...)
+		if b, err = s.GitIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.HgIdentifier.isEmptyJSON() {
+		b = append(b, `,"hgIdentifier":`...)
+		if b, err = s.HgIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.Submitter.isEmptyJSON() {
+		b = append(b, `,"submitter":`...)
+		if b, err = s.Submitter.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if !s.SvnIdentifier.isEmptyJSON() {
+		b = append(b, `,"svnIdentifier":`...)
+		if b, err = s.SvnIdentifier.appendJSON(b); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1Data) isEmptyJSON() bool {
+	return s.CcCompositeIdentifier.isEmptyJSON() &&
+		len(s.CustomData) == 0 &&
+		s.GitIdentifier.isEmptyJSON() &&
+		s.HgIdentifier.isEmptyJSON() &&
+		s.Submitter.isEmptyJSON() &&
+		s.SvnIdentifier.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "ccCompositeIdentifier":
+		return true, s.CcCompositeIdentifier.decodeJSON(member, opts)
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "gitIdentifier":
+		return true, s.GitIdentifier.decodeJSON(member, opts)
+	case "hgIdentifier":
+		return true, s.HgIdentifier.decodeJSON(member, opts)
+	case "submitter":
+		return true, s.Submitter.decodeJSON(member, opts)
+	case "svnIdentifier":
+		return true, s.SvnIdentifier.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "ccCompositeIdentifier", "customData", "gitIdentifier", "hgIdentifier", "submitter", "svnIdentifier"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1Data) deepCopy() SCSV1Data {
+	c := *s
+	c.CcCompositeIdentifier = s.CcCompositeIdentifier.deepCopy()
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.GitIdentifier = s.GitIdentifier.deepCopy()
+	c.HgIdentifier = s.HgIdentifier.deepCopy()
+	c.Submitter = s.Submitter.deepCopy()
+	c.SvnIdentifier = s.SvnIdentifier.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1Data) equal(other *SCSV1Data) bool {
+	return s.CcCompositeIdentifier.equal(&other.CcCompositeIdentifier) &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.GitIdentifier.equal(&other.GitIdentifier) &&
+		s.HgIdentifier.equal(&other.HgIdentifier) &&
+		s.Submitter.equal(&other.Submitter) &&
+		s.SvnIdentifier.equal(&other.SvnIdentifier)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1Data) validate(pointer string, problems *[]FieldError) {
+	if !s.CcCompositeIdentifier.isEmptyJSON() {
+		s.CcCompositeIdentifier.validate(pointer+"/ccCompositeIdentifier", problems)
+	}
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if !s.GitIdentifier.isEmptyJSON() {
+		s.GitIdentifier.validate(pointer+"/gitIdentifier", problems)
+	}
+	if !s.HgIdentifier.isEmptyJSON() {
+		s.HgIdentifier.validate(pointer+"/hgIdentifier", problems)
+	}
+	if !s.Submitter.isEmptyJSON() {
+		s.Submitter.validate(pointer+"/submitter", problems)
+	}
+	if !s.SvnIdentifier.isEmptyJSON() {
+		s.SvnIdentifier.validate(pointer+"/svnIdentifier", problems)
+	}
+}
+
 type SCSV1DataCcCompositeIdentifier struct {
 	// Mandatory fields
 	Branch     string   `json:"branch"`
@@ -159,6 +784,125 @@ type SCSV1DataCcCompositeIdentifier struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1DataCcCompositeIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"branch":`...)
+	b = appendJSONString(b, s.Branch)
+	b = append(b, `,"configSpec":`...)
+	b = appendJSONString(b, s.ConfigSpec)
+	b = append(b, `,"vobs":`...)
+	if b, err = appendJSONArray(b, s.Vobs, func(elem *string, b []byte) (_ []byte, err error) {
+		b = appendJSONString(b, *elem)
+		return b, nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1DataCcCompositeIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.ConfigSpec == "" &&
+		len(s.Vobs) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1DataCcCompositeIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1DataCcCompositeIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1DataCcCompositeIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "configSpec":
+		return true, decodeJSONString(member, &s.ConfigSpec, "string")
+	case "vobs":
+		return true, decodeJSONArray(member, &s.Vobs, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	}
+	if folded, ok := foldJSONName(name, "branch", "configSpec", "vobs"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1DataCcCompositeIdentifier) deepCopy() SCSV1DataCcCompositeIdentifier {
+	c := *s
+	c.Vobs = deepCopySlice(s.Vobs, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1DataCcCompositeIdentifier) equal(other *SCSV1DataCcCompositeIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.ConfigSpec == other.ConfigSpec &&
+		equalSlices(s.Vobs, other.Vobs, func(a *string, b *string) bool {
+			return *a == *b
+		})
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1DataCcCompositeIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1DataCcCompositeIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.Branch == "" {
+		addFieldProblem(problems, pointer+"/branch", "mandatory field is missing")
+	}
+	if s.ConfigSpec == "" {
+		addFieldProblem(problems, pointer+"/configSpec", "mandatory field is missing")
+	}
+	if s.Vobs == nil {
+		addFieldProblem(problems, pointer+"/vobs", "mandatory field is missing")
+	}
+}
+
 type SCSV1DataGitIdentifier struct {
 	// Mandatory fields
 	CommitID string `json:"commitId"`
@@ -169,6 +913,122 @@ type SCSV1DataGitIdentifier struct {
 	RepoName string `json:"repoName,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1DataGitIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"commitId":`...)
+	b = appendJSONString(b, s.CommitID)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	if s.Branch != "" {
+		b = append(b, `,"branch":`...)
+		b = appendJSONString(b, s.Branch)
+	}
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1DataGitIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.CommitID == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1DataGitIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1DataGitIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1DataGitIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "commitId":
+		return true, decodeJSONString(member, &s.CommitID, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	}
+	if folded, ok := foldJSONName(name, "branch", "commitId", "repoName", "repoUri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1DataGitIdentifier) deepCopy() SCSV1DataGitIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1DataGitIdentifier) equal(other *SCSV1DataGitIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.CommitID == other.CommitID &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1DataGitIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1DataGitIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.CommitID == "" {
+		addFieldProblem(problems, pointer+"/commitId", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
+
 type SCSV1DataHgIdentifier struct {
 	// Mandatory fields
 	CommitID string `json:"commitId"`
@@ -179,6 +1039,122 @@ type SCSV1DataHgIdentifier struct {
 	RepoName string `json:"repoName,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1DataHgIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"commitId":`...)
+	b = appendJSONString(b, s.CommitID)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	if s.Branch != "" {
+		b = append(b, `,"branch":`...)
+		b = appendJSONString(b, s.Branch)
+	}
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1DataHgIdentifier) isEmptyJSON() bool {
+	return s.Branch == "" &&
+		s.CommitID == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1DataHgIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1DataHgIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1DataHgIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "branch":
+		return true, decodeJSONString(member, &s.Branch, "string")
+	case "commitId":
+		return true, decodeJSONString(member, &s.CommitID, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	}
+	if folded, ok := foldJSONName(name, "branch", "commitId", "repoName", "repoUri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1DataHgIdentifier) deepCopy() SCSV1DataHgIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1DataHgIdentifier) equal(other *SCSV1DataHgIdentifier) bool {
+	return s.Branch == other.Branch &&
+		s.CommitID == other.CommitID &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1DataHgIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1DataHgIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.CommitID == "" {
+		addFieldProblem(problems, pointer+"/commitId", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
+
 type SCSV1DataSubmitter struct {
 	// Mandatory fields
 
@@ -189,6 +1165,120 @@ type SCSV1DataSubmitter struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1DataSubmitter) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	if s.Email != "" {
+		b = append(b, `,"email":`...)
+		b = appendJSONString(b, s.Email)
+	}
+	if s.Group != "" {
+		b = append(b, `,"group":`...)
+		b = appendJSONString(b, s.Group)
+	}
+	if s.ID != "" {
+		b = append(b, `,"id":`...)
+		b = appendJSONString(b, s.ID)
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1DataSubmitter) isEmptyJSON() bool {
+	return s.Email == "" &&
+		s.Group == "" &&
+		s.ID == "" &&
+		s.Name == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1DataSubmitter) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1DataSubmitter")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1DataSubmitter) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "email":
+		return true, decodeJSONString(member, &s.Email, "string")
+	case "group":
+		return true, decodeJSONString(member, &s.Group, "string")
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	}
+	if folded, ok := foldJSONName(name, "email", "group", "id", "name"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1DataSubmitter) deepCopy() SCSV1DataSubmitter {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1DataSubmitter) equal(other *SCSV1DataSubmitter) bool {
+	return s.Email == other.Email &&
+		s.Group == other.Group &&
+		s.ID == other.ID &&
+		s.Name == other.Name
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1DataSubmitter) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1DataSubmitter) validate(pointer string, problems *[]FieldError) {
+}
+
 type SCSV1DataSvnIdentifier struct {
 	// Mandatory fields
 	Directory string `json:"directory"`
@@ -198,3 +1288,117 @@ type SCSV1DataSvnIdentifier struct {
 	// Optional fields
 	RepoName string `json:"repoName,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *SCSV1DataSvnIdentifier) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"directory":`...)
+	b = appendJSONString(b, s.Directory)
+	b = append(b, `,"repoUri":`...)
+	b = appendJSONString(b, s.RepoURI)
+	b = append(b, `,"revision":`...)
+	b = appendJSONInt(b, s.Revision)
+	if s.RepoName != "" {
+		b = append(b, `,"repoName":`...)
+		b = appendJSONString(b, s.RepoName)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *SCSV1DataSvnIdentifier) isEmptyJSON() bool {
+	return s.Directory == "" &&
+		s.RepoName == "" &&
+		s.RepoURI == "" &&
+		s.Revision == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *SCSV1DataSvnIdentifier) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.SCSV1DataSvnIdentifier")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *SCSV1DataSvnIdentifier) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "directory":
+		return true, decodeJSONString(member, &s.Directory, "string")
+	case "repoName":
+		return true, decodeJSONString(member, &s.RepoName, "string")
+	case "repoUri":
+		return true, decodeJSONString(member, &s.RepoURI, "string")
+	case "revision":
+		return true, decodeJSONInt(member, &s.Revision, "int64")
+	}
+	if folded, ok := foldJSONName(name, "directory", "repoName", "repoUri", "revision"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *SCSV1DataSvnIdentifier) deepCopy() SCSV1DataSvnIdentifier {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *SCSV1DataSvnIdentifier) equal(other *SCSV1DataSvnIdentifier) bool {
+	return s.Directory == other.Directory &&
+		s.RepoName == other.RepoName &&
+		s.RepoURI == other.RepoURI &&
+		s.Revision == other.Revision
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *SCSV1DataSvnIdentifier) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *SCSV1DataSvnIdentifier) validate(pointer string, problems *[]FieldError) {
+	if s.Directory == "" {
+		addFieldProblem(problems, pointer+"/directory", "mandatory field is missing")
+	}
+	if s.RepoURI == "" {
+		addFieldProblem(problems, pointer+"/repoUri", "mandatory field is missing")
+	}
+}
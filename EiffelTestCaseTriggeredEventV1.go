@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewTestCaseTriggeredV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewTestCaseTriggeredV1(modifiers ...Modifier) (*TestCaseTriggeredV1, error) {
+	return newTestCaseTriggeredV1(Generator{}, eventTypeTable["EiffelTestCaseTriggeredEvent"][1].latestVersion, modifiers)
+}
+
+// NewTestCaseTriggeredV1 creates a new EiffelTestCaseTriggeredEvent struct pointer like
+// the package-level NewTestCaseTriggeredV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewTestCaseTriggeredV1(modifiers ...Modifier) (*TestCaseTriggeredV1, error) {
+	return newTestCaseTriggeredV1(f.generator, eventTypeTable["EiffelTestCaseTriggeredEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newTestCaseTriggeredV1 creates a new EiffelTestCaseTriggeredEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newTestCaseTriggeredV1(generator Generator, version string, modifiers []Modifier) (*TestCaseTriggeredV1, error) {
 	var event TestCaseTriggeredV1
 	event.Meta.Type = "EiffelTestCaseTriggeredEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new TestCaseTriggeredV1: %w", err)
@@ -48,33 +61,51 @@ func NewTestCaseTriggeredV1(modifiers ...Modifier) (*TestCaseTriggeredV1, error)
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *TestCaseTriggeredV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *TCTV1Data   `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *TestCaseTriggeredV1) DeepCopy() *TestCaseTriggeredV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *TestCaseTriggeredV1) Equal(other *TestCaseTriggeredV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *TestCaseTriggeredV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *TestCaseTriggeredV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *TestCaseTriggeredV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *TestCaseTriggeredV1) String() string {
 
 var (
 	_ CapabilityTeller = &TestCaseTriggeredV1{}
+	_ Event            = &TestCaseTriggeredV1{}
+	_ FieldGetter      = &TestCaseTriggeredV1{}
 	_ FieldSetter      = &TestCaseTriggeredV1{}
 	_ MetaTeller       = &TestCaseTriggeredV1{}
 )
@@ -120,6 +153,47 @@ func (e TestCaseTriggeredV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e TestCaseTriggeredV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *TestCaseTriggeredV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *TestCaseTriggeredV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *TestCaseTriggeredV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e TestCaseTriggeredV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e TestCaseTriggeredV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e TestCaseTriggeredV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,214 @@ func (e TestCaseTriggeredV1) SupportsSigning() bool {
 	return false
 }
 
+// TestCaseTriggeredV1Builder builds EiffelTestCaseTriggeredEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildTestCaseTriggeredV1.
+type TestCaseTriggeredV1Builder struct {
+	event     TestCaseTriggeredV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildTestCaseTriggeredV1 returns a builder for major version 1
+// of EiffelTestCaseTriggeredEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildTestCaseTriggeredV1(modifiers ...Modifier) *TestCaseTriggeredV1Builder {
+	b := &TestCaseTriggeredV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelTestCaseTriggeredEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildTestCaseTriggeredV1 returns a builder for EiffelTestCaseTriggeredEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildTestCaseTriggeredV1 for details.
+func (f *Factory) BuildTestCaseTriggeredV1(modifiers ...Modifier) *TestCaseTriggeredV1Builder {
+	b := BuildTestCaseTriggeredV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *TestCaseTriggeredV1Builder) CustomData(value ...CustomDataV1) *TestCaseTriggeredV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ExecutionType sets the data.executionType field.
+func (b *TestCaseTriggeredV1Builder) ExecutionType(value TCTV1DataExecutionType) *TestCaseTriggeredV1Builder {
+	b.event.Data.ExecutionType = value
+	return b
+}
+
+// Parameters sets the data.parameters field.
+func (b *TestCaseTriggeredV1Builder) Parameters(value ...TCTV1DataParameter) *TestCaseTriggeredV1Builder {
+	b.event.Data.Parameters = value
+	return b
+}
+
+// RecipeID sets the data.recipeId field.
+func (b *TestCaseTriggeredV1Builder) RecipeID(value string) *TestCaseTriggeredV1Builder {
+	b.event.Data.RecipeID = value
+	return b
+}
+
+// ID sets the data.testCase.id field, which is mandatory.
+func (b *TestCaseTriggeredV1Builder) ID(value string) *TestCaseTriggeredV1Builder {
+	b.event.Data.TestCase.ID = value
+	return b
+}
+
+// Tracker sets the data.testCase.tracker field.
+func (b *TestCaseTriggeredV1Builder) Tracker(value string) *TestCaseTriggeredV1Builder {
+	b.event.Data.TestCase.Tracker = value
+	return b
+}
+
+// URI sets the data.testCase.uri field.
+func (b *TestCaseTriggeredV1Builder) URI(value string) *TestCaseTriggeredV1Builder {
+	b.event.Data.TestCase.URI = value
+	return b
+}
+
+// Version sets the data.testCase.version field.
+func (b *TestCaseTriggeredV1Builder) Version(value string) *TestCaseTriggeredV1Builder {
+	b.event.Data.TestCase.Version = value
+	return b
+}
+
+// Triggers sets the data.triggers field.
+func (b *TestCaseTriggeredV1Builder) Triggers(value ...TCTV1DataTrigger) *TestCaseTriggeredV1Builder {
+	b.event.Data.Triggers = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *TestCaseTriggeredV1Builder) Cause(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) CauseByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *TestCaseTriggeredV1Builder) Context(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) ContextByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// Environment adds a ENVIRONMENT link to the target event.
+func (b *TestCaseTriggeredV1Builder) Environment(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Environment, target)
+	return b
+}
+
+// EnvironmentByID adds a ENVIRONMENT link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) EnvironmentByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Environment, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *TestCaseTriggeredV1Builder) FlowContext(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) FlowContextByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// Iut adds a IUT link to the target event.
+// At least one such link is required.
+func (b *TestCaseTriggeredV1Builder) Iut(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Iut, target)
+	return b
+}
+
+// IutByID adds a IUT link to the event with the given ID.
+// At least one such link is required.
+func (b *TestCaseTriggeredV1Builder) IutByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Iut, target)
+	return b
+}
+
+// Precursor adds a PRECURSOR link to the target event.
+func (b *TestCaseTriggeredV1Builder) Precursor(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Precursor, target)
+	return b
+}
+
+// PrecursorByID adds a PRECURSOR link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) PrecursorByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Precursor, target)
+	return b
+}
+
+// Terc adds a TERC link to the target event.
+func (b *TestCaseTriggeredV1Builder) Terc(target MetaTeller) *TestCaseTriggeredV1Builder {
+	b.event.Links.Add(LinkType_Terc, target)
+	return b
+}
+
+// TercByID adds a TERC link to the event with the given ID.
+func (b *TestCaseTriggeredV1Builder) TercByID(target string) *TestCaseTriggeredV1Builder {
+	b.event.Links.AddByID(LinkType_Terc, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildTestCaseTriggeredV1.
+func (b *TestCaseTriggeredV1Builder) With(modifiers ...Modifier) *TestCaseTriggeredV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *TestCaseTriggeredV1Builder) Build() (*TestCaseTriggeredV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new TestCaseTriggeredV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type TestCaseTriggeredV1 struct {
 	// Mandatory fields
 	Data  TCTV1Data    `json:"data"`
@@ -135,6 +417,131 @@ type TestCaseTriggeredV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TestCaseTriggeredV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TestCaseTriggeredV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TestCaseTriggeredV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TestCaseTriggeredV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TestCaseTriggeredV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TestCaseTriggeredV1) deepCopy() TestCaseTriggeredV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TestCaseTriggeredV1) equal(other *TestCaseTriggeredV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TestCaseTriggeredV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TestCaseTriggeredV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type TCTV1Data struct {
@@ -149,6 +556,173 @@ type TCTV1Data struct {
 	Triggers      []TCTV1DataTrigger     `json:"triggers,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TCTV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"testCase":`...)
+	if b, err = s.TestCase.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.ExecutionType != "" {
+		b = append(b, `,"executionType":`...)
+		b = appendJSONString(b, string(s.ExecutionType))
+	}
+	if len(s.Parameters) != 0 {
+		b = append(b, `,"parameters":`...)
+		if b, err = appendJSONArray(b, s.Parameters, (*TCTV1DataParameter).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.RecipeID != "" {
+		b = append(b, `,"recipeId":`...)
+		b = appendJSONString(b, s.RecipeID)
+	}
+	if len(s.Triggers) != 0 {
+		b = append(b, `,"triggers":`...)
+		if b, err = appendJSONArray(b, s.Triggers, (*TCTV1DataTrigger).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TCTV1Data) isEmptyJSON() bool {
+	return len(s.CustomData) == 0 &&
+		s.ExecutionType == "" &&
+		len(s.Parameters) == 0 &&
+		s.RecipeID == "" &&
+		s.TestCase.isEmptyJSON() &&
+		len(s.Triggers) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TCTV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TCTV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TCTV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "executionType":
+		return true, decodeJSONString(member, (*string)(&s.ExecutionType), "eiffelevents.TCTV1DataExecutionType")
+	case "parameters":
+		return true, decodeJSONArray(member, &s.Parameters, "[]eiffelevents.TCTV1DataParameter", opts, (*TCTV1DataParameter).decodeJSON)
+	case "recipeId":
+		return true, decodeJSONString(member, &s.RecipeID, "string")
+	case "testCase":
+		return true, s.TestCase.decodeJSON(member, opts)
+	case "triggers":
+		return true, decodeJSONArray(member, &s.Triggers, "[]eiffelevents.TCTV1DataTrigger", opts, (*TCTV1DataTrigger).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "customData", "executionType", "parameters", "recipeId", "testCase", "triggers"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TCTV1Data) deepCopy() TCTV1Data {
+	c := *s
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.Parameters = deepCopySlice(s.Parameters, (*TCTV1DataParameter).deepCopy)
+	c.TestCase = s.TestCase.deepCopy()
+	c.Triggers = deepCopySlice(s.Triggers, (*TCTV1DataTrigger).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TCTV1Data) equal(other *TCTV1Data) bool {
+	return equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.ExecutionType == other.ExecutionType &&
+		equalSlices(s.Parameters, other.Parameters, (*TCTV1DataParameter).equal) &&
+		s.RecipeID == other.RecipeID &&
+		s.TestCase.equal(&other.TestCase) &&
+		equalSlices(s.Triggers, other.Triggers, (*TCTV1DataTrigger).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TCTV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TCTV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if s.ExecutionType != "" {
+		if !s.ExecutionType.IsValid() {
+			addFieldProblem(problems, pointer+"/executionType", "%q is not a valid %s value", string(s.ExecutionType), "TCTV1DataExecutionType")
+		}
+	}
+	if len(s.Parameters) != 0 {
+		for i := range s.Parameters {
+			elem := &s.Parameters[i]
+			elem.validate(indexPointer(pointer+"/parameters", i), problems)
+		}
+	}
+	if s.RecipeID != "" {
+		checkPattern(problems, pointer+"/recipeId", s.RecipeID, "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	}
+	s.TestCase.validate(pointer+"/testCase", problems)
+	if len(s.Triggers) != 0 {
+		for i := range s.Triggers {
+			elem := &s.Triggers[i]
+			elem.validate(indexPointer(pointer+"/triggers", i), problems)
+		}
+	}
+}
+
 type TCTV1DataExecutionType string
 
 const (
@@ -158,6 +732,15 @@ const (
 	TCTV1DataExecutionType_Other         TCTV1DataExecutionType = "OTHER"
 )
 
+// IsValid returns true if the value is one of the defined TCTV1DataExecutionType values.
+func (e TCTV1DataExecutionType) IsValid() bool {
+	switch e {
+	case TCTV1DataExecutionType_Manual, TCTV1DataExecutionType_SemiAutomated, TCTV1DataExecutionType_Automated, TCTV1DataExecutionType_Other:
+		return true
+	}
+	return false
+}
+
 type TCTV1DataParameter struct {
 	// Mandatory fields
 	Name  string `json:"name"`
@@ -167,6 +750,106 @@ type TCTV1DataParameter struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TCTV1DataParameter) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"value":`...)
+	b = appendJSONString(b, s.Value)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TCTV1DataParameter) isEmptyJSON() bool {
+	return s.Name == "" &&
+		s.Value == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TCTV1DataParameter) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TCTV1DataParameter")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TCTV1DataParameter) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "value":
+		return true, decodeJSONString(member, &s.Value, "string")
+	}
+	if folded, ok := foldJSONName(name, "name", "value"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TCTV1DataParameter) deepCopy() TCTV1DataParameter {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TCTV1DataParameter) equal(other *TCTV1DataParameter) bool {
+	return s.Name == other.Name &&
+		s.Value == other.Value
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TCTV1DataParameter) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TCTV1DataParameter) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.Value == "" {
+		addFieldProblem(problems, pointer+"/value", "mandatory field is missing")
+	}
+}
+
 type TCTV1DataTestCase struct {
 	// Mandatory fields
 	ID string `json:"id"`
@@ -177,6 +860,121 @@ type TCTV1DataTestCase struct {
 	Version string `json:"version,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TCTV1DataTestCase) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	if s.Tracker != "" {
+		b = append(b, `,"tracker":`...)
+		b = appendJSONString(b, s.Tracker)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if s.Version != "" {
+		b = append(b, `,"version":`...)
+		b = appendJSONString(b, s.Version)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TCTV1DataTestCase) isEmptyJSON() bool {
+	return s.ID == "" &&
+		s.Tracker == "" &&
+		s.URI == "" &&
+		s.Version == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TCTV1DataTestCase) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TCTV1DataTestCase")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TCTV1DataTestCase) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	case "version":
+		return true, decodeJSONString(member, &s.Version, "string")
+	}
+	if folded, ok := foldJSONName(name, "id", "tracker", "uri", "version"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TCTV1DataTestCase) deepCopy() TCTV1DataTestCase {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TCTV1DataTestCase) equal(other *TCTV1DataTestCase) bool {
+	return s.ID == other.ID &&
+		s.Tracker == other.Tracker &&
+		s.URI == other.URI &&
+		s.Version == other.Version
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TCTV1DataTestCase) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TCTV1DataTestCase) validate(pointer string, problems *[]FieldError) {
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	}
+}
+
 type TCTV1DataTrigger struct {
 	// Mandatory fields
 	Type TCTV1DataTriggerType `json:"type"`
@@ -185,6 +983,109 @@ type TCTV1DataTrigger struct {
 	Description string `json:"description,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TCTV1DataTrigger) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"type":`...)
+	b = appendJSONString(b, string(s.Type))
+	if s.Description != "" {
+		b = append(b, `,"description":`...)
+		b = appendJSONString(b, s.Description)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TCTV1DataTrigger) isEmptyJSON() bool {
+	return s.Description == "" &&
+		s.Type == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TCTV1DataTrigger) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TCTV1DataTrigger")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TCTV1DataTrigger) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "description":
+		return true, decodeJSONString(member, &s.Description, "string")
+	case "type":
+		return true, decodeJSONString(member, (*string)(&s.Type), "eiffelevents.TCTV1DataTriggerType")
+	}
+	if folded, ok := foldJSONName(name, "description", "type"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TCTV1DataTrigger) deepCopy() TCTV1DataTrigger {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TCTV1DataTrigger) equal(other *TCTV1DataTrigger) bool {
+	return s.Description == other.Description &&
+		s.Type == other.Type
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TCTV1DataTrigger) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TCTV1DataTrigger) validate(pointer string, problems *[]FieldError) {
+	if s.Type == "" {
+		addFieldProblem(problems, pointer+"/type", "mandatory field is missing")
+	} else {
+		if !s.Type.IsValid() {
+			addFieldProblem(problems, pointer+"/type", "%q is not a valid %s value", string(s.Type), "TCTV1DataTriggerType")
+		}
+	}
+}
+
 type TCTV1DataTriggerType string
 
 const (
@@ -194,3 +1095,12 @@ const (
 	TCTV1DataTriggerType_Timer        TCTV1DataTriggerType = "TIMER"
 	TCTV1DataTriggerType_Other        TCTV1DataTriggerType = "OTHER"
 )
+
+// IsValid returns true if the value is one of the defined TCTV1DataTriggerType values.
+func (e TCTV1DataTriggerType) IsValid() bool {
+	switch e {
+	case TCTV1DataTriggerType_Manual, TCTV1DataTriggerType_EiffelEvent, TCTV1DataTriggerType_SourceChange, TCTV1DataTriggerType_Timer, TCTV1DataTriggerType_Other:
+		return true
+	}
+	return false
+}
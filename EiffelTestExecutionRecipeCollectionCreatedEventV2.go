@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewTestExecutionRecipeCollectionCreatedV2 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 2.x.x
 // currently known by this SDK.
 func NewTestExecutionRecipeCollectionCreatedV2(modifiers ...Modifier) (*TestExecutionRecipeCollectionCreatedV2, error) {
+	return newTestExecutionRecipeCollectionCreatedV2(Generator{}, eventTypeTable["EiffelTestExecutionRecipeCollectionCreatedEvent"][2].latestVersion, modifiers)
+}
+
+// NewTestExecutionRecipeCollectionCreatedV2 creates a new EiffelTestExecutionRecipeCollectionCreatedEvent struct pointer like
+// the package-level NewTestExecutionRecipeCollectionCreatedV2, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewTestExecutionRecipeCollectionCreatedV2(modifiers ...Modifier) (*TestExecutionRecipeCollectionCreatedV2, error) {
+	return newTestExecutionRecipeCollectionCreatedV2(f.generator, eventTypeTable["EiffelTestExecutionRecipeCollectionCreatedEvent"][2].latestVersion, f.withModifiers(modifiers))
+}
+
+// newTestExecutionRecipeCollectionCreatedV2 creates a new EiffelTestExecutionRecipeCollectionCreatedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newTestExecutionRecipeCollectionCreatedV2(generator Generator, version string, modifiers []Modifier) (*TestExecutionRecipeCollectionCreatedV2, error) {
 	var event TestExecutionRecipeCollectionCreatedV2
 	event.Meta.Type = "EiffelTestExecutionRecipeCollectionCreatedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][2].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new TestExecutionRecipeCollectionCreatedV2: %w", err)
@@ -48,33 +61,51 @@ func NewTestExecutionRecipeCollectionCreatedV2(modifiers ...Modifier) (*TestExec
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *TestExecutionRecipeCollectionCreatedV2) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *TERCCV2Data `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *TestExecutionRecipeCollectionCreatedV2) DeepCopy() *TestExecutionRecipeCollectionCreatedV2 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *TestExecutionRecipeCollectionCreatedV2) Equal(other *TestExecutionRecipeCollectionCreatedV2) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *TestExecutionRecipeCollectionCreatedV2) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *TestExecutionRecipeCollectionCreatedV2) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *TestExecutionRecipeCollectionCreatedV2) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *TestExecutionRecipeCollectionCreatedV2) String() string {
 
 var (
 	_ CapabilityTeller = &TestExecutionRecipeCollectionCreatedV2{}
+	_ Event            = &TestExecutionRecipeCollectionCreatedV2{}
+	_ FieldGetter      = &TestExecutionRecipeCollectionCreatedV2{}
 	_ FieldSetter      = &TestExecutionRecipeCollectionCreatedV2{}
 	_ MetaTeller       = &TestExecutionRecipeCollectionCreatedV2{}
 )
@@ -120,6 +153,47 @@ func (e TestExecutionRecipeCollectionCreatedV2) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e TestExecutionRecipeCollectionCreatedV2) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *TestExecutionRecipeCollectionCreatedV2) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *TestExecutionRecipeCollectionCreatedV2) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *TestExecutionRecipeCollectionCreatedV2) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e TestExecutionRecipeCollectionCreatedV2) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e TestExecutionRecipeCollectionCreatedV2) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e TestExecutionRecipeCollectionCreatedV2) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,146 @@ func (e TestExecutionRecipeCollectionCreatedV2) SupportsSigning() bool {
 	return false
 }
 
+// TestExecutionRecipeCollectionCreatedV2Builder builds EiffelTestExecutionRecipeCollectionCreatedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildTestExecutionRecipeCollectionCreatedV2.
+type TestExecutionRecipeCollectionCreatedV2Builder struct {
+	event     TestExecutionRecipeCollectionCreatedV2
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildTestExecutionRecipeCollectionCreatedV2 returns a builder for major version 2
+// of EiffelTestExecutionRecipeCollectionCreatedEvent. The event version is set to the most recent
+// 2.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildTestExecutionRecipeCollectionCreatedV2(modifiers ...Modifier) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b := &TestExecutionRecipeCollectionCreatedV2Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelTestExecutionRecipeCollectionCreatedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][2].latestVersion
+	return b
+}
+
+// BuildTestExecutionRecipeCollectionCreatedV2 returns a builder for EiffelTestExecutionRecipeCollectionCreatedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildTestExecutionRecipeCollectionCreatedV2 for details.
+func (f *Factory) BuildTestExecutionRecipeCollectionCreatedV2(modifiers ...Modifier) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b := BuildTestExecutionRecipeCollectionCreatedV2(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Batches sets the data.batches field.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) Batches(value ...TERCCV2DataBatch) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.Batches = value
+	return b
+}
+
+// BatchesURI sets the data.batchesUri field.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) BatchesURI(value string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.BatchesURI = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) CustomData(value ...CustomDataV1) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// ID sets the data.selectionStrategy.id field, which is mandatory.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) ID(value string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.SelectionStrategy.ID = value
+	return b
+}
+
+// Tracker sets the data.selectionStrategy.tracker field.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) Tracker(value string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.SelectionStrategy.Tracker = value
+	return b
+}
+
+// URI sets the data.selectionStrategy.uri field.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) URI(value string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Data.SelectionStrategy.URI = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) Cause(target MetaTeller) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) CauseByID(target string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) Context(target MetaTeller) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) ContextByID(target string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) FlowContext(target MetaTeller) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) FlowContextByID(target string) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildTestExecutionRecipeCollectionCreatedV2.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) With(modifiers ...Modifier) *TestExecutionRecipeCollectionCreatedV2Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *TestExecutionRecipeCollectionCreatedV2Builder) Build() (*TestExecutionRecipeCollectionCreatedV2, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new TestExecutionRecipeCollectionCreatedV2: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type TestExecutionRecipeCollectionCreatedV2 struct {
 	// Mandatory fields
 	Data  TERCCV2Data  `json:"data"`
@@ -135,6 +349,131 @@ type TestExecutionRecipeCollectionCreatedV2 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TestExecutionRecipeCollectionCreatedV2) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TestExecutionRecipeCollectionCreatedV2) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TestExecutionRecipeCollectionCreatedV2) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TestExecutionRecipeCollectionCreatedV2")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TestExecutionRecipeCollectionCreatedV2) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TestExecutionRecipeCollectionCreatedV2) deepCopy() TestExecutionRecipeCollectionCreatedV2 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TestExecutionRecipeCollectionCreatedV2) equal(other *TestExecutionRecipeCollectionCreatedV2) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TestExecutionRecipeCollectionCreatedV2) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TestExecutionRecipeCollectionCreatedV2) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type TERCCV2Data struct {
@@ -147,6 +486,140 @@ type TERCCV2Data struct {
 	CustomData []CustomDataV1     `json:"customData,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"selectionStrategy":`...)
+	if b, err = s.SelectionStrategy.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(s.Batches) != 0 {
+		b = append(b, `,"batches":`...)
+		if b, err = appendJSONArray(b, s.Batches, (*TERCCV2DataBatch).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.BatchesURI != "" {
+		b = append(b, `,"batchesUri":`...)
+		b = appendJSONString(b, s.BatchesURI)
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2Data) isEmptyJSON() bool {
+	return len(s.Batches) == 0 &&
+		s.BatchesURI == "" &&
+		len(s.CustomData) == 0 &&
+		s.SelectionStrategy.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "batches":
+		return true, decodeJSONArray(member, &s.Batches, "[]eiffelevents.TERCCV2DataBatch", opts, (*TERCCV2DataBatch).decodeJSON)
+	case "batchesUri":
+		return true, decodeJSONString(member, &s.BatchesURI, "string")
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "selectionStrategy":
+		return true, s.SelectionStrategy.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "batches", "batchesUri", "customData", "selectionStrategy"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2Data) deepCopy() TERCCV2Data {
+	c := *s
+	c.Batches = deepCopySlice(s.Batches, (*TERCCV2DataBatch).deepCopy)
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.SelectionStrategy = s.SelectionStrategy.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2Data) equal(other *TERCCV2Data) bool {
+	return equalSlices(s.Batches, other.Batches, (*TERCCV2DataBatch).equal) &&
+		s.BatchesURI == other.BatchesURI &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		s.SelectionStrategy.equal(&other.SelectionStrategy)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.Batches) != 0 {
+		for i := range s.Batches {
+			elem := &s.Batches[i]
+			elem.validate(indexPointer(pointer+"/batches", i), problems)
+		}
+	}
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	s.SelectionStrategy.validate(pointer+"/selectionStrategy", problems)
+}
+
 type TERCCV2DataBatch struct {
 	// Mandatory fields
 	Priority int64                    `json:"priority"`
@@ -157,6 +630,136 @@ type TERCCV2DataBatch struct {
 	Name         string                       `json:"name,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataBatch) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"priority":`...)
+	b = appendJSONInt(b, s.Priority)
+	b = append(b, `,"recipes":`...)
+	if b, err = appendJSONArray(b, s.Recipes, (*TERCCV2DataBatchRecipe).appendJSON); err != nil {
+		return nil, err
+	}
+	if len(s.Dependencies) != 0 {
+		b = append(b, `,"dependencies":`...)
+		if b, err = appendJSONArray(b, s.Dependencies, (*TERCCV2DataBatchDependency).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if s.Name != "" {
+		b = append(b, `,"name":`...)
+		b = appendJSONString(b, s.Name)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataBatch) isEmptyJSON() bool {
+	return len(s.Dependencies) == 0 &&
+		s.Name == "" &&
+		s.Priority == 0 &&
+		len(s.Recipes) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataBatch) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataBatch")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataBatch) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "dependencies":
+		return true, decodeJSONArray(member, &s.Dependencies, "[]eiffelevents.TERCCV2DataBatchDependency", opts, (*TERCCV2DataBatchDependency).decodeJSON)
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "priority":
+		return true, decodeJSONInt(member, &s.Priority, "int64")
+	case "recipes":
+		return true, decodeJSONArray(member, &s.Recipes, "[]eiffelevents.TERCCV2DataBatchRecipe", opts, (*TERCCV2DataBatchRecipe).decodeJSON)
+	}
+	if folded, ok := foldJSONName(name, "dependencies", "name", "priority", "recipes"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataBatch) deepCopy() TERCCV2DataBatch {
+	c := *s
+	c.Dependencies = deepCopySlice(s.Dependencies, (*TERCCV2DataBatchDependency).deepCopy)
+	c.Recipes = deepCopySlice(s.Recipes, (*TERCCV2DataBatchRecipe).deepCopy)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataBatch) equal(other *TERCCV2DataBatch) bool {
+	return equalSlices(s.Dependencies, other.Dependencies, (*TERCCV2DataBatchDependency).equal) &&
+		s.Name == other.Name &&
+		s.Priority == other.Priority &&
+		equalSlices(s.Recipes, other.Recipes, (*TERCCV2DataBatchRecipe).equal)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataBatch) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataBatch) validate(pointer string, problems *[]FieldError) {
+	if len(s.Dependencies) != 0 {
+		for i := range s.Dependencies {
+			elem := &s.Dependencies[i]
+			elem.validate(indexPointer(pointer+"/dependencies", i), problems)
+		}
+	}
+	if s.Recipes == nil {
+		addFieldProblem(problems, pointer+"/recipes", "mandatory field is missing")
+	} else {
+		for i := range s.Recipes {
+			elem := &s.Recipes[i]
+			elem.validate(indexPointer(pointer+"/recipes", i), problems)
+		}
+	}
+}
+
 type TERCCV2DataBatchDependency struct {
 	// Mandatory fields
 	Dependency string `json:"dependency"`
@@ -166,6 +769,106 @@ type TERCCV2DataBatchDependency struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataBatchDependency) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"dependency":`...)
+	b = appendJSONString(b, s.Dependency)
+	b = append(b, `,"dependent":`...)
+	b = appendJSONString(b, s.Dependent)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataBatchDependency) isEmptyJSON() bool {
+	return s.Dependency == "" &&
+		s.Dependent == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataBatchDependency) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataBatchDependency")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataBatchDependency) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "dependency":
+		return true, decodeJSONString(member, &s.Dependency, "string")
+	case "dependent":
+		return true, decodeJSONString(member, &s.Dependent, "string")
+	}
+	if folded, ok := foldJSONName(name, "dependency", "dependent"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataBatchDependency) deepCopy() TERCCV2DataBatchDependency {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataBatchDependency) equal(other *TERCCV2DataBatchDependency) bool {
+	return s.Dependency == other.Dependency &&
+		s.Dependent == other.Dependent
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataBatchDependency) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataBatchDependency) validate(pointer string, problems *[]FieldError) {
+	if s.Dependency == "" {
+		addFieldProblem(problems, pointer+"/dependency", "mandatory field is missing")
+	}
+	if s.Dependent == "" {
+		addFieldProblem(problems, pointer+"/dependent", "mandatory field is missing")
+	}
+}
+
 type TERCCV2DataBatchRecipe struct {
 	// Mandatory fields
 	ID       string                         `json:"id"`
@@ -175,6 +878,126 @@ type TERCCV2DataBatchRecipe struct {
 	Constraints []TERCCV2DataBatchRecipeConstraint `json:"constraints,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataBatchRecipe) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	b = append(b, `,"testCase":`...)
+	if b, err = s.TestCase.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(s.Constraints) != 0 {
+		b = append(b, `,"constraints":`...)
+		if b, err = appendJSONArray(b, s.Constraints, (*TERCCV2DataBatchRecipeConstraint).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataBatchRecipe) isEmptyJSON() bool {
+	return len(s.Constraints) == 0 &&
+		s.ID == "" &&
+		s.TestCase.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataBatchRecipe) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataBatchRecipe")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataBatchRecipe) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "constraints":
+		return true, decodeJSONArray(member, &s.Constraints, "[]eiffelevents.TERCCV2DataBatchRecipeConstraint", opts, (*TERCCV2DataBatchRecipeConstraint).decodeJSON)
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "testCase":
+		return true, s.TestCase.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "constraints", "id", "testCase"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataBatchRecipe) deepCopy() TERCCV2DataBatchRecipe {
+	c := *s
+	c.Constraints = deepCopySlice(s.Constraints, (*TERCCV2DataBatchRecipeConstraint).deepCopy)
+	c.TestCase = s.TestCase.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataBatchRecipe) equal(other *TERCCV2DataBatchRecipe) bool {
+	return equalSlices(s.Constraints, other.Constraints, (*TERCCV2DataBatchRecipeConstraint).equal) &&
+		s.ID == other.ID &&
+		s.TestCase.equal(&other.TestCase)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataBatchRecipe) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataBatchRecipe) validate(pointer string, problems *[]FieldError) {
+	if len(s.Constraints) != 0 {
+		for i := range s.Constraints {
+			elem := &s.Constraints[i]
+			elem.validate(indexPointer(pointer+"/constraints", i), problems)
+		}
+	}
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	} else {
+		checkPattern(problems, pointer+"/id", s.ID, "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	}
+	s.TestCase.validate(pointer+"/testCase", problems)
+}
+
 type TERCCV2DataBatchRecipeConstraint struct {
 	// Mandatory fields
 	Key   string      `json:"key"`
@@ -184,6 +1007,109 @@ type TERCCV2DataBatchRecipeConstraint struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataBatchRecipeConstraint) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"key":`...)
+	b = appendJSONString(b, s.Key)
+	b = append(b, `,"value":`...)
+	if b, err = appendJSONValue(b, s.Value); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataBatchRecipeConstraint) isEmptyJSON() bool {
+	return s.Key == "" &&
+		s.Value == nil
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataBatchRecipeConstraint) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataBatchRecipeConstraint")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataBatchRecipeConstraint) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "key":
+		return true, decodeJSONString(member, &s.Key, "string")
+	case "value":
+		return true, decodeJSONValue(member, &s.Value)
+	}
+	if folded, ok := foldJSONName(name, "key", "value"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataBatchRecipeConstraint) deepCopy() TERCCV2DataBatchRecipeConstraint {
+	c := *s
+	c.Value = deepCopyValue(s.Value)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataBatchRecipeConstraint) equal(other *TERCCV2DataBatchRecipeConstraint) bool {
+	return s.Key == other.Key &&
+		equalValues(s.Value, other.Value)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataBatchRecipeConstraint) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataBatchRecipeConstraint) validate(pointer string, problems *[]FieldError) {
+	if s.Key == "" {
+		addFieldProblem(problems, pointer+"/key", "mandatory field is missing")
+	}
+	if s.Value == nil {
+		addFieldProblem(problems, pointer+"/value", "mandatory field is missing")
+	}
+}
+
 type TERCCV2DataBatchRecipeTestCase struct {
 	// Mandatory fields
 	ID string `json:"id"`
@@ -193,6 +1119,113 @@ type TERCCV2DataBatchRecipeTestCase struct {
 	URI     string `json:"uri,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataBatchRecipeTestCase) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	if s.Tracker != "" {
+		b = append(b, `,"tracker":`...)
+		b = appendJSONString(b, s.Tracker)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataBatchRecipeTestCase) isEmptyJSON() bool {
+	return s.ID == "" &&
+		s.Tracker == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataBatchRecipeTestCase) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataBatchRecipeTestCase")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataBatchRecipeTestCase) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "id", "tracker", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataBatchRecipeTestCase) deepCopy() TERCCV2DataBatchRecipeTestCase {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataBatchRecipeTestCase) equal(other *TERCCV2DataBatchRecipeTestCase) bool {
+	return s.ID == other.ID &&
+		s.Tracker == other.Tracker &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataBatchRecipeTestCase) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataBatchRecipeTestCase) validate(pointer string, problems *[]FieldError) {
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	}
+}
+
 type TERCCV2DataSelectionStrategy struct {
 	// Mandatory fields
 	ID string `json:"id"`
@@ -201,3 +1234,110 @@ type TERCCV2DataSelectionStrategy struct {
 	Tracker string `json:"tracker,omitempty"`
 	URI     string `json:"uri,omitempty"`
 }
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TERCCV2DataSelectionStrategy) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"id":`...)
+	b = appendJSONString(b, s.ID)
+	if s.Tracker != "" {
+		b = append(b, `,"tracker":`...)
+		b = appendJSONString(b, s.Tracker)
+	}
+	if s.URI != "" {
+		b = append(b, `,"uri":`...)
+		b = appendJSONString(b, s.URI)
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TERCCV2DataSelectionStrategy) isEmptyJSON() bool {
+	return s.ID == "" &&
+		s.Tracker == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TERCCV2DataSelectionStrategy) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TERCCV2DataSelectionStrategy")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TERCCV2DataSelectionStrategy) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "id":
+		return true, decodeJSONString(member, &s.ID, "string")
+	case "tracker":
+		return true, decodeJSONString(member, &s.Tracker, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "id", "tracker", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TERCCV2DataSelectionStrategy) deepCopy() TERCCV2DataSelectionStrategy {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TERCCV2DataSelectionStrategy) equal(other *TERCCV2DataSelectionStrategy) bool {
+	return s.ID == other.ID &&
+		s.Tracker == other.Tracker &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TERCCV2DataSelectionStrategy) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TERCCV2DataSelectionStrategy) validate(pointer string, problems *[]FieldError) {
+	if s.ID == "" {
+		addFieldProblem(problems, pointer+"/id", "mandatory field is missing")
+	}
+}
@@ -21,10 +21,8 @@ package eiffelevents
 import (
 	"fmt"
 	"reflect"
-	"time"
 
-	"github.com/clarketm/json"
-	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 )
 
 // NewTestSuiteStartedV1 creates a new struct pointer that represents
@@ -33,11 +31,26 @@ import (
 // The event version is set to the most recent 1.x.x
 // currently known by this SDK.
 func NewTestSuiteStartedV1(modifiers ...Modifier) (*TestSuiteStartedV1, error) {
+	return newTestSuiteStartedV1(Generator{}, eventTypeTable["EiffelTestSuiteStartedEvent"][1].latestVersion, modifiers)
+}
+
+// NewTestSuiteStartedV1 creates a new EiffelTestSuiteStartedEvent struct pointer like
+// the package-level NewTestSuiteStartedV1, but with meta.id and meta.time from
+// the factory's Generator and with the factory's modifiers applied before
+// the given modifiers.
+func (f *Factory) NewTestSuiteStartedV1(modifiers ...Modifier) (*TestSuiteStartedV1, error) {
+	return newTestSuiteStartedV1(f.generator, eventTypeTable["EiffelTestSuiteStartedEvent"][1].latestVersion, f.withModifiers(modifiers))
+}
+
+// newTestSuiteStartedV1 creates a new EiffelTestSuiteStartedEvent struct pointer of the given
+// version with meta.id and meta.time from the generator, and applies the
+// modifiers to it.
+func newTestSuiteStartedV1(generator Generator, version string, modifiers []Modifier) (*TestSuiteStartedV1, error) {
 	var event TestSuiteStartedV1
 	event.Meta.Type = "EiffelTestSuiteStartedEvent"
-	event.Meta.ID = uuid.NewString()
-	event.Meta.Version = eventTypeTable[event.Meta.Type][1].latestVersion
-	event.Meta.Time = time.Now().UnixMilli()
+	event.Meta.ID = generator.newID()
+	event.Meta.Version = version
+	event.Meta.Time = generator.timestamp()
 	for _, modifier := range modifiers {
 		if err := modifier(&event); err != nil {
 			return nil, fmt.Errorf("error applying modifier to new TestSuiteStartedV1: %w", err)
@@ -48,33 +61,51 @@ func NewTestSuiteStartedV1(modifiers ...Modifier) (*TestSuiteStartedV1, error) {
 
 // MarshalJSON returns the JSON encoding of the event.
 func (e *TestSuiteStartedV1) MarshalJSON() ([]byte, error) {
-	// The standard encoding/json package doesn't honor omitempty for
-	// non-pointer structs (it doesn't recurse into values, only examines
-	// the immediate value). This is a not terribly elegant way of making
-	// sure that this struct is marshaled by github.com/clarketm/json
-	// without the infinite loop we'd get by just passing the struct to
-	// github.com/clarketm/json.Marshal.
-	//
-	// Make sure the links slice is non-null so that non-initialized slices
-	// get serialized as "[]" instead of "null".
-	links := e.Links
-	if links == nil {
-		links = make(EventLinksV1, 0)
-	}
-	s := struct {
-		Data  *TSSV1Data   `json:"data"`
-		Links EventLinksV1 `json:"links"`
-		Meta  *MetaV1      `json:"meta"`
-	}{
-		Data:  &e.Data,
-		Links: links,
-		Meta:  &e.Meta,
-	}
-	return json.Marshal(s)
+	b, err := e.appendJSON(make([]byte, 0, 1024))
+	if err != nil {
+		return nil, err
+	}
+	return e.unknownFields.apply(b)
+}
+
+// DeepCopy returns a copy of the event that doesn't share any memory
+// with the original, i.e. modifying the copy (including any slices and
+// custom data values) doesn't affect the original. The copy of a nil
+// event is nil.
+func (e *TestSuiteStartedV1) DeepCopy() *TestSuiteStartedV1 {
+	if e == nil {
+		return nil
+	}
+	c := e.deepCopy()
+	return &c
+}
+
+// Equal returns true if the event and the other event have the same
+// field values. Slices are compared element by element, with nil slices
+// being equal to empty slices, and values of interface{} fields (e.g. custom
+// data values) are considered equal if their JSON encodings are equal.
+// Fields preserved via the PreserveUnknownFields option are also compared.
+func (e *TestSuiteStartedV1) Equal(other *TestSuiteStartedV1) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.equal(other)
+}
+
+func (e *TestSuiteStartedV1) GetField(fieldName string) (interface{}, error) {
+	return getField(reflect.ValueOf(e), fieldName)
 }
 
 func (e *TestSuiteStartedV1) SetField(fieldName string, value interface{}) error {
-	return setField(reflect.ValueOf(e), fieldName, value)
+	if err := setField(reflect.ValueOf(e), fieldName, value); err != nil {
+		return err
+	}
+	e.fieldRecorder.record(fieldName)
+	return nil
+}
+
+func (e *TestSuiteStartedV1) setUnknownFields(fields *unknownFieldSet) {
+	e.unknownFields = fields
 }
 
 // String returns the JSON encoding of the event.
@@ -91,6 +122,8 @@ func (e *TestSuiteStartedV1) String() string {
 
 var (
 	_ CapabilityTeller = &TestSuiteStartedV1{}
+	_ Event            = &TestSuiteStartedV1{}
+	_ FieldGetter      = &TestSuiteStartedV1{}
 	_ FieldSetter      = &TestSuiteStartedV1{}
 	_ MetaTeller       = &TestSuiteStartedV1{}
 )
@@ -120,6 +153,47 @@ func (e TestSuiteStartedV1) DomainID() string {
 	return e.Meta.Source.DomainID
 }
 
+// GetLinks returns the event's links.
+func (e TestSuiteStartedV1) GetLinks() EventLinksV1 {
+	return e.Links
+}
+
+// SetLinks replaces the event's links.
+func (e *TestSuiteStartedV1) SetLinks(links EventLinksV1) {
+	e.Links = links
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (e *TestSuiteStartedV1) AddLink(linkType LinkType, target MetaTeller) {
+	e.Links.Add(linkType, target)
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (e *TestSuiteStartedV1) AddLinkByID(linkType LinkType, target string) {
+	e.Links.AddByID(linkType, target)
+}
+
+// CustomData returns the value of the data.customData field.
+func (e TestSuiteStartedV1) CustomData() []CustomDataV1 {
+	return e.Data.CustomData
+}
+
+// Tags returns the value of the meta.tags field.
+func (e TestSuiteStartedV1) Tags() []string {
+	return e.Meta.Tags
+}
+
+// Source returns the value of the meta.source field.
+func (e TestSuiteStartedV1) Source() EventSource {
+	return EventSource{
+		DomainID:   e.Meta.Source.DomainID,
+		Host:       e.Meta.Source.Host,
+		Name:       e.Meta.Source.Name,
+		Serializer: mavenPurl(e.Meta.Source.Serializer.GroupID, e.Meta.Source.Serializer.ArtifactID, e.Meta.Source.Serializer.Version),
+		URI:        e.Meta.Source.URI,
+	}
+}
+
 // SupportsSigning returns true if the event supports signatures according
 // to V3 of the meta field, i.e. events where the signature is found under
 // meta.security.integrityProtection.
@@ -127,6 +201,152 @@ func (e TestSuiteStartedV1) SupportsSigning() bool {
 	return false
 }
 
+// TestSuiteStartedV1Builder builds EiffelTestSuiteStartedEvent events in a fluent style
+// and makes sure that they're complete. Create one with BuildTestSuiteStartedV1.
+type TestSuiteStartedV1Builder struct {
+	event     TestSuiteStartedV1
+	generator Generator
+	modifiers []Modifier
+}
+
+// BuildTestSuiteStartedV1 returns a builder for major version 1
+// of EiffelTestSuiteStartedEvent. The event version is set to the most recent
+// 1.x.x currently known by this SDK.
+// The modifiers are applied to the event when it's built.
+func BuildTestSuiteStartedV1(modifiers ...Modifier) *TestSuiteStartedV1Builder {
+	b := &TestSuiteStartedV1Builder{
+		modifiers: modifiers,
+	}
+	b.event.Meta.Type = "EiffelTestSuiteStartedEvent"
+	b.event.Meta.Version = eventTypeTable[b.event.Meta.Type][1].latestVersion
+	return b
+}
+
+// BuildTestSuiteStartedV1 returns a builder for EiffelTestSuiteStartedEvent that takes
+// meta.id and meta.time from the factory's Generator and applies the
+// factory's modifiers followed by the given modifiers to the event when
+// it's built. See the package-level BuildTestSuiteStartedV1 for details.
+func (f *Factory) BuildTestSuiteStartedV1(modifiers ...Modifier) *TestSuiteStartedV1Builder {
+	b := BuildTestSuiteStartedV1(f.withModifiers(modifiers)...)
+	b.generator = f.generator
+	return b
+}
+
+// Categories sets the data.categories field.
+func (b *TestSuiteStartedV1Builder) Categories(value ...string) *TestSuiteStartedV1Builder {
+	b.event.Data.Categories = value
+	return b
+}
+
+// CustomData sets the data.customData field.
+func (b *TestSuiteStartedV1Builder) CustomData(value ...CustomDataV1) *TestSuiteStartedV1Builder {
+	b.event.Data.CustomData = value
+	return b
+}
+
+// LiveLogs sets the data.liveLogs field.
+func (b *TestSuiteStartedV1Builder) LiveLogs(value ...TSSV1DataLiveLog) *TestSuiteStartedV1Builder {
+	b.event.Data.LiveLogs = value
+	return b
+}
+
+// Name sets the data.name field, which is mandatory.
+func (b *TestSuiteStartedV1Builder) Name(value string) *TestSuiteStartedV1Builder {
+	b.event.Data.Name = value
+	return b
+}
+
+// Types sets the data.types field.
+func (b *TestSuiteStartedV1Builder) Types(value ...TSSV1DataType) *TestSuiteStartedV1Builder {
+	b.event.Data.Types = value
+	return b
+}
+
+// Cause adds a CAUSE link to the target event.
+func (b *TestSuiteStartedV1Builder) Cause(target MetaTeller) *TestSuiteStartedV1Builder {
+	b.event.Links.Add(LinkType_Cause, target)
+	return b
+}
+
+// CauseByID adds a CAUSE link to the event with the given ID.
+func (b *TestSuiteStartedV1Builder) CauseByID(target string) *TestSuiteStartedV1Builder {
+	b.event.Links.AddByID(LinkType_Cause, target)
+	return b
+}
+
+// Context adds a CONTEXT link to the target event.
+func (b *TestSuiteStartedV1Builder) Context(target MetaTeller) *TestSuiteStartedV1Builder {
+	b.event.Links.Add(LinkType_Context, target)
+	return b
+}
+
+// ContextByID adds a CONTEXT link to the event with the given ID.
+func (b *TestSuiteStartedV1Builder) ContextByID(target string) *TestSuiteStartedV1Builder {
+	b.event.Links.AddByID(LinkType_Context, target)
+	return b
+}
+
+// FlowContext adds a FLOW_CONTEXT link to the target event.
+func (b *TestSuiteStartedV1Builder) FlowContext(target MetaTeller) *TestSuiteStartedV1Builder {
+	b.event.Links.Add(LinkType_FlowContext, target)
+	return b
+}
+
+// FlowContextByID adds a FLOW_CONTEXT link to the event with the given ID.
+func (b *TestSuiteStartedV1Builder) FlowContextByID(target string) *TestSuiteStartedV1Builder {
+	b.event.Links.AddByID(LinkType_FlowContext, target)
+	return b
+}
+
+// Terc adds a TERC link to the target event.
+func (b *TestSuiteStartedV1Builder) Terc(target MetaTeller) *TestSuiteStartedV1Builder {
+	b.event.Links.Add(LinkType_Terc, target)
+	return b
+}
+
+// TercByID adds a TERC link to the event with the given ID.
+func (b *TestSuiteStartedV1Builder) TercByID(target string) *TestSuiteStartedV1Builder {
+	b.event.Links.AddByID(LinkType_Terc, target)
+	return b
+}
+
+// With adds modifiers that are applied to the event when it's built,
+// after the modifiers passed to BuildTestSuiteStartedV1.
+func (b *TestSuiteStartedV1Builder) With(modifiers ...Modifier) *TestSuiteStartedV1Builder {
+	b.modifiers = append(b.modifiers, modifiers...)
+	return b
+}
+
+// Build returns a new event with the fields and links set via the builder,
+// a new meta.id, and meta.time set to the current time (or values from
+// the factory's Generator if the builder was created by a Factory).
+// The modifiers are then applied to the event. Returns an *IncompleteEventError if the event
+// lacks mandatory fields or required links.
+//
+// A zero-valued number or boolean can't be told apart from one that was
+// never set. Mandatory numbers and booleans with setters are therefore
+// reported as missing if they're zero and neither their setter nor
+// SetField (e.g. from a modifier) has been called for them. Other
+// mandatory numbers and booleans, e.g. in slice elements, are never
+// reported as missing.
+//
+// The builder can't detect missing fields at compile time; they're
+// reported by Build.
+func (b *TestSuiteStartedV1Builder) Build() (*TestSuiteStartedV1, error) {
+	event := b.event.deepCopy()
+	event.Meta.ID = b.generator.newID()
+	event.Meta.Time = b.generator.timestamp()
+	for _, modifier := range b.modifiers {
+		if err := modifier(&event); err != nil {
+			return nil, fmt.Errorf("error applying modifier to new TestSuiteStartedV1: %w", err)
+		}
+	}
+	if err := checkEventCompleteness(&event, nil); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 type TestSuiteStartedV1 struct {
 	// Mandatory fields
 	Data  TSSV1Data    `json:"data"`
@@ -135,6 +355,131 @@ type TestSuiteStartedV1 struct {
 
 	// Optional fields
 
+	// Fields found anywhere in the event when unmarshaling with the
+	// PreserveUnknownFields option that don't correspond to any struct field.
+	unknownFields *unknownFieldSet
+
+	// Records the fields set via SetField while a builder
+	// applies its modifiers to the event.
+	fieldRecorder *fieldRecorder
+}
+
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TestSuiteStartedV1) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"data":`...)
+	if b, err = s.Data.appendJSON(b); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"links":`...)
+	if s.Links == nil {
+		b = append(b, `[]`...)
+	} else if b, err = appendJSONArray(b, s.Links, (*EventLinkV1).appendJSON); err != nil {
+		return nil, err
+	}
+	b = append(b, `,"meta":`...)
+	if b, err = s.Meta.appendJSON(b); err != nil {
+		return nil, err
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TestSuiteStartedV1) isEmptyJSON() bool {
+	return s.Data.isEmptyJSON() &&
+		len(s.Links) == 0 &&
+		s.Meta.isEmptyJSON()
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TestSuiteStartedV1) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TestSuiteStartedV1")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TestSuiteStartedV1) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "data":
+		return true, s.Data.decodeJSON(member, opts)
+	case "links":
+		return true, decodeJSONArray(member, &s.Links, "eiffelevents.EventLinksV1", opts, (*EventLinkV1).decodeJSON)
+	case "meta":
+		return true, s.Meta.decodeJSON(member, opts)
+	}
+	if folded, ok := foldJSONName(name, "data", "links", "meta"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TestSuiteStartedV1) deepCopy() TestSuiteStartedV1 {
+	c := *s
+	c.Data = s.Data.deepCopy()
+	c.Links = deepCopySlice(s.Links, (*EventLinkV1).deepCopy)
+	c.Meta = s.Meta.deepCopy()
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TestSuiteStartedV1) equal(other *TestSuiteStartedV1) bool {
+	return s.Data.equal(&other.Data) &&
+		equalSlices(s.Links, other.Links, (*EventLinkV1).equal) &&
+		s.Meta.equal(&other.Meta) &&
+		equalUnknownFields(s, s.unknownFields, other, other.unknownFields)
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TestSuiteStartedV1) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TestSuiteStartedV1) validate(pointer string, problems *[]FieldError) {
+	s.Data.validate(pointer+"/data", problems)
+	for i := range s.Links {
+		elem := &s.Links[i]
+		elem.validate(indexPointer(pointer+"/links", i), problems)
+	}
+	s.Meta.validate(pointer+"/meta", problems)
 }
 
 type TSSV1Data struct {
@@ -148,6 +493,175 @@ type TSSV1Data struct {
 	Types      []TSSV1DataType    `json:"types,omitempty"`
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TSSV1Data) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	if len(s.Categories) != 0 {
+		b = append(b, `,"categories":`...)
+		if b, err = appendJSONArray(b, s.Categories, func(elem *string, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, *elem)
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.CustomData) != 0 {
+		b = append(b, `,"customData":`...)
+		if b, err = appendJSONArray(b, s.CustomData, (*CustomDataV1).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.LiveLogs) != 0 {
+		b = append(b, `,"liveLogs":`...)
+		if b, err = appendJSONArray(b, s.LiveLogs, (*TSSV1DataLiveLog).appendJSON); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Types) != 0 {
+		b = append(b, `,"types":`...)
+		if b, err = appendJSONArray(b, s.Types, func(elem *TSSV1DataType, b []byte) (_ []byte, err error) {
+			b = appendJSONString(b, string(*elem))
+			return b, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TSSV1Data) isEmptyJSON() bool {
+	return len(s.Categories) == 0 &&
+		len(s.CustomData) == 0 &&
+		len(s.LiveLogs) == 0 &&
+		s.Name == "" &&
+		len(s.Types) == 0
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TSSV1Data) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TSSV1Data")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TSSV1Data) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "categories":
+		return true, decodeJSONArray(member, &s.Categories, "[]string", opts, func(elem *string, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, elem, "string")
+		})
+	case "customData":
+		return true, decodeJSONArray(member, &s.CustomData, "[]eiffelevents.CustomDataV1", opts, (*CustomDataV1).decodeJSON)
+	case "liveLogs":
+		return true, decodeJSONArray(member, &s.LiveLogs, "[]eiffelevents.TSSV1DataLiveLog", opts, (*TSSV1DataLiveLog).decodeJSON)
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "types":
+		return true, decodeJSONArray(member, &s.Types, "[]eiffelevents.TSSV1DataType", opts, func(elem *TSSV1DataType, value gjson.Result, opts decodeOptions) error {
+			return decodeJSONString(value, (*string)(elem), "eiffelevents.TSSV1DataType")
+		})
+	}
+	if folded, ok := foldJSONName(name, "categories", "customData", "liveLogs", "name", "types"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TSSV1Data) deepCopy() TSSV1Data {
+	c := *s
+	c.Categories = deepCopySlice(s.Categories, nil)
+	c.CustomData = deepCopySlice(s.CustomData, (*CustomDataV1).deepCopy)
+	c.LiveLogs = deepCopySlice(s.LiveLogs, (*TSSV1DataLiveLog).deepCopy)
+	c.Types = deepCopySlice(s.Types, nil)
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TSSV1Data) equal(other *TSSV1Data) bool {
+	return equalSlices(s.Categories, other.Categories, func(a *string, b *string) bool {
+		return *a == *b
+	}) &&
+		equalSlices(s.CustomData, other.CustomData, (*CustomDataV1).equal) &&
+		equalSlices(s.LiveLogs, other.LiveLogs, (*TSSV1DataLiveLog).equal) &&
+		s.Name == other.Name &&
+		equalSlices(s.Types, other.Types, func(a *TSSV1DataType, b *TSSV1DataType) bool {
+			return *a == *b
+		})
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TSSV1Data) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TSSV1Data) validate(pointer string, problems *[]FieldError) {
+	if len(s.CustomData) != 0 {
+		for i := range s.CustomData {
+			elem := &s.CustomData[i]
+			elem.validate(indexPointer(pointer+"/customData", i), problems)
+		}
+	}
+	if len(s.LiveLogs) != 0 {
+		for i := range s.LiveLogs {
+			elem := &s.LiveLogs[i]
+			elem.validate(indexPointer(pointer+"/liveLogs", i), problems)
+		}
+	}
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if len(s.Types) != 0 {
+		for i := range s.Types {
+			elem := &s.Types[i]
+			if !elem.IsValid() {
+				addFieldProblem(problems, indexPointer(pointer+"/types", i), "%q is not a valid %s value", string(*elem), "TSSV1DataType")
+			}
+		}
+	}
+}
+
 type TSSV1DataLiveLog struct {
 	// Mandatory fields
 	Name string `json:"name"`
@@ -157,6 +671,106 @@ type TSSV1DataLiveLog struct {
 
 }
 
+// appendJSON appends the JSON encoding of the struct to b.
+func (s *TSSV1DataLiveLog) appendJSON(b []byte) (_ []byte, err error) {
+	// Every field is preceded by a comma and the first one
+	// is replaced by the opening brace afterwards.
+	start := len(b)
+	b = append(b, `,"name":`...)
+	b = appendJSONString(b, s.Name)
+	b = append(b, `,"uri":`...)
+	b = appendJSONString(b, s.URI)
+	if len(b) == start {
+		return append(b, "{}"...), nil
+	}
+	b[start] = '{'
+	return append(b, '}'), nil
+}
+
+// isEmptyJSON returns true if all fields of the struct are empty
+// in the sense of the omitempty struct tag option.
+func (s *TSSV1DataLiveLog) isEmptyJSON() bool {
+	return s.Name == "" &&
+		s.URI == ""
+}
+
+// decodeJSON populates the struct from a JSON value.
+func (s *TSSV1DataLiveLog) decodeJSON(value gjson.Result, opts decodeOptions) error {
+	if value.Type == gjson.Null {
+		return nil
+	}
+	if !value.IsObject() {
+		return newJSONTypeError(value, "eiffelevents.TSSV1DataLiveLog")
+	}
+	var err error
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		var known bool
+		opts.unknownFields.pushKey(key.Str)
+		known, err = s.decodeJSONMember(key.Str, member, opts)
+		opts.unknownFields.pop()
+		if !known {
+			opts.unknownFields.add(key.Str, member)
+		}
+		if err != nil {
+			err = prependJSONPointerToken(err, key.Str)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// decodeJSONMember decodes an object member into the struct field with
+// the given JSON name. Like encoding/json it prefers an exact match but
+// falls back to a case-insensitive one. Returns false if there's no
+// such field.
+func (s *TSSV1DataLiveLog) decodeJSONMember(name string, member gjson.Result, opts decodeOptions) (bool, error) {
+	switch name {
+	case "name":
+		return true, decodeJSONString(member, &s.Name, "string")
+	case "uri":
+		return true, decodeJSONString(member, &s.URI, "string")
+	}
+	if folded, ok := foldJSONName(name, "name", "uri"); ok {
+		opts.unknownFields.renameKey(folded)
+		return s.decodeJSONMember(folded, member, opts)
+	}
+	return false, nil
+}
+
+// deepCopy returns a copy of the struct that doesn't share any memory
+// with the original.
+func (s *TSSV1DataLiveLog) deepCopy() TSSV1DataLiveLog {
+	c := *s
+	return c
+}
+
+// equal returns true if all fields of the struct equal
+// the corresponding fields of the other struct.
+func (s *TSSV1DataLiveLog) equal(other *TSSV1DataLiveLog) bool {
+	return s.Name == other.Name &&
+		s.URI == other.URI
+}
+
+// Validate checks that the struct adheres to the constraints of its schema,
+// i.e. that mandatory fields are populated, that enum fields have valid
+// values, and that strings and arrays satisfy the schema's patterns, formats,
+// and length limits. Returns a *SchemaValidationError listing all problems.
+func (s *TSSV1DataLiveLog) Validate() error {
+	return validateStruct(s)
+}
+
+// validate appends any problems with the struct's fields to problems.
+// The pointer argument is the JSON pointer to the struct itself.
+func (s *TSSV1DataLiveLog) validate(pointer string, problems *[]FieldError) {
+	if s.Name == "" {
+		addFieldProblem(problems, pointer+"/name", "mandatory field is missing")
+	}
+	if s.URI == "" {
+		addFieldProblem(problems, pointer+"/uri", "mandatory field is missing")
+	}
+}
+
 type TSSV1DataType string
 
 const (
@@ -178,3 +792,12 @@ const (
 	TSSV1DataType_Stability        TSSV1DataType = "STABILITY"
 	TSSV1DataType_Usability        TSSV1DataType = "USABILITY"
 )
+
+// IsValid returns true if the value is one of the defined TSSV1DataType values.
+func (e TSSV1DataType) IsValid() bool {
+	switch e {
+	case TSSV1DataType_Accessibility, TSSV1DataType_BackupRecovery, TSSV1DataType_Compatibility, TSSV1DataType_Conversion, TSSV1DataType_DisasterRecovery, TSSV1DataType_Functional, TSSV1DataType_Installability, TSSV1DataType_Interoperability, TSSV1DataType_Localization, TSSV1DataType_Maintainability, TSSV1DataType_Performance, TSSV1DataType_Portability, TSSV1DataType_Procedure, TSSV1DataType_Reliability, TSSV1DataType_Security, TSSV1DataType_Stability, TSSV1DataType_Usability:
+		return true
+	}
+	return false
+}
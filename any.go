@@ -26,14 +26,15 @@ import (
 // to allow JSON objects with arrays of Eiffel events to be unmarshalled into
 // something useful.
 //
-// The type also implements the MetaTeller interface so if you only need e.g.
-// the ID of each event in a slice you don't have to do a type assertion on
-// every element.
+// The type also implements the Event interface (and therefore MetaTeller)
+// so if you only need e.g. the ID or the links of each event in a slice you
+// don't have to do a type assertion on every element.
 type Any struct {
 	event interface{}
 }
 
 var (
+	_ Event            = &Any{}
 	_ MetaTeller       = &Any{}
 	_ json.Marshaler   = &Any{}
 	_ json.Unmarshaler = &Any{}
@@ -96,3 +97,55 @@ func (a Any) Time() int64 {
 func (a Any) DomainID() string {
 	return a.event.(MetaTeller).DomainID() // nolint:forcetypeassert
 }
+
+// SupportsSigning returns true if the event supports signatures according
+// to V3 of the meta field, i.e. events where the signature is found under
+// meta.security.integrityProtection.
+func (a Any) SupportsSigning() bool {
+	return a.event.(CapabilityTeller).SupportsSigning() // nolint:forcetypeassert
+}
+
+// GetField returns the value of a field of the event. See FieldGetter for details.
+func (a Any) GetField(fieldName string) (interface{}, error) {
+	return a.event.(FieldGetter).GetField(fieldName) // nolint:forcetypeassert
+}
+
+// SetField sets a field of the event. See FieldSetter for details.
+func (a Any) SetField(fieldName string, value interface{}) error {
+	return a.event.(FieldSetter).SetField(fieldName, value) // nolint:forcetypeassert
+}
+
+// GetLinks returns the event's links.
+func (a Any) GetLinks() EventLinksV1 {
+	return a.event.(Event).GetLinks() // nolint:forcetypeassert
+}
+
+// SetLinks replaces the event's links.
+func (a Any) SetLinks(links EventLinksV1) {
+	a.event.(Event).SetLinks(links) // nolint:forcetypeassert
+}
+
+// AddLink adds a new link of the specified type to a target event.
+func (a Any) AddLink(linkType LinkType, target MetaTeller) {
+	a.event.(Event).AddLink(linkType, target) // nolint:forcetypeassert
+}
+
+// AddLinkByID adds a new link of the specified type to a target event identified by an ID.
+func (a Any) AddLinkByID(linkType LinkType, target string) {
+	a.event.(Event).AddLinkByID(linkType, target) // nolint:forcetypeassert
+}
+
+// CustomData returns the value of the data.customData field.
+func (a Any) CustomData() []CustomDataV1 {
+	return a.event.(Event).CustomData() // nolint:forcetypeassert
+}
+
+// Tags returns the value of the meta.tags field.
+func (a Any) Tags() []string {
+	return a.event.(Event).Tags() // nolint:forcetypeassert
+}
+
+// Source returns the value of the meta.source field.
+func (a Any) Source() EventSource {
+	return a.event.(Event).Source() // nolint:forcetypeassert
+}
@@ -0,0 +1,158 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var ErrIncompleteEvent error = errors.New("event is incomplete")
+
+// IncompleteEventError is returned by the Build methods of the event
+// builders when the event lacks mandatory fields or required links.
+// It matches ErrIncompleteEvent when inspected with errors.Is.
+type IncompleteEventError struct {
+	// MissingFields lists the dot-separated paths of the mandatory fields
+	// that haven't been populated, e.g. data.outcome.verdict.
+	MissingFields []string
+
+	// MissingLinks lists the required link types that the event lacks.
+	MissingLinks []LinkType
+}
+
+func (e *IncompleteEventError) Error() string {
+	var problems []string
+	if len(e.MissingFields) > 0 {
+		problems = append(problems, "missing mandatory field(s) "+strings.Join(e.MissingFields, ", "))
+	}
+	if len(e.MissingLinks) > 0 {
+		linkTypes := make([]string, len(e.MissingLinks))
+		for i, linkType := range e.MissingLinks {
+			linkTypes[i] = string(linkType)
+		}
+		problems = append(problems, "missing required link(s) "+strings.Join(linkTypes, ", "))
+	}
+	return fmt.Sprintf("%s: %s", ErrIncompleteEvent, strings.Join(problems, "; "))
+}
+
+func (e *IncompleteEventError) Is(target error) bool {
+	return target == ErrIncompleteEvent
+}
+
+// checkEventCompleteness makes sure that all mandatory fields of an event
+// have been populated and that it has all links required by the event
+// definition, and returns an *IncompleteEventError if not. Only strings
+// and slices can be told apart from deliberately set zero values, so
+// mandatory numbers and booleans are only reported as missing if they're
+// zero and their dot-separated paths are included in unsetFields, i.e.
+// the builder knows that they haven't been set.
+func checkEventCompleteness(event Event, unsetFields []string) error {
+	var incomplete IncompleteEventError
+	collectMissingFields(reflect.ValueOf(event), "", unsetFields, &incomplete.MissingFields)
+
+	// If there are no link rules for the event version (e.g. because
+	// a modifier changed it) there's nothing to check against.
+	rules, _ := LinkRules(event.Type(), event.Version())
+	links := event.GetLinks()
+	for _, rule := range rules {
+		if rule.Required && links.FindFirst(rule.Type) == "" {
+			incomplete.MissingLinks = append(incomplete.MissingLinks, rule.Type)
+		}
+	}
+
+	if len(incomplete.MissingFields) > 0 || len(incomplete.MissingLinks) > 0 {
+		return &incomplete
+	}
+	return nil
+}
+
+func collectMissingFields(v reflect.Value, path string, unsetFields []string, result *[]string) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name, omitempty := jsonFieldName(v.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			fieldPath := joinFieldPath(path, name)
+			field := v.Field(i)
+			if !omitempty && (isMissingValue(field) || field.IsZero() && slices.Contains(unsetFields, fieldPath)) {
+				*result = append(*result, fieldPath)
+				continue
+			}
+			// Optional fields that haven't been populated won't be
+			// marshaled so their mandatory subfields don't matter.
+			if omitempty && field.IsZero() {
+				continue
+			}
+			collectMissingFields(field, fieldPath, unsetFields, result)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			collectMissingFields(v.Index(i), fmt.Sprintf("%s[%d]", path, i), unsetFields, result)
+		}
+	}
+}
+
+// isMissingValue returns true if a mandatory field with the given value
+// would be considered missing or invalid when the event is marshaled.
+// Nil link slices are marshaled as empty arrays so they're never missing.
+func isMissingValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len() == 0
+	case reflect.Slice:
+		return v.IsNil() && v.Type() != reflect.TypeOf(EventLinksV1{})
+	}
+	return false
+}
+
+// fieldRecorder records the names of the fields set via the SetField
+// method of an event. The builders use it to tell whether a modifier has
+// set a mandatory number or boolean, even if it was set to its zero value.
+// The record method is a no-op for a nil recorder, which is what events
+// have unless a builder is applying its modifiers to them.
+type fieldRecorder struct {
+	fieldNames []string
+}
+
+func (r *fieldRecorder) record(fieldName string) {
+	if r != nil {
+		r.fieldNames = append(r.fieldNames, fieldName)
+	}
+}
+
+// hasRecorded returns true if the field with the given dot-separated path,
+// or any of its parents, has been set.
+func (r *fieldRecorder) hasRecorded(path string) bool {
+	for _, name := range r.fieldNames {
+		if name == path || strings.HasPrefix(path, name+".") {
+			return true
+		}
+	}
+	return false
+}
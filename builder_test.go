@@ -0,0 +1,201 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderCompleteEvent(t *testing.T) {
+	tcs, err := NewTestCaseStartedV3()
+	require.NoError(t, err)
+
+	event, err := BuildTestCaseFinishedV3(WithSourceHost("example.com")).
+		Verdict(TCFV3DataOutcomeVerdict_Passed).
+		Conclusion(TCFV3DataOutcomeConclusion_Successful).
+		TestCaseExecution(tcs).
+		With(WithSourceName("builder-test")).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "EiffelTestCaseFinishedEvent", event.Meta.Type)
+	assert.Equal(t, "3.3.0", event.Meta.Version)
+	assert.NotEmpty(t, event.Meta.ID)
+	assert.NotZero(t, event.Meta.Time)
+	assert.Equal(t, "example.com", event.Meta.Source.Host)
+	assert.Equal(t, "builder-test", event.Meta.Source.Name)
+	assert.Equal(t, TCFV3DataOutcomeVerdict_Passed, event.Data.Outcome.Verdict)
+	assert.Equal(t, TCFV3DataOutcomeConclusion_Successful, event.Data.Outcome.Conclusion)
+	assert.Equal(t, []string{tcs.ID()}, event.Links.FindAll(LinkType_TestCaseExecution))
+}
+
+func TestBuilderIncompleteEvent(t *testing.T) {
+	testcases := []struct {
+		name          string
+		build         func() (Event, error)
+		expectedError *IncompleteEventError
+	}{
+		{
+			name: "Nothing set",
+			build: func() (Event, error) {
+				return BuildTestCaseFinishedV3().Build()
+			},
+			expectedError: &IncompleteEventError{
+				MissingFields: []string{"data.outcome.conclusion", "data.outcome.verdict"},
+				MissingLinks:  []LinkType{LinkType_TestCaseExecution},
+			},
+		},
+		{
+			name: "Only link missing",
+			build: func() (Event, error) {
+				return BuildTestCaseFinishedV3().
+					Verdict(TCFV3DataOutcomeVerdict_Passed).
+					Conclusion(TCFV3DataOutcomeConclusion_Successful).
+					Build()
+			},
+			expectedError: &IncompleteEventError{
+				MissingLinks: []LinkType{LinkType_TestCaseExecution},
+			},
+		},
+		{
+			name: "Mandatory slice and slice element field missing",
+			build: func() (Event, error) {
+				return BuildArtifactPublishedV3().
+					Locations(ArtPV3DataLocation{Type: ArtPV3DataLocationType_Nexus}).
+					ArtifactByID("aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1").
+					Build()
+			},
+			expectedError: &IncompleteEventError{
+				MissingFields: []string{"data.locations[0].uri"},
+			},
+		},
+		{
+			name: "Mandatory number never set",
+			build: func() (Event, error) {
+				return BuildSourceChangeCreatedV4().
+					Directory("trunk").
+					SvnIdentifierRepoURI("https://svn.example.com/repo").
+					Build()
+			},
+			expectedError: &IncompleteEventError{
+				MissingFields: []string{"data.svnIdentifier.revision"},
+			},
+		},
+		{
+			name: "Mandatory field cleared by modifier",
+			build: func() (Event, error) {
+				return BuildCompositionDefinedV3().
+					Name("my-composition").
+					With(func(fieldSetter FieldSetter) error {
+						return fieldSetter.SetField("data.name", "")
+					}).
+					Build()
+			},
+			expectedError: &IncompleteEventError{
+				MissingFields: []string{"data.name"},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.build()
+			assert.ErrorIs(t, err, ErrIncompleteEvent)
+			var incompleteErr *IncompleteEventError
+			require.ErrorAs(t, err, &incompleteErr)
+			assert.Equal(t, tc.expectedError, incompleteErr)
+		})
+	}
+}
+
+func TestBuilderMandatoryNumberSetToZero(t *testing.T) {
+	event, err := BuildSourceChangeCreatedV4().
+		Directory("trunk").
+		SvnIdentifierRepoURI("https://svn.example.com/repo").
+		Revision(0).
+		Build()
+	require.NoError(t, err)
+	assert.Zero(t, event.Data.SvnIdentifier.Revision)
+
+	// An unset mandatory number of an optional parent that isn't
+	// populated doesn't matter.
+	_, err = BuildSourceChangeCreatedV4().Build()
+	require.NoError(t, err)
+
+	// Modifiers may also set the number to zero, either directly
+	// or by setting a parent field.
+	for _, fieldName := range []string{"data.svnIdentifier.revision", "data.svnIdentifier"} {
+		t.Run(fieldName, func(t *testing.T) {
+			_, err := BuildSourceChangeCreatedV4().
+				Directory("trunk").
+				SvnIdentifierRepoURI("https://svn.example.com/repo").
+				With(func(fieldSetter FieldSetter) error {
+					if fieldName == "data.svnIdentifier" {
+						return fieldSetter.SetField(fieldName, map[string]interface{}{
+							"directory": "trunk",
+							"repoUri":   "https://svn.example.com/repo",
+							"revision":  0,
+						})
+					}
+					return fieldSetter.SetField(fieldName, 0)
+				}).
+				Build()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBuilderModifierError(t *testing.T) {
+	modifierErr := errors.New("modifier failed")
+	_, err := BuildCompositionDefinedV3(func(fieldSetter FieldSetter) error {
+		return modifierErr
+	}).Name("my-composition").Build()
+	assert.ErrorIs(t, err, modifierErr)
+}
+
+func TestBuilderReuse(t *testing.T) {
+	builder := BuildCompositionDefinedV3().Name("my-composition").ElementByID("aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1")
+	event1, err := builder.Build()
+	require.NoError(t, err)
+	event1.AddLinkByID(LinkType_Cause, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2")
+
+	event2, err := builder.Build()
+	require.NoError(t, err)
+	assert.NotEqual(t, event1.ID(), event2.ID())
+	assert.Len(t, event2.Links, 1)
+
+	// Custom data values aren't shared between the events either.
+	builder.CustomData(CustomDataV1{Key: "k", Value: map[string]interface{}{"a": "b"}})
+	event1, err = builder.Build()
+	require.NoError(t, err)
+	event1.Data.CustomData[0].Value.(map[string]interface{})["a"] = "c"
+	event2, err = builder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "b"}, event2.Data.CustomData[0].Value)
+}
+
+func TestIncompleteEventErrorMessage(t *testing.T) {
+	err := &IncompleteEventError{
+		MissingFields: []string{"data.name", "data.version"},
+		MissingLinks:  []LinkType{"CAUSE"},
+	}
+	assert.Equal(t,
+		"event is incomplete: missing mandatory field(s) data.name, data.version; missing required link(s) CAUSE",
+		err.Error())
+}
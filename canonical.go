@@ -0,0 +1,96 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gowebpki/jcs"
+	"github.com/tidwall/sjson"
+)
+
+var ErrHashUnavailable error = errors.New("hash function unavailable")
+
+// volatileMetaFields are the fields removed by the ExcludeVolatileMeta option.
+var volatileMetaFields = []string{"meta.id", "meta.time", "meta.security"}
+
+type canonicalConfig struct {
+	excludedFields []string
+}
+
+// CanonicalOption is a function that configures Canonicalize and Digest.
+type CanonicalOption func(*canonicalConfig)
+
+// ExcludeVolatileMeta removes the meta.id, meta.time, and meta.security
+// fields from the event before it's canonicalized. Those fields typically
+// differ between two events that otherwise describe the same thing, e.g.
+// when a producer retries the publishing of an event by creating it anew,
+// so excluding them makes it possible to detect such duplicates.
+func ExcludeVolatileMeta() CanonicalOption {
+	return func(cfg *canonicalConfig) {
+		cfg.excludedFields = append(cfg.excludedFields, volatileMetaFields...)
+	}
+}
+
+// Canonicalize returns the canonical JSON representation of an event
+// according to RFC 8785 (JSON Canonicalization Scheme), i.e. the same
+// representation that's used when signing events. Two events with the
+// same contents have the same canonical representation regardless of
+// e.g. object member order or whitespace in their original encodings.
+// Any value that can be marshaled to an event, e.g. *Any and RawEvent,
+// can be canonicalized.
+func Canonicalize(event json.Marshaler, opts ...CanonicalOption) ([]byte, error) {
+	var cfg canonicalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	eventBytes, err := event.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling event: %w", err)
+	}
+	for _, field := range cfg.excludedFields {
+		if eventBytes, err = sjson.DeleteBytes(eventBytes, field); err != nil {
+			return nil, fmt.Errorf("error removing %s from event: %w", field, err)
+		}
+	}
+	if eventBytes, err = jcs.Transform(eventBytes); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+	}
+	return eventBytes, nil
+}
+
+// Digest returns the hash of the canonical JSON representation of
+// an event, as returned by Canonicalize. Together with the
+// ExcludeVolatileMeta option this can be used to deduplicate or
+// content-address events. An ErrHashUnavailable error is returned
+// if the hash function hasn't been linked into the binary.
+func Digest(event json.Marshaler, hash crypto.Hash, opts ...CanonicalOption) ([]byte, error) {
+	if !hash.Available() {
+		return nil, fmt.Errorf("%w: %s", ErrHashUnavailable, hash)
+	}
+	canonical, err := Canonicalize(event, opts...)
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write(canonical)
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,112 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	testcases := []struct {
+		name     string
+		input    string
+		opts     []CanonicalOption
+		expected string
+	}{
+		{
+			name:     "Members are sorted and whitespace removed",
+			input:    "{\n  \"meta\": {\"version\": \"3.0.0\", \"id\": \"x\"},\n  \"data\": {\"b\": 1.0, \"a\": \"\\u00e5\"}\n}",
+			expected: `{"data":{"a":"å","b":1},"meta":{"id":"x","version":"3.0.0"}}`,
+		},
+		{
+			name:     "Volatile meta fields excluded",
+			input:    `{"meta": {"id": "x", "time": 1, "type": "T", "security": {"authorIdentity": "me"}}, "data": {}}`,
+			opts:     []CanonicalOption{ExcludeVolatileMeta()},
+			expected: `{"data":{},"meta":{"type":"T"}}`,
+		},
+		{
+			name:     "Excluding missing fields is fine",
+			input:    `{"meta": {"type": "T"}}`,
+			opts:     []CanonicalOption{ExcludeVolatileMeta()},
+			expected: `{"meta":{"type":"T"}}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Canonicalize(RawEvent(tc.input), tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestCanonicalizeMalformedInput(t *testing.T) {
+	_, err := Canonicalize(RawEvent(`{"meta": `))
+	assert.ErrorIs(t, err, ErrMalformedInput)
+}
+
+func TestDigestDetectsDuplicates(t *testing.T) {
+	newEvent := func() *CompositionDefinedV3 {
+		event, err := BuildCompositionDefinedV3().Name("my-composition").Build()
+		require.NoError(t, err)
+		return event
+	}
+	event1 := newEvent()
+	event2 := newEvent()
+	event2.Meta.Time = event1.Meta.Time + 1000
+	event2.Meta.Security.AuthorIdentity = "CN=someone"
+
+	digest1, err := Digest(event1, crypto.SHA256, ExcludeVolatileMeta())
+	require.NoError(t, err)
+	digest2, err := Digest(event2, crypto.SHA256, ExcludeVolatileMeta())
+	require.NoError(t, err)
+	assert.Equal(t, digest1, digest2)
+	assert.Len(t, digest1, sha256.Size)
+
+	// Without the option the events differ.
+	digest1, err = Digest(event1, crypto.SHA256)
+	require.NoError(t, err)
+	digest2, err = Digest(event2, crypto.SHA256)
+	require.NoError(t, err)
+	assert.NotEqual(t, digest1, digest2)
+
+	// But a real difference is detected with the option too.
+	event2.Data.Name = "other-composition"
+	digest2, err = Digest(event2, crypto.SHA256, ExcludeVolatileMeta())
+	require.NoError(t, err)
+	digest1, err = Digest(event1, crypto.SHA256, ExcludeVolatileMeta())
+	require.NoError(t, err)
+	assert.NotEqual(t, digest1, digest2)
+}
+
+func TestDigestMatchesCanonicalForm(t *testing.T) {
+	event := RawEvent(`{"b": 1, "a": 2}`)
+	digest, err := Digest(event, crypto.SHA256)
+	require.NoError(t, err)
+	expected := sha256.Sum256([]byte(`{"a":2,"b":1}`))
+	assert.Equal(t, expected[:], digest)
+}
+
+func TestDigestUnavailableHash(t *testing.T) {
+	_, err := Digest(RawEvent(`{}`), crypto.RIPEMD160)
+	assert.ErrorIs(t, err, ErrHashUnavailable)
+}
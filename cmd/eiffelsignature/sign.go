@@ -19,9 +19,9 @@ package main
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
-	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
@@ -49,11 +49,9 @@ func signCmd(args []string, in io.Reader, out io.Writer) error {
 		return fmt.Errorf("unable to create key signer: %w", err)
 	}
 
-	decoder := json.NewDecoder(in)
+	decoder := eiffelevents.NewDecoder(in)
 	for {
-		var payloadIn json.RawMessage
-
-		err := decoder.Decode(&payloadIn)
+		event, err := decoder.Decode()
 		if err == io.EOF {
 			break
 		}
@@ -61,12 +59,7 @@ func signCmd(args []string, in io.Reader, out io.Writer) error {
 			return fmt.Errorf("unable to decode input stream: %w", err)
 		}
 
-		event, err := eiffelevents.UnmarshalAny(payloadIn)
-		if err != nil {
-			return fmt.Errorf("unable to unmarshal event: %w", err)
-		}
-
-		// We know the event we get from UnmarshalAny implements SigningSubject.
+		// We know the event we get from the decoder implements SigningSubject.
 		payloadOut, err := signer.Sign(event.(signature.SigningSubject)) // nolint:forcetypeassert
 		if err != nil {
 			return fmt.Errorf("unable to sign event: %w", err)
@@ -82,7 +75,8 @@ func signCmd(args []string, in io.Reader, out io.Writer) error {
 
 // loadPrivateKey attempts to read and parse a private key file encoded
 // as PEM. It supports RSA and ECDSA keys, either in their native
-// encodings (PKCS#1 and SEC1, respectively) or as PKCS#8.
+// encodings (PKCS#1 and SEC1, respectively) or as PKCS#8, and Ed25519
+// keys as PKCS#8.
 func loadPrivateKey(path string) (crypto.PrivateKey, error) {
 	pemData, err := os.ReadFile(path)
 	if err != nil {
@@ -102,7 +96,7 @@ func loadPrivateKey(path string) (crypto.PrivateKey, error) {
 		case "PRIVATE KEY":
 			if k, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
 				switch pk := k.(type) {
-				case *rsa.PrivateKey, *ecdsa.PrivateKey:
+				case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
 					return pk, nil
 				default:
 					return nil, fmt.Errorf("unsupported key type in PKCS#8: %T", k)
@@ -18,7 +18,9 @@ package main
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
@@ -31,47 +33,87 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/eiffel-community/eiffelevents-sdk-go"
+	"github.com/eiffel-community/eiffelevents-sdk-go/signature"
 )
 
 // TestSignAndVerify is a simple smoke test for the eiffelsignature subcommands
 // that generates a keypair and an event, signs the event with the "sign" command
 // and verifies it with the "verify" command.
 func TestSignAndVerify(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		alg        string
+		privateKey crypto.Signer
+	}{
+		{
+			name:       "ECDSA",
+			alg:        "ES512",
+			privateKey: ecdsaKey,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dn := "CN=test"
+			privateKeyPath := filepath.Join(t.TempDir(), "private.pem")
+			publicKeyDir := t.TempDir()
+
+			createKeypairFiles(t, tc.privateKey, privateKeyPath, filepath.Join(publicKeyDir, dn+".pem"))
+
+			event, err := eiffelevents.NewCompositionDefinedV3()
+			require.NoError(t, err)
+			event.Data.Name = "my-composition"
+
+			var signedEvent bytes.Buffer
+			require.NoError(t, signCmd([]string{privateKeyPath, dn, tc.alg}, strings.NewReader(event.String()), &signedEvent))
+			require.NoError(t, verifyCmd(t.Context(), []string{publicKeyDir}, &signedEvent))
+		})
+	}
+}
+
+// TestSignEdDSAUnavailable checks that Ed25519 private keys are loaded but
+// that signing fails since no event version can express EdDSA yet.
+func TestSignEdDSAUnavailable(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
 	dn := "CN=test"
 	privateKeyPath := filepath.Join(t.TempDir(), "private.pem")
-	publicKeyDir := t.TempDir()
-
-	createKeypairFiles(t, privateKeyPath, filepath.Join(publicKeyDir, dn+".pem"))
+	createKeypairFiles(t, ed25519Key, privateKeyPath, filepath.Join(t.TempDir(), dn+".pem"))
 
 	event, err := eiffelevents.NewCompositionDefinedV3()
 	require.NoError(t, err)
 	event.Data.Name = "my-composition"
 
 	var signedEvent bytes.Buffer
-	require.NoError(t, signCmd([]string{privateKeyPath, dn, "ES512"}, strings.NewReader(event.String()), &signedEvent))
-	require.NoError(t, verifyCmd(t.Context(), []string{publicKeyDir}, &signedEvent))
+	err = signCmd([]string{privateKeyPath, dn, "EdDSA"}, strings.NewReader(event.String()), &signedEvent)
+	require.ErrorIs(t, err, signature.ErrSigningUnavailable)
 }
 
-func createKeypairFiles(t *testing.T, privateKeyPath string, publicKeyFilePath string) {
-	// Generate a new ECDSA private key.
-	privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
-	require.NoError(t, err)
-
+// createKeypairFiles writes the private key and its public key to PEM files.
+// ECDSA private keys are written in their native SEC1 encoding and other
+// keys as PKCS#8.
+func createKeypairFiles(t *testing.T, privateKey crypto.Signer, privateKeyPath string, publicKeyFilePath string) {
 	// Encode the private to DER and marshal that to a PEM file.
-	privBytes, err := x509.MarshalECPrivateKey(privateKey)
+	privBlock := &pem.Block{}
+	var err error
+	if ecdsaKey, ok := privateKey.(*ecdsa.PrivateKey); ok {
+		privBlock.Type = "EC PRIVATE KEY"
+		privBlock.Bytes, err = x509.MarshalECPrivateKey(ecdsaKey)
+	} else {
+		privBlock.Type = "PRIVATE KEY"
+		privBlock.Bytes, err = x509.MarshalPKCS8PrivateKey(privateKey)
+	}
 	require.NoError(t, err)
 
 	privateKeyFile, err := os.Create(privateKeyPath)
 	require.NoError(t, err)
 	defer privateKeyFile.Close()
-	err = pem.Encode(privateKeyFile, &pem.Block{
-		Type:  "EC PRIVATE KEY",
-		Bytes: privBytes,
-	})
-	require.NoError(t, err)
+	require.NoError(t, pem.Encode(privateKeyFile, privBlock))
 
 	// Extract the public part of the key, encode to DER and marshal to a PEM file.
-	pubBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	pubBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
 	require.NoError(t, err)
 
 	publicKeyFile, err := os.Create(publicKeyFilePath)
@@ -18,10 +18,10 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
+	"github.com/eiffel-community/eiffelevents-sdk-go"
 	"github.com/eiffel-community/eiffelevents-sdk-go/signature"
 )
 
@@ -33,11 +33,9 @@ func verifyCmd(ctx context.Context, args []string, in io.Reader) error {
 
 	locator := signature.NewFSPublicKeyLocator(signature.FSPublicKeyLocatorConfig{KeyDirectory: keyDir})
 	verifier := signature.NewVerifier(locator)
-	decoder := json.NewDecoder(in)
+	decoder := eiffelevents.NewDecoder(in)
 	for {
-		var payloadIn json.RawMessage
-
-		err := decoder.Decode(&payloadIn)
+		payloadIn, err := decoder.DecodeRaw()
 		if err == io.EOF {
 			break
 		}
@@ -45,7 +43,7 @@ func verifyCmd(ctx context.Context, args []string, in io.Reader) error {
 			return fmt.Errorf("unable to decode input stream: %w", err)
 		}
 
-		if err := verifier.Verify(ctx, []byte(payloadIn)); err != nil {
+		if err := verifier.Verify(ctx, payloadIn); err != nil {
 			return fmt.Errorf("unable to verify event signature: %w", err)
 		}
 	}
@@ -0,0 +1,363 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/package-url/packageurl-go"
+)
+
+var ErrLossyConversion error = errors.New("conversion would lose information")
+
+// ConversionReport describes the differences between an event
+// and the result of converting it to another version.
+type ConversionReport struct {
+	// Dropped contains the paths of fields that had a non-empty value in the source event
+	// but don't exist (or have an incompatible type or invalid value) in the target version.
+	Dropped []string
+
+	// Defaulted contains the paths of mandatory fields in the target version
+	// that didn't have any value in the source event and therefore were given
+	// their zero value.
+	Defaulted []string
+
+	// Invalidated contains the paths of fields that were removed because
+	// their values wouldn't be valid after the conversion, e.g. signatures
+	// and schema URIs.
+	Invalidated []string
+}
+
+// Convert converts an event, e.g. as returned by UnmarshalAny, to another
+// major version of the same event type. Only the major version of targetVersion
+// is significant; the returned event (a pointer to a struct) always has the most
+// recent version within that major version since that's the version that the
+// struct represents. The meta.id field is retained.
+//
+// Fields that don't exist in the target version or whose values aren't valid
+// there (e.g. enum values that the target version doesn't define) are dropped,
+// and mandatory fields that the source event doesn't have get their zero value.
+// Differences in the meta field layouts, e.g. between the sdm block of MetaV1 and MetaV2 and the
+// security block of MetaV3, are handled. Any signature or schema URI is removed
+// since they wouldn't be valid for the converted event. All such changes are
+// listed in the returned ConversionReport.
+//
+// Conversions to an older major version are only permitted if no fields are
+// dropped; otherwise an ErrLossyConversion error is returned along with a report
+// of what would've been dropped. If the event type or target version isn't
+// supported an ErrUnsupportedEvent error is returned.
+func Convert(event interface{}, targetVersion string) (interface{}, *ConversionReport, error) {
+	if a, ok := event.(*Any); ok {
+		event = a.Get()
+	} else if a, ok := event.(Any); ok {
+		event = a.Get()
+	}
+	mt, ok := event.(MetaTeller)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: value of type %T isn't an Eiffel event", ErrUnsupportedEvent, event)
+	}
+
+	srcVersion, err := semver.NewVersion(mt.Version())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: unable to parse meta.version: %s", ErrMalformedInput, err)
+	}
+	dstVersion, err := semver.NewVersion(targetVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse target version %q: %w", targetVersion, err)
+	}
+	dstMajorVersion, ok := eventTypeTable[mt.Type()][dstVersion.Major()]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s has no major version %d", ErrUnsupportedEvent, mt.Type(), dstVersion.Major())
+	}
+
+	// Make sure we have a pointer to the struct so that the event's
+	// own MarshalJSON method gets used.
+	srcVal := reflect.ValueOf(event)
+	if srcVal.Kind() != reflect.Ptr {
+		ptr := reflect.New(srcVal.Type())
+		ptr.Elem().Set(srcVal)
+		srcVal = ptr
+	}
+	srcType := srcVal.Elem().Type()
+	if srcType.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("%w: value of type %T isn't an Eiffel event", ErrUnsupportedEvent, event)
+	}
+
+	srcJSON, err := json.Marshal(srcVal.Interface())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling source event: %w", err)
+	}
+	var doc map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(srcJSON))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("error decoding source event: %w", err)
+	}
+
+	report := &ConversionReport{}
+	if meta, ok := doc["meta"].(map[string]interface{}); ok {
+		convertMeta(meta, metaMajorVersion(srcType), metaMajorVersion(dstMajorVersion.structType), report)
+	}
+	projected, _ := projectValue(doc, dstMajorVersion.structType, "", report)
+	if meta, ok := projected.(map[string]interface{})["meta"].(map[string]interface{}); ok {
+		meta["version"] = dstMajorVersion.latestVersion
+	}
+
+	if dstVersion.Major() < srcVersion.Major() && len(report.Dropped) > 0 {
+		return nil, report, fmt.Errorf("%w: converting %s %s to %s would drop %s",
+			ErrLossyConversion, mt.Type(), mt.Version(), dstMajorVersion.latestVersion, strings.Join(report.Dropped, ", "))
+	}
+
+	dstJSON, err := json.Marshal(projected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling converted event: %w", err)
+	}
+	result := reflect.New(dstMajorVersion.structType).Interface()
+	if err := json.Unmarshal(dstJSON, result); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling converted event: %w", err)
+	}
+	return result, report, nil
+}
+
+// metaMajorVersion returns the major version of the meta field
+// of the given event struct type.
+func metaMajorVersion(eventType reflect.Type) int {
+	field, ok := eventType.FieldByName("Meta")
+	if !ok {
+		return 0
+	}
+	switch field.Type {
+	case reflect.TypeOf(MetaV1{}):
+		return 1
+	case reflect.TypeOf(MetaV2{}):
+		return 2
+	case reflect.TypeOf(MetaV3{}):
+		return 3
+	}
+	return 0
+}
+
+// convertMeta transforms the meta object of an event in place
+// from one major version of the meta field to another.
+func convertMeta(meta map[string]interface{}, from int, to int, report *ConversionReport) {
+	// Neither the schema URI nor any signature will be valid after the conversion.
+	if _, ok := meta["schemaUri"]; ok {
+		delete(meta, "schemaUri")
+		report.Invalidated = append(report.Invalidated, "meta.schemaUri")
+	}
+	security, _ := meta["security"].(map[string]interface{})
+	if _, ok := security["integrityProtection"]; ok {
+		delete(security, "integrityProtection")
+		report.Invalidated = append(report.Invalidated, "meta.security.integrityProtection")
+	}
+
+	// MetaV1 expresses the serializer as a Maven GAV object while
+	// later versions use a purl string.
+	if source, ok := meta["source"].(map[string]interface{}); ok {
+		if from == 1 && to > 1 {
+			if gav, ok := source["serializer"].(map[string]interface{}); ok {
+				groupID, _ := gav["groupId"].(string)
+				artifactID, _ := gav["artifactId"].(string)
+				version, _ := gav["version"].(string)
+				source["serializer"] = mavenPurl(groupID, artifactID, version)
+			}
+		} else if from > 1 && to == 1 {
+			if purl, ok := source["serializer"].(string); ok {
+				if gav := purlToGAV(purl); gav != nil {
+					source["serializer"] = gav
+				}
+			}
+		}
+	}
+
+	// MetaV1 and MetaV2 keep the author identity in the sdm object
+	// while MetaV3 has it directly in the security object.
+	if security != nil {
+		if from < 3 && to == 3 {
+			if sdm, ok := security["sdm"].(map[string]interface{}); ok {
+				delete(security, "sdm")
+				security["authorIdentity"] = sdm["authorIdentity"]
+				if digest, _ := sdm["encryptedDigest"].(string); digest != "" {
+					report.Dropped = append(report.Dropped, "meta.security.sdm.encryptedDigest")
+				}
+			}
+		} else if from == 3 && to < 3 {
+			if authorIdentity, ok := security["authorIdentity"]; ok {
+				delete(security, "authorIdentity")
+				security["sdm"] = map[string]interface{}{
+					"authorIdentity": authorIdentity,
+				}
+			}
+		}
+	}
+}
+
+// purlToGAV converts a Maven purl to a MetaV1 serializer object.
+// Returns nil if the purl can't be converted.
+func purlToGAV(purl string) map[string]interface{} {
+	p, err := packageurl.FromString(purl)
+	if err != nil || p.Type != packageurl.TypeMaven {
+		return nil
+	}
+	return map[string]interface{}{
+		"groupId":    p.Namespace,
+		"artifactId": p.Name,
+		"version":    p.Version,
+	}
+}
+
+// projectValue returns a copy of a value decoded from JSON that only
+// contains the parts that are representable by the given type. Differences
+// are recorded in the report. The second return value is false if the value
+// as a whole is incompatible with the type.
+func projectValue(value interface{}, t reflect.Type, path string, report *ConversionReport) (interface{}, bool) {
+	if value == nil {
+		return nil, true
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		result := make(map[string]interface{}, len(obj))
+		known := make(map[string]bool, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			name, omitempty := jsonFieldName(t.Field(i))
+			if name == "" {
+				continue
+			}
+			known[name] = true
+			fieldPath := joinFieldPath(path, name)
+			if fieldValue, present := obj[name]; present {
+				if projected, ok := projectValue(fieldValue, t.Field(i).Type, fieldPath, report); ok {
+					result[name] = projected
+					continue
+				}
+				if !isEmptyJSONValue(fieldValue) {
+					report.Dropped = append(report.Dropped, fieldPath)
+				}
+			}
+			if !omitempty {
+				report.Defaulted = append(report.Defaulted, fieldPath)
+			}
+		}
+		var unknown []string
+		for name, fieldValue := range obj {
+			if !known[name] && !isEmptyJSONValue(fieldValue) {
+				unknown = append(unknown, joinFieldPath(path, name))
+			}
+		}
+		sort.Strings(unknown)
+		report.Dropped = append(report.Dropped, unknown...)
+		return result, true
+	case reflect.Slice:
+		arr, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		result := make([]interface{}, 0, len(arr))
+		for i, elem := range arr {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			if projected, ok := projectValue(elem, t.Elem(), elemPath, report); ok {
+				result = append(result, projected)
+			} else {
+				report.Dropped = append(report.Dropped, elemPath)
+			}
+		}
+		return result, true
+	case reflect.Interface:
+		return value, true
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		// Enum values must be among the values defined by the target type.
+		if enum, isEnum := reflect.ValueOf(s).Convert(t).Interface().(interface{ IsValid() bool }); isEnum && s != "" && !enum.IsValid() {
+			return nil, false
+		}
+		return value, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		_, err := n.Int64()
+		return value, err == nil
+	case reflect.Float32, reflect.Float64:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		_, err := n.Float64()
+		return value, err == nil
+	case reflect.Bool:
+		_, ok := value.(bool)
+		return value, ok
+	}
+	return nil, false
+}
+
+// isEmptyJSONValue returns true if a value decoded from JSON is null,
+// false, zero, or an empty string, array, or object. Dropping such
+// a value isn't considered a loss of information.
+func isEmptyJSONValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// jsonFieldName returns the name of a struct field according to its "json"
+// tag and whether the field has the omitempty option. Returns an empty name
+// for fields that aren't included in the JSON representation.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	return name, strings.Contains(","+opts+",", ",omitempty,")
+}
+
+// joinFieldPath appends a field name to a dot-separated field path.
+func joinFieldPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,139 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertUpgrade(t *testing.T) {
+	src, err := NewSourceChangeCreatedV1()
+	require.NoError(t, err)
+	src.Meta.Source.Serializer = MetaV1SourceSerializer{
+		GroupID:    "com.example",
+		ArtifactID: "app",
+		Version:    "1.0",
+	}
+	src.Meta.Security.SDM = MetaV1SecuritySDM{
+		AuthorIdentity:  "CN=John Doe",
+		EncryptedDigest: "abc123",
+	}
+	src.Data.Author.Name = "John Doe"
+	src.Data.Issues = []interface{}{"JIRA-1"}
+	src.Links = EventLinksV1{{Type: "CAUSE", Target: "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}}
+
+	result, report, err := Convert(src, "4.0.0")
+	require.NoError(t, err)
+	require.IsType(t, &SourceChangeCreatedV4{}, result)
+	dst := result.(*SourceChangeCreatedV4)
+
+	assert.Equal(t, src.Meta.ID, dst.Meta.ID)
+	assert.Equal(t, src.Meta.Time, dst.Meta.Time)
+	assert.Equal(t, eventTypeTable["EiffelSourceChangeCreatedEvent"][4].latestVersion, dst.Meta.Version)
+	assert.Equal(t, "pkg:maven/com.example/app@1.0", dst.Meta.Source.Serializer)
+	assert.Equal(t, "CN=John Doe", dst.Meta.Security.AuthorIdentity)
+	assert.Equal(t, "John Doe", dst.Data.Author.Name)
+	assert.Equal(t, src.Links, dst.Links)
+
+	assert.Equal(t, []string{"meta.security.sdm.encryptedDigest", "data.issues"}, report.Dropped)
+	assert.Empty(t, report.Defaulted)
+	assert.Empty(t, report.Invalidated)
+}
+
+func TestConvertDowngrade(t *testing.T) {
+	src, err := NewSourceChangeCreatedV4(WithSourceSerializer("pkg:maven/com.example/app@1.0"))
+	require.NoError(t, err)
+	src.Meta.Security.AuthorIdentity = "CN=John Doe"
+	src.Data.Author.Name = "John Doe"
+
+	result, report, err := Convert(src, "1")
+	require.NoError(t, err)
+	require.IsType(t, &SourceChangeCreatedV1{}, result)
+	dst := result.(*SourceChangeCreatedV1)
+
+	assert.Equal(t, "1.1.0", dst.Meta.Version)
+	assert.Equal(t, MetaV1SourceSerializer{GroupID: "com.example", ArtifactID: "app", Version: "1.0"}, dst.Meta.Source.Serializer)
+	assert.Equal(t, "CN=John Doe", dst.Meta.Security.SDM.AuthorIdentity)
+	assert.Equal(t, "John Doe", dst.Data.Author.Name)
+	assert.Empty(t, report.Dropped)
+	assert.Equal(t, []string{"meta.security.sdm.encryptedDigest"}, report.Defaulted)
+}
+
+func TestConvertLossyDowngrade(t *testing.T) {
+	src, err := NewSourceChangeCreatedV4(WithSourceSerializer("pkg:golang/example.com/app@1.0"))
+	require.NoError(t, err)
+	src.Meta.SchemaURI = "https://example.com/schema.json"
+
+	// SourceChangeCreatedV1 and V2 both use MetaV1, whose Maven GAV
+	// serializer can't express a non-Maven purl.
+	_, report, err := Convert(src, "2.0.0")
+	require.ErrorIs(t, err, ErrLossyConversion)
+	assert.Equal(t, []string{"meta.schemaUri"}, report.Invalidated)
+	assert.Equal(t, []string{"meta.source.serializer"}, report.Dropped)
+
+	_, report, err = Convert(src, "1.0.0")
+	require.ErrorIs(t, err, ErrLossyConversion)
+	assert.Equal(t, []string{"meta.schemaUri"}, report.Invalidated)
+	assert.Equal(t, []string{"meta.source.serializer"}, report.Dropped)
+}
+
+func TestConvertInvalidEnumValue(t *testing.T) {
+	src, err := NewTestCaseFinishedV3()
+	require.NoError(t, err)
+	src.Data.Outcome.Verdict = "BOGUS"
+	src.Data.Outcome.Conclusion = TCFV3DataOutcomeConclusion_Successful
+
+	result, report, err := Convert(src, "3.0.0")
+	require.NoError(t, err)
+	dst := result.(*TestCaseFinishedV3)
+	assert.Empty(t, dst.Data.Outcome.Verdict)
+	assert.Equal(t, TCFV3DataOutcomeConclusion_Successful, dst.Data.Outcome.Conclusion)
+	assert.Equal(t, []string{"data.outcome.verdict"}, report.Dropped)
+
+	_, report, err = Convert(src, "2.0.0")
+	require.ErrorIs(t, err, ErrLossyConversion)
+	assert.Equal(t, []string{"data.outcome.verdict"}, report.Dropped)
+}
+
+func TestConvertAllTypes(t *testing.T) {
+	for eventType, majorVersions := range eventTypeTable {
+		for srcMajor, srcVersion := range majorVersions {
+			for _, dstVersion := range majorVersions {
+				src := reflect.New(srcVersion.structType).Interface().(FieldSetter)
+				require.NoError(t, src.SetField("meta.type", eventType))
+				require.NoError(t, src.SetField("meta.version", srcVersion.latestVersion))
+				result, _, err := Convert(src, dstVersion.latestVersion)
+				require.NoError(t, err, "converting %s from major version %d to %s", eventType, srcMajor, dstVersion.latestVersion)
+				assert.IsType(t, reflect.New(dstVersion.structType).Interface(), result)
+				assert.Equal(t, dstVersion.latestVersion, result.(MetaTeller).Version())
+			}
+		}
+	}
+}
+
+func TestConvertUnsupported(t *testing.T) {
+	src, err := NewSourceChangeCreatedV4()
+	require.NoError(t, err)
+	_, _, err = Convert(src, "99.0.0")
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+	_, _, err = Convert("not an event", "1.0.0")
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+}
@@ -0,0 +1,160 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/clarketm/json"
+)
+
+// deepCopySlice returns a copy of a slice, using copyElem to copy each
+// element or plain assignment if copyElem is nil. A nil slice remains nil.
+func deepCopySlice[S ~[]T, T any](s S, copyElem func(elem *T) T) S {
+	if s == nil {
+		return nil
+	}
+	c := make(S, len(s))
+	for i := range s {
+		if copyElem != nil {
+			c[i] = copyElem(&s[i])
+		} else {
+			c[i] = s[i]
+		}
+	}
+	return c
+}
+
+// equalSlices returns true if two slices have the same length and
+// equalElem returns true for each pair of elements. Nil slices are
+// considered equal to empty slices.
+func equalSlices[T any](a []T, b []T, equalElem func(a *T, b *T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalElem(&a[i], &b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deepCopyValue returns a deep copy of the value of an interface{} field.
+// Values produced by JSON unmarshaling (maps, slices, and primitive values)
+// are copied without reflection but any other value is copied recursively
+// via reflection.
+func deepCopyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil, bool, float64, string, json.Number:
+		return v
+	case map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		c := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			c[key] = deepCopyValue(value)
+		}
+		return c
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		c := make([]interface{}, len(v))
+		for i, value := range v {
+			c[i] = deepCopyValue(value)
+		}
+		return c
+	}
+	return deepCopyReflectValue(reflect.ValueOf(v)).Interface()
+}
+
+func deepCopyReflectValue(v reflect.Value) reflect.Value {
+	switch v.Kind() { // nolint:exhaustive
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyReflectValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyReflectValue(v.Elem()))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopyReflectValue(iter.Value()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyReflectValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyReflectValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		// Unexported fields can't be set via reflection
+		// so they're shallow copied along with the struct.
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopyReflectValue(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// equalValues returns true if two values of interface{} fields are deeply
+// equal or have the same JSON encoding. The latter makes e.g. the integer 1
+// equal to the float64 value 1 that JSON unmarshaling produces.
+func equalValues(a interface{}, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,150 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeepCopyIsIndependent(t *testing.T) {
+	input := `{
+		"meta": {"type": "EiffelTestCaseFinishedEvent", "version": "3.3.0", "time": 1, "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "tags": ["a"], "unknown": 1},
+		"data": {
+			"outcome": {
+				"verdict": "PASSED",
+				"conclusion": "SUCCESSFUL",
+				"metrics": [{"name": "duration", "value": {"seconds": [1, 2]}}]
+			},
+			"customData": [{"key": "k", "value": ["x"]}]
+		},
+		"links": [{"type": "TEST_CASE_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}]
+	}`
+	anyEvent, err := UnmarshalAny([]byte(input), PreserveUnknownFields())
+	require.NoError(t, err)
+	original := anyEvent.(*TestCaseFinishedV3) // nolint:forcetypeassert
+	originalJSON := original.String()
+
+	c := original.DeepCopy()
+	require.True(t, c.Equal(original))
+
+	c.Meta.Tags[0] = "b"
+	c.Data.Outcome.Metrics[0].Value.(map[string]interface{})["seconds"].([]interface{})[0] = 3.0 // nolint:forcetypeassert
+	c.Data.CustomData[0].Value.([]interface{})[0] = "y"                                          // nolint:forcetypeassert
+	c.Links[0].Target = "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"
+
+	assert.JSONEq(t, originalJSON, original.String())
+	assert.False(t, c.Equal(original))
+}
+
+func TestDeepCopyAndEqualAllEventTypes(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) // nolint:gosec
+	for _, majorVersions := range eventTypeTable {
+		for _, majorVersion := range majorVersions {
+			t.Run(majorVersion.structType.Name(), func(t *testing.T) {
+				for i := 0; i < 20; i++ {
+					event := reflect.ValueOf(majorVersion.newEvent())
+					fillRandom(event.Elem(), r)
+
+					c := event.MethodByName("DeepCopy").Call(nil)[0]
+					require.Equal(t, event.Interface(), c.Interface())
+					require.True(t, event.MethodByName("Equal").Call([]reflect.Value{c})[0].Bool())
+
+					// Changing the ID of the copy must not affect the original.
+					c.Elem().FieldByName("Meta").FieldByName("ID").SetString("other")
+					require.False(t, event.MethodByName("Equal").Call([]reflect.Value{c})[0].Bool())
+				}
+			})
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testcases := []struct {
+		name     string
+		modifier func(event *TestCaseFinishedV3)
+		expected bool
+	}{
+		{
+			name:     "Identical",
+			modifier: func(event *TestCaseFinishedV3) {},
+			expected: true,
+		},
+		{
+			name: "Nil and empty slices are equal",
+			modifier: func(event *TestCaseFinishedV3) {
+				event.Data.PersistentLogs = []TCFV3DataPersistentLog{}
+			},
+			expected: true,
+		},
+		{
+			name: "Custom data values with same JSON encoding are equal",
+			modifier: func(event *TestCaseFinishedV3) {
+				event.Data.CustomData[0].Value = 1.0
+			},
+			expected: true,
+		},
+		{
+			name: "Different custom data value",
+			modifier: func(event *TestCaseFinishedV3) {
+				event.Data.CustomData[0].Value = 2
+			},
+			expected: false,
+		},
+		{
+			name: "Different enum value",
+			modifier: func(event *TestCaseFinishedV3) {
+				event.Data.Outcome.Verdict = TCFV3DataOutcomeVerdict_Failed
+			},
+			expected: false,
+		},
+		{
+			name: "Extra link",
+			modifier: func(event *TestCaseFinishedV3) {
+				event.Links.AddByID(LinkType_Cause, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2")
+			},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NewTestCaseFinishedV3()
+			require.NoError(t, err)
+			a.Data.CustomData = []CustomDataV1{{Key: "k", Value: 1}}
+			b := a.DeepCopy()
+			tc.modifier(b)
+			assert.Equal(t, tc.expected, a.Equal(b))
+			assert.Equal(t, tc.expected, b.Equal(a))
+		})
+	}
+}
+
+func TestDeepCopyNil(t *testing.T) {
+	var event *CompositionDefinedV3
+	assert.Nil(t, event.DeepCopy())
+}
+
+func TestEqualNil(t *testing.T) {
+	var a, b *CompositionDefinedV3
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(&CompositionDefinedV3{}))
+	assert.False(t, (&CompositionDefinedV3{}).Equal(a))
+}
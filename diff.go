@@ -0,0 +1,177 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+)
+
+// ChangeKind describes how a field differs between two events.
+type ChangeKind int
+
+const (
+	// FieldModified means that the field exists in both events
+	// but with different values.
+	FieldModified ChangeKind = iota
+
+	// FieldAdded means that the field only exists in the second event.
+	FieldAdded
+
+	// FieldRemoved means that the field only exists in the first event.
+	FieldRemoved
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case FieldAdded:
+		return "added"
+	case FieldRemoved:
+		return "removed"
+	}
+	return "modified"
+}
+
+// FieldChange describes a field whose value differs between two events.
+type FieldChange struct {
+	// Path is the path to the field, using dots to separate object members
+	// and square brackets for array indexes, e.g. data.outcome.verdict
+	// or links[1].target.
+	Path string
+
+	Kind ChangeKind
+
+	// Old is the field's value in the first event, or nil if the field
+	// was added. Values are represented as by json.Unmarshal, e.g.
+	// numbers are float64 values and objects are map[string]interface{}.
+	Old interface{}
+
+	// New is the field's value in the second event, or nil if the field
+	// was removed.
+	New interface{}
+}
+
+// String returns a human-readable description of the change,
+// with values formatted as JSON.
+func (fc FieldChange) String() string {
+	switch fc.Kind {
+	case FieldAdded:
+		return fmt.Sprintf("%s: added %s", fc.Path, formatChangeValue(fc.New))
+	case FieldRemoved:
+		return fmt.Sprintf("%s: removed %s", fc.Path, formatChangeValue(fc.Old))
+	}
+	return fmt.Sprintf("%s: %s -> %s", fc.Path, formatChangeValue(fc.Old), formatChangeValue(fc.New))
+}
+
+func formatChangeValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// Diff compares the JSON representations of two events and returns the
+// fields whose values differ, in the order they appear in the events.
+// Objects are compared member by member and arrays element by element,
+// so e.g. a changed link target is reported as a single change of
+// links[0].target. Because the comparison is made on the JSON level
+// the events don't have to be of the same type or version, and fields
+// preserved via the PreserveUnknownFields option are included. Any
+// value that can be marshaled to an event, e.g. *Any and RawEvent,
+// can be compared.
+//
+// An empty result means that the events are equivalent.
+func Diff(a json.Marshaler, b json.Marshaler) ([]FieldChange, error) {
+	aJSON, err := a.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling first event: %w", err)
+	}
+	bJSON, err := b.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling second event: %w", err)
+	}
+	if !gjson.ValidBytes(aJSON) || !gjson.ValidBytes(bJSON) {
+		return nil, fmt.Errorf("%w: invalid JSON encoding of event", ErrMalformedInput)
+	}
+	var changes []FieldChange
+	diffJSON(gjson.ParseBytes(aJSON), gjson.ParseBytes(bJSON), "", &changes)
+	return changes, nil
+}
+
+func diffJSON(a gjson.Result, b gjson.Result, path string, changes *[]FieldChange) {
+	switch {
+	case a.IsObject() && b.IsObject():
+		bMembers := b.Map()
+		aKeys := map[string]bool{}
+		a.ForEach(func(key gjson.Result, aMember gjson.Result) bool {
+			aKeys[key.Str] = true
+			memberPath := joinFieldPath(path, key.Str)
+			if bMember, ok := bMembers[key.Str]; ok {
+				diffJSON(aMember, bMember, memberPath, changes)
+			} else {
+				*changes = append(*changes, FieldChange{Path: memberPath, Kind: FieldRemoved, Old: aMember.Value()})
+			}
+			return true
+		})
+		b.ForEach(func(key gjson.Result, bMember gjson.Result) bool {
+			if !aKeys[key.Str] {
+				*changes = append(*changes, FieldChange{Path: joinFieldPath(path, key.Str), Kind: FieldAdded, New: bMember.Value()})
+			}
+			return true
+		})
+	case a.IsArray() && b.IsArray():
+		aElems := a.Array()
+		bElems := b.Array()
+		for i := 0; i < len(aElems) || i < len(bElems); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(bElems):
+				*changes = append(*changes, FieldChange{Path: elemPath, Kind: FieldRemoved, Old: aElems[i].Value()})
+			case i >= len(aElems):
+				*changes = append(*changes, FieldChange{Path: elemPath, Kind: FieldAdded, New: bElems[i].Value()})
+			default:
+				diffJSON(aElems[i], bElems[i], elemPath, changes)
+			}
+		}
+	case !equalJSONScalars(a, b):
+		*changes = append(*changes, FieldChange{Path: path, Kind: FieldModified, Old: a.Value(), New: b.Value()})
+	}
+}
+
+// equalJSONScalars returns true if two JSON values that aren't both
+// objects or both arrays are equal.
+func equalJSONScalars(a gjson.Result, b gjson.Result) bool {
+	if a.Type != b.Type || a.IsObject() != b.IsObject() || a.IsArray() != b.IsArray() {
+		return false
+	}
+	switch a.Type { // nolint:exhaustive
+	case gjson.String:
+		return a.Str == b.Str
+	case gjson.Number:
+		// Compare the integer values too since large integers
+		// can't be represented exactly as float64 values.
+		return a.Raw == b.Raw || (a.Num == b.Num && a.Int() == b.Int())
+	case gjson.JSON:
+		// Only one of them is an object or an array, which the
+		// comparisons above would've caught.
+		return false
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	a, err := NewTestCaseFinishedV3()
+	require.NoError(t, err)
+	a.Data.Outcome.Verdict = TCFV3DataOutcomeVerdict_Passed
+	a.Data.Outcome.Conclusion = TCFV3DataOutcomeConclusion_Successful
+	a.Data.CustomData = []CustomDataV1{{Key: "k", Value: 1}}
+	a.Links.AddByID(LinkType_TestCaseExecution, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1")
+	a.Links.AddByID(LinkType_Cause, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2")
+
+	b := a.DeepCopy()
+	b.Data.Outcome.Verdict = TCFV3DataOutcomeVerdict_Failed
+	b.Data.Outcome.Description = "It broke"
+	b.Data.CustomData[0].Value = 1.0 // Same JSON encoding as 1.
+	b.Links = b.Links[:1]
+	b.Links[0].Target = "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee3"
+
+	changes, err := Diff(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, []FieldChange{
+		{Path: "data.outcome.verdict", Kind: FieldModified, Old: "PASSED", New: "FAILED"},
+		{Path: "data.outcome.description", Kind: FieldAdded, New: "It broke"},
+		{Path: "links[0].target", Kind: FieldModified, Old: "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", New: "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee3"},
+		{
+			Path: "links[1]",
+			Kind: FieldRemoved,
+			Old:  map[string]interface{}{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"},
+		},
+	}, changes)
+}
+
+func TestDiffEqualEvents(t *testing.T) {
+	a, err := NewCompositionDefinedV3()
+	require.NoError(t, err)
+
+	changes, err := Diff(a, RawEvent(a.String()))
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiffNumbers(t *testing.T) {
+	testcases := []struct {
+		a       string
+		b       string
+		changed bool
+	}{
+		{`{"n": 1}`, `{"n": 1.0}`, false},
+		{`{"n": 1}`, `{"n": 2}`, true},
+		{`{"n": 9007199254740993}`, `{"n": 9007199254740992}`, true},
+		{`{"n": 1}`, `{"n": "1"}`, true},
+		{`{"n": {}}`, `{"n": []}`, true},
+		{`{"n": null}`, `{"n": null}`, false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			changes, err := Diff(RawEvent(tc.a), RawEvent(tc.b))
+			require.NoError(t, err)
+			assert.Equal(t, tc.changed, len(changes) > 0)
+		})
+	}
+}
+
+func TestFieldChangeString(t *testing.T) {
+	testcases := []struct {
+		change   FieldChange
+		expected string
+	}{
+		{FieldChange{Path: "data.name", Kind: FieldModified, Old: "a", New: "b"}, `data.name: "a" -> "b"`},
+		{FieldChange{Path: "data.tags", Kind: FieldAdded, New: []interface{}{"x"}}, `data.tags: added ["x"]`},
+		{FieldChange{Path: "links[1]", Kind: FieldRemoved, Old: map[string]interface{}{"type": "CAUSE"}}, `links[1]: removed {"type":"CAUSE"}`},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.change.String())
+		})
+	}
+}
@@ -22,6 +22,41 @@ import (
 	eiffeleventsroot "github.com/eiffel-community/eiffelevents-sdk-go"
 )
 
+// Factory creates events of the versions in this edition with a common
+// Generator and a common set of modifiers applied.
+// See eiffeleventsroot.Factory for details.
+type Factory struct {
+	root *eiffeleventsroot.Factory
+}
+
+// NewFactory returns a new Factory configured with the given options.
+// Modifiers are applied to all events the factory creates, in the given order.
+func NewFactory(options ...eiffeleventsroot.FactoryOption) *Factory {
+	return &Factory{root: eiffeleventsroot.NewFactory(options...)}
+}
+
+// With returns a new Factory with the configuration of the receiver and
+// the given options, i.e. any modifiers among the options are applied
+// after the modifiers of the receiver. The receiver is not modified.
+func (f *Factory) With(options ...eiffeleventsroot.FactoryOption) *Factory {
+	return &Factory{root: f.root.With(options...)}
+}
+
+// Producer creates events of the versions in this edition with a common
+// configuration applied. In addition to the methods of eiffeleventsroot.Producer
+// it has one NewXxx and one BuildXxx method per event type in the edition.
+type Producer struct {
+	*eiffeleventsroot.Producer
+}
+
+// NewProducer returns a new Producer with the given configuration.
+// The Versions field of the configuration is replaced with the event
+// versions of this edition.
+func NewProducer(cfg eiffeleventsroot.ProducerConfig) *Producer {
+	cfg.Versions = eventVersions
+	return &Producer{eiffeleventsroot.NewProducer(cfg)}
+}
+
 // ActivityCanceled represents version 3.2.0 of EiffelActivityCanceledEvent.
 type ActivityCanceled = eiffeleventsroot.ActivityCanceledV3
 
@@ -32,6 +67,41 @@ func NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCance
 	return eiffeleventsroot.NewActivityCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildActivityCanceled returns a builder for version 3.2.0
+// of EiffelActivityCanceledEvent. See eiffeleventsroot.BuildActivityCanceledV3
+// for details.
+func BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV3Builder {
+	return eiffeleventsroot.BuildActivityCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewActivityCanceled creates a new struct pointer that represents
+// version 3.2.0 of EiffelActivityCanceledEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCanceled, error) {
+	return f.root.NewActivityCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildActivityCanceled returns a builder for version 3.2.0
+// of EiffelActivityCanceledEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV3Builder {
+	return f.root.BuildActivityCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewActivityCanceled creates a new struct pointer that represents
+// version 3.2.0 of EiffelActivityCanceledEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCanceled, error) {
+	return p.Factory().NewActivityCanceledV3(modifiers...)
+}
+
+// BuildActivityCanceled returns a builder for version 3.2.0
+// of EiffelActivityCanceledEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV3Builder {
+	return p.Factory().BuildActivityCanceledV3(modifiers...)
+}
+
 // ActivityFinished represents version 3.3.0 of EiffelActivityFinishedEvent.
 type ActivityFinished = eiffeleventsroot.ActivityFinishedV3
 
@@ -42,6 +112,41 @@ func NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinis
 	return eiffeleventsroot.NewActivityFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildActivityFinished returns a builder for version 3.3.0
+// of EiffelActivityFinishedEvent. See eiffeleventsroot.BuildActivityFinishedV3
+// for details.
+func BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV3Builder {
+	return eiffeleventsroot.BuildActivityFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewActivityFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelActivityFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinished, error) {
+	return f.root.NewActivityFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildActivityFinished returns a builder for version 3.3.0
+// of EiffelActivityFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV3Builder {
+	return f.root.BuildActivityFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewActivityFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelActivityFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinished, error) {
+	return p.Factory().NewActivityFinishedV3(modifiers...)
+}
+
+// BuildActivityFinished returns a builder for version 3.3.0
+// of EiffelActivityFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV3Builder {
+	return p.Factory().BuildActivityFinishedV3(modifiers...)
+}
+
 // ActivityStarted represents version 4.3.0 of EiffelActivityStartedEvent.
 type ActivityStarted = eiffeleventsroot.ActivityStartedV4
 
@@ -52,6 +157,41 @@ func NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarte
 	return eiffeleventsroot.NewActivityStartedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
 }
 
+// BuildActivityStarted returns a builder for version 4.3.0
+// of EiffelActivityStartedEvent. See eiffeleventsroot.BuildActivityStartedV4
+// for details.
+func BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV4Builder {
+	return eiffeleventsroot.BuildActivityStartedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// NewActivityStarted creates a new struct pointer that represents
+// version 4.3.0 of EiffelActivityStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarted, error) {
+	return f.root.NewActivityStartedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// BuildActivityStarted returns a builder for version 4.3.0
+// of EiffelActivityStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV4Builder {
+	return f.root.BuildActivityStartedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// NewActivityStarted creates a new struct pointer that represents
+// version 4.3.0 of EiffelActivityStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarted, error) {
+	return p.Factory().NewActivityStartedV4(modifiers...)
+}
+
+// BuildActivityStarted returns a builder for version 4.3.0
+// of EiffelActivityStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV4Builder {
+	return p.Factory().BuildActivityStartedV4(modifiers...)
+}
+
 // ActivityTriggered represents version 4.2.0 of EiffelActivityTriggeredEvent.
 type ActivityTriggered = eiffeleventsroot.ActivityTriggeredV4
 
@@ -62,6 +202,41 @@ func NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTrig
 	return eiffeleventsroot.NewActivityTriggeredV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
 }
 
+// BuildActivityTriggered returns a builder for version 4.2.0
+// of EiffelActivityTriggeredEvent. See eiffeleventsroot.BuildActivityTriggeredV4
+// for details.
+func BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV4Builder {
+	return eiffeleventsroot.BuildActivityTriggeredV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewActivityTriggered creates a new struct pointer that represents
+// version 4.2.0 of EiffelActivityTriggeredEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTriggered, error) {
+	return f.root.NewActivityTriggeredV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// BuildActivityTriggered returns a builder for version 4.2.0
+// of EiffelActivityTriggeredEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV4Builder {
+	return f.root.BuildActivityTriggeredV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewActivityTriggered creates a new struct pointer that represents
+// version 4.2.0 of EiffelActivityTriggeredEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTriggered, error) {
+	return p.Factory().NewActivityTriggeredV4(modifiers...)
+}
+
+// BuildActivityTriggered returns a builder for version 4.2.0
+// of EiffelActivityTriggeredEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV4Builder {
+	return p.Factory().BuildActivityTriggeredV4(modifiers...)
+}
+
 // AnnouncementPublished represents version 3.2.0 of EiffelAnnouncementPublishedEvent.
 type AnnouncementPublished = eiffeleventsroot.AnnouncementPublishedV3
 
@@ -72,6 +247,41 @@ func NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*Announce
 	return eiffeleventsroot.NewAnnouncementPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildAnnouncementPublished returns a builder for version 3.2.0
+// of EiffelAnnouncementPublishedEvent. See eiffeleventsroot.BuildAnnouncementPublishedV3
+// for details.
+func BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV3Builder {
+	return eiffeleventsroot.BuildAnnouncementPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewAnnouncementPublished creates a new struct pointer that represents
+// version 3.2.0 of EiffelAnnouncementPublishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*AnnouncementPublished, error) {
+	return f.root.NewAnnouncementPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildAnnouncementPublished returns a builder for version 3.2.0
+// of EiffelAnnouncementPublishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV3Builder {
+	return f.root.BuildAnnouncementPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewAnnouncementPublished creates a new struct pointer that represents
+// version 3.2.0 of EiffelAnnouncementPublishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*AnnouncementPublished, error) {
+	return p.Factory().NewAnnouncementPublishedV3(modifiers...)
+}
+
+// BuildAnnouncementPublished returns a builder for version 3.2.0
+// of EiffelAnnouncementPublishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV3Builder {
+	return p.Factory().BuildAnnouncementPublishedV3(modifiers...)
+}
+
 // ArtifactCreated represents version 3.3.0 of EiffelArtifactCreatedEvent.
 type ArtifactCreated = eiffeleventsroot.ArtifactCreatedV3
 
@@ -82,6 +292,41 @@ func NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreate
 	return eiffeleventsroot.NewArtifactCreatedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildArtifactCreated returns a builder for version 3.3.0
+// of EiffelArtifactCreatedEvent. See eiffeleventsroot.BuildArtifactCreatedV3
+// for details.
+func BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV3Builder {
+	return eiffeleventsroot.BuildArtifactCreatedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewArtifactCreated creates a new struct pointer that represents
+// version 3.3.0 of EiffelArtifactCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreated, error) {
+	return f.root.NewArtifactCreatedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildArtifactCreated returns a builder for version 3.3.0
+// of EiffelArtifactCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV3Builder {
+	return f.root.BuildArtifactCreatedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewArtifactCreated creates a new struct pointer that represents
+// version 3.3.0 of EiffelArtifactCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreated, error) {
+	return p.Factory().NewArtifactCreatedV3(modifiers...)
+}
+
+// BuildArtifactCreated returns a builder for version 3.3.0
+// of EiffelArtifactCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV3Builder {
+	return p.Factory().BuildArtifactCreatedV3(modifiers...)
+}
+
 // ArtifactPublished represents version 3.3.0 of EiffelArtifactPublishedEvent.
 type ArtifactPublished = eiffeleventsroot.ArtifactPublishedV3
 
@@ -92,6 +337,41 @@ func NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPubl
 	return eiffeleventsroot.NewArtifactPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildArtifactPublished returns a builder for version 3.3.0
+// of EiffelArtifactPublishedEvent. See eiffeleventsroot.BuildArtifactPublishedV3
+// for details.
+func BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV3Builder {
+	return eiffeleventsroot.BuildArtifactPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewArtifactPublished creates a new struct pointer that represents
+// version 3.3.0 of EiffelArtifactPublishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPublished, error) {
+	return f.root.NewArtifactPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildArtifactPublished returns a builder for version 3.3.0
+// of EiffelArtifactPublishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV3Builder {
+	return f.root.BuildArtifactPublishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewArtifactPublished creates a new struct pointer that represents
+// version 3.3.0 of EiffelArtifactPublishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPublished, error) {
+	return p.Factory().NewArtifactPublishedV3(modifiers...)
+}
+
+// BuildArtifactPublished returns a builder for version 3.3.0
+// of EiffelArtifactPublishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV3Builder {
+	return p.Factory().BuildArtifactPublishedV3(modifiers...)
+}
+
 // ArtifactReused represents version 3.2.0 of EiffelArtifactReusedEvent.
 type ArtifactReused = eiffeleventsroot.ArtifactReusedV3
 
@@ -102,6 +382,41 @@ func NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused,
 	return eiffeleventsroot.NewArtifactReusedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildArtifactReused returns a builder for version 3.2.0
+// of EiffelArtifactReusedEvent. See eiffeleventsroot.BuildArtifactReusedV3
+// for details.
+func BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV3Builder {
+	return eiffeleventsroot.BuildArtifactReusedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewArtifactReused creates a new struct pointer that represents
+// version 3.2.0 of EiffelArtifactReusedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused, error) {
+	return f.root.NewArtifactReusedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildArtifactReused returns a builder for version 3.2.0
+// of EiffelArtifactReusedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV3Builder {
+	return f.root.BuildArtifactReusedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewArtifactReused creates a new struct pointer that represents
+// version 3.2.0 of EiffelArtifactReusedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused, error) {
+	return p.Factory().NewArtifactReusedV3(modifiers...)
+}
+
+// BuildArtifactReused returns a builder for version 3.2.0
+// of EiffelArtifactReusedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV3Builder {
+	return p.Factory().BuildArtifactReusedV3(modifiers...)
+}
+
 // CompositionDefined represents version 3.3.0 of EiffelCompositionDefinedEvent.
 type CompositionDefined = eiffeleventsroot.CompositionDefinedV3
 
@@ -112,6 +427,41 @@ func NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*Composition
 	return eiffeleventsroot.NewCompositionDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildCompositionDefined returns a builder for version 3.3.0
+// of EiffelCompositionDefinedEvent. See eiffeleventsroot.BuildCompositionDefinedV3
+// for details.
+func BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV3Builder {
+	return eiffeleventsroot.BuildCompositionDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewCompositionDefined creates a new struct pointer that represents
+// version 3.3.0 of EiffelCompositionDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*CompositionDefined, error) {
+	return f.root.NewCompositionDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildCompositionDefined returns a builder for version 3.3.0
+// of EiffelCompositionDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV3Builder {
+	return f.root.BuildCompositionDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewCompositionDefined creates a new struct pointer that represents
+// version 3.3.0 of EiffelCompositionDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*CompositionDefined, error) {
+	return p.Factory().NewCompositionDefinedV3(modifiers...)
+}
+
+// BuildCompositionDefined returns a builder for version 3.3.0
+// of EiffelCompositionDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV3Builder {
+	return p.Factory().BuildCompositionDefinedV3(modifiers...)
+}
+
 // ConfidenceLevelModified represents version 3.2.0 of EiffelConfidenceLevelModifiedEvent.
 type ConfidenceLevelModified = eiffeleventsroot.ConfidenceLevelModifiedV3
 
@@ -122,6 +472,41 @@ func NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*Confid
 	return eiffeleventsroot.NewConfidenceLevelModifiedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildConfidenceLevelModified returns a builder for version 3.2.0
+// of EiffelConfidenceLevelModifiedEvent. See eiffeleventsroot.BuildConfidenceLevelModifiedV3
+// for details.
+func BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV3Builder {
+	return eiffeleventsroot.BuildConfidenceLevelModifiedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewConfidenceLevelModified creates a new struct pointer that represents
+// version 3.2.0 of EiffelConfidenceLevelModifiedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*ConfidenceLevelModified, error) {
+	return f.root.NewConfidenceLevelModifiedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildConfidenceLevelModified returns a builder for version 3.2.0
+// of EiffelConfidenceLevelModifiedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV3Builder {
+	return f.root.BuildConfidenceLevelModifiedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewConfidenceLevelModified creates a new struct pointer that represents
+// version 3.2.0 of EiffelConfidenceLevelModifiedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*ConfidenceLevelModified, error) {
+	return p.Factory().NewConfidenceLevelModifiedV3(modifiers...)
+}
+
+// BuildConfidenceLevelModified returns a builder for version 3.2.0
+// of EiffelConfidenceLevelModifiedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV3Builder {
+	return p.Factory().BuildConfidenceLevelModifiedV3(modifiers...)
+}
+
 // EnvironmentDefined represents version 3.3.0 of EiffelEnvironmentDefinedEvent.
 type EnvironmentDefined = eiffeleventsroot.EnvironmentDefinedV3
 
@@ -132,6 +517,41 @@ func NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*Environment
 	return eiffeleventsroot.NewEnvironmentDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildEnvironmentDefined returns a builder for version 3.3.0
+// of EiffelEnvironmentDefinedEvent. See eiffeleventsroot.BuildEnvironmentDefinedV3
+// for details.
+func BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV3Builder {
+	return eiffeleventsroot.BuildEnvironmentDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewEnvironmentDefined creates a new struct pointer that represents
+// version 3.3.0 of EiffelEnvironmentDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*EnvironmentDefined, error) {
+	return f.root.NewEnvironmentDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildEnvironmentDefined returns a builder for version 3.3.0
+// of EiffelEnvironmentDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV3Builder {
+	return f.root.BuildEnvironmentDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewEnvironmentDefined creates a new struct pointer that represents
+// version 3.3.0 of EiffelEnvironmentDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*EnvironmentDefined, error) {
+	return p.Factory().NewEnvironmentDefinedV3(modifiers...)
+}
+
+// BuildEnvironmentDefined returns a builder for version 3.3.0
+// of EiffelEnvironmentDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV3Builder {
+	return p.Factory().BuildEnvironmentDefinedV3(modifiers...)
+}
+
 // FlowContextDefined represents version 3.2.0 of EiffelFlowContextDefinedEvent.
 type FlowContextDefined = eiffeleventsroot.FlowContextDefinedV3
 
@@ -142,6 +562,41 @@ func NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContext
 	return eiffeleventsroot.NewFlowContextDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildFlowContextDefined returns a builder for version 3.2.0
+// of EiffelFlowContextDefinedEvent. See eiffeleventsroot.BuildFlowContextDefinedV3
+// for details.
+func BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV3Builder {
+	return eiffeleventsroot.BuildFlowContextDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewFlowContextDefined creates a new struct pointer that represents
+// version 3.2.0 of EiffelFlowContextDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContextDefined, error) {
+	return f.root.NewFlowContextDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildFlowContextDefined returns a builder for version 3.2.0
+// of EiffelFlowContextDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV3Builder {
+	return f.root.BuildFlowContextDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewFlowContextDefined creates a new struct pointer that represents
+// version 3.2.0 of EiffelFlowContextDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContextDefined, error) {
+	return p.Factory().NewFlowContextDefinedV3(modifiers...)
+}
+
+// BuildFlowContextDefined returns a builder for version 3.2.0
+// of EiffelFlowContextDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV3Builder {
+	return p.Factory().BuildFlowContextDefinedV3(modifiers...)
+}
+
 // IssueDefined represents version 3.2.0 of EiffelIssueDefinedEvent.
 type IssueDefined = eiffeleventsroot.IssueDefinedV3
 
@@ -152,6 +607,41 @@ func NewIssueDefined(modifiers ...eiffeleventsroot.Modifier) (*IssueDefined, err
 	return eiffeleventsroot.NewIssueDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildIssueDefined returns a builder for version 3.2.0
+// of EiffelIssueDefinedEvent. See eiffeleventsroot.BuildIssueDefinedV3
+// for details.
+func BuildIssueDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueDefinedV3Builder {
+	return eiffeleventsroot.BuildIssueDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewIssueDefined creates a new struct pointer that represents
+// version 3.2.0 of EiffelIssueDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewIssueDefined(modifiers ...eiffeleventsroot.Modifier) (*IssueDefined, error) {
+	return f.root.NewIssueDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildIssueDefined returns a builder for version 3.2.0
+// of EiffelIssueDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildIssueDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueDefinedV3Builder {
+	return f.root.BuildIssueDefinedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewIssueDefined creates a new struct pointer that represents
+// version 3.2.0 of EiffelIssueDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewIssueDefined(modifiers ...eiffeleventsroot.Modifier) (*IssueDefined, error) {
+	return p.Factory().NewIssueDefinedV3(modifiers...)
+}
+
+// BuildIssueDefined returns a builder for version 3.2.0
+// of EiffelIssueDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildIssueDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueDefinedV3Builder {
+	return p.Factory().BuildIssueDefinedV3(modifiers...)
+}
+
 // IssueVerified represents version 4.2.0 of EiffelIssueVerifiedEvent.
 type IssueVerified = eiffeleventsroot.IssueVerifiedV4
 
@@ -162,6 +652,41 @@ func NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, e
 	return eiffeleventsroot.NewIssueVerifiedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
 }
 
+// BuildIssueVerified returns a builder for version 4.2.0
+// of EiffelIssueVerifiedEvent. See eiffeleventsroot.BuildIssueVerifiedV4
+// for details.
+func BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV4Builder {
+	return eiffeleventsroot.BuildIssueVerifiedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewIssueVerified creates a new struct pointer that represents
+// version 4.2.0 of EiffelIssueVerifiedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, error) {
+	return f.root.NewIssueVerifiedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// BuildIssueVerified returns a builder for version 4.2.0
+// of EiffelIssueVerifiedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV4Builder {
+	return f.root.BuildIssueVerifiedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewIssueVerified creates a new struct pointer that represents
+// version 4.2.0 of EiffelIssueVerifiedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, error) {
+	return p.Factory().NewIssueVerifiedV4(modifiers...)
+}
+
+// BuildIssueVerified returns a builder for version 4.2.0
+// of EiffelIssueVerifiedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV4Builder {
+	return p.Factory().BuildIssueVerifiedV4(modifiers...)
+}
+
 // SourceChangeCreated represents version 4.2.0 of EiffelSourceChangeCreatedEvent.
 type SourceChangeCreated = eiffeleventsroot.SourceChangeCreatedV4
 
@@ -172,6 +697,41 @@ func NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChan
 	return eiffeleventsroot.NewSourceChangeCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
 }
 
+// BuildSourceChangeCreated returns a builder for version 4.2.0
+// of EiffelSourceChangeCreatedEvent. See eiffeleventsroot.BuildSourceChangeCreatedV4
+// for details.
+func BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV4Builder {
+	return eiffeleventsroot.BuildSourceChangeCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewSourceChangeCreated creates a new struct pointer that represents
+// version 4.2.0 of EiffelSourceChangeCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeCreated, error) {
+	return f.root.NewSourceChangeCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// BuildSourceChangeCreated returns a builder for version 4.2.0
+// of EiffelSourceChangeCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV4Builder {
+	return f.root.BuildSourceChangeCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.2.0"))...)
+}
+
+// NewSourceChangeCreated creates a new struct pointer that represents
+// version 4.2.0 of EiffelSourceChangeCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeCreated, error) {
+	return p.Factory().NewSourceChangeCreatedV4(modifiers...)
+}
+
+// BuildSourceChangeCreated returns a builder for version 4.2.0
+// of EiffelSourceChangeCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV4Builder {
+	return p.Factory().BuildSourceChangeCreatedV4(modifiers...)
+}
+
 // SourceChangeSubmitted represents version 3.2.0 of EiffelSourceChangeSubmittedEvent.
 type SourceChangeSubmitted = eiffeleventsroot.SourceChangeSubmittedV3
 
@@ -182,6 +742,41 @@ func NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceCh
 	return eiffeleventsroot.NewSourceChangeSubmittedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildSourceChangeSubmitted returns a builder for version 3.2.0
+// of EiffelSourceChangeSubmittedEvent. See eiffeleventsroot.BuildSourceChangeSubmittedV3
+// for details.
+func BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV3Builder {
+	return eiffeleventsroot.BuildSourceChangeSubmittedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewSourceChangeSubmitted creates a new struct pointer that represents
+// version 3.2.0 of EiffelSourceChangeSubmittedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeSubmitted, error) {
+	return f.root.NewSourceChangeSubmittedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildSourceChangeSubmitted returns a builder for version 3.2.0
+// of EiffelSourceChangeSubmittedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV3Builder {
+	return f.root.BuildSourceChangeSubmittedV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewSourceChangeSubmitted creates a new struct pointer that represents
+// version 3.2.0 of EiffelSourceChangeSubmittedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeSubmitted, error) {
+	return p.Factory().NewSourceChangeSubmittedV3(modifiers...)
+}
+
+// BuildSourceChangeSubmitted returns a builder for version 3.2.0
+// of EiffelSourceChangeSubmittedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV3Builder {
+	return p.Factory().BuildSourceChangeSubmittedV3(modifiers...)
+}
+
 // TestCaseCanceled represents version 3.2.0 of EiffelTestCaseCanceledEvent.
 type TestCaseCanceled = eiffeleventsroot.TestCaseCanceledV3
 
@@ -192,6 +787,41 @@ func NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCance
 	return eiffeleventsroot.NewTestCaseCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildTestCaseCanceled returns a builder for version 3.2.0
+// of EiffelTestCaseCanceledEvent. See eiffeleventsroot.BuildTestCaseCanceledV3
+// for details.
+func BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV3Builder {
+	return eiffeleventsroot.BuildTestCaseCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewTestCaseCanceled creates a new struct pointer that represents
+// version 3.2.0 of EiffelTestCaseCanceledEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCanceled, error) {
+	return f.root.NewTestCaseCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildTestCaseCanceled returns a builder for version 3.2.0
+// of EiffelTestCaseCanceledEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV3Builder {
+	return f.root.BuildTestCaseCanceledV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewTestCaseCanceled creates a new struct pointer that represents
+// version 3.2.0 of EiffelTestCaseCanceledEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCanceled, error) {
+	return p.Factory().NewTestCaseCanceledV3(modifiers...)
+}
+
+// BuildTestCaseCanceled returns a builder for version 3.2.0
+// of EiffelTestCaseCanceledEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV3Builder {
+	return p.Factory().BuildTestCaseCanceledV3(modifiers...)
+}
+
 // TestCaseFinished represents version 3.3.0 of EiffelTestCaseFinishedEvent.
 type TestCaseFinished = eiffeleventsroot.TestCaseFinishedV3
 
@@ -202,6 +832,41 @@ func NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinis
 	return eiffeleventsroot.NewTestCaseFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildTestCaseFinished returns a builder for version 3.3.0
+// of EiffelTestCaseFinishedEvent. See eiffeleventsroot.BuildTestCaseFinishedV3
+// for details.
+func BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV3Builder {
+	return eiffeleventsroot.BuildTestCaseFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestCaseFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestCaseFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinished, error) {
+	return f.root.NewTestCaseFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildTestCaseFinished returns a builder for version 3.3.0
+// of EiffelTestCaseFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV3Builder {
+	return f.root.BuildTestCaseFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestCaseFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestCaseFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinished, error) {
+	return p.Factory().NewTestCaseFinishedV3(modifiers...)
+}
+
+// BuildTestCaseFinished returns a builder for version 3.3.0
+// of EiffelTestCaseFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV3Builder {
+	return p.Factory().BuildTestCaseFinishedV3(modifiers...)
+}
+
 // TestCaseStarted represents version 3.3.0 of EiffelTestCaseStartedEvent.
 type TestCaseStarted = eiffeleventsroot.TestCaseStartedV3
 
@@ -212,6 +877,41 @@ func NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarte
 	return eiffeleventsroot.NewTestCaseStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildTestCaseStarted returns a builder for version 3.3.0
+// of EiffelTestCaseStartedEvent. See eiffeleventsroot.BuildTestCaseStartedV3
+// for details.
+func BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV3Builder {
+	return eiffeleventsroot.BuildTestCaseStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestCaseStarted creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestCaseStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarted, error) {
+	return f.root.NewTestCaseStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildTestCaseStarted returns a builder for version 3.3.0
+// of EiffelTestCaseStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV3Builder {
+	return f.root.BuildTestCaseStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestCaseStarted creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestCaseStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarted, error) {
+	return p.Factory().NewTestCaseStartedV3(modifiers...)
+}
+
+// BuildTestCaseStarted returns a builder for version 3.3.0
+// of EiffelTestCaseStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV3Builder {
+	return p.Factory().BuildTestCaseStartedV3(modifiers...)
+}
+
 // TestCaseTriggered represents version 3.2.0 of EiffelTestCaseTriggeredEvent.
 type TestCaseTriggered = eiffeleventsroot.TestCaseTriggeredV3
 
@@ -222,6 +922,41 @@ func NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTrig
 	return eiffeleventsroot.NewTestCaseTriggeredV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
 }
 
+// BuildTestCaseTriggered returns a builder for version 3.2.0
+// of EiffelTestCaseTriggeredEvent. See eiffeleventsroot.BuildTestCaseTriggeredV3
+// for details.
+func BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV3Builder {
+	return eiffeleventsroot.BuildTestCaseTriggeredV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewTestCaseTriggered creates a new struct pointer that represents
+// version 3.2.0 of EiffelTestCaseTriggeredEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTriggered, error) {
+	return f.root.NewTestCaseTriggeredV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// BuildTestCaseTriggered returns a builder for version 3.2.0
+// of EiffelTestCaseTriggeredEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV3Builder {
+	return f.root.BuildTestCaseTriggeredV3(append(modifiers, eiffeleventsroot.WithVersion("3.2.0"))...)
+}
+
+// NewTestCaseTriggered creates a new struct pointer that represents
+// version 3.2.0 of EiffelTestCaseTriggeredEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTriggered, error) {
+	return p.Factory().NewTestCaseTriggeredV3(modifiers...)
+}
+
+// BuildTestCaseTriggered returns a builder for version 3.2.0
+// of EiffelTestCaseTriggeredEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV3Builder {
+	return p.Factory().BuildTestCaseTriggeredV3(modifiers...)
+}
+
 // TestExecutionRecipeCollectionCreated represents version 4.3.0 of EiffelTestExecutionRecipeCollectionCreatedEvent.
 type TestExecutionRecipeCollectionCreated = eiffeleventsroot.TestExecutionRecipeCollectionCreatedV4
 
@@ -232,6 +967,41 @@ func NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modif
 	return eiffeleventsroot.NewTestExecutionRecipeCollectionCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
 }
 
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 4.3.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent. See eiffeleventsroot.BuildTestExecutionRecipeCollectionCreatedV4
+// for details.
+func BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV4Builder {
+	return eiffeleventsroot.BuildTestExecutionRecipeCollectionCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// NewTestExecutionRecipeCollectionCreated creates a new struct pointer that represents
+// version 4.3.0 of EiffelTestExecutionRecipeCollectionCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) (*TestExecutionRecipeCollectionCreated, error) {
+	return f.root.NewTestExecutionRecipeCollectionCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 4.3.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV4Builder {
+	return f.root.BuildTestExecutionRecipeCollectionCreatedV4(append(modifiers, eiffeleventsroot.WithVersion("4.3.0"))...)
+}
+
+// NewTestExecutionRecipeCollectionCreated creates a new struct pointer that represents
+// version 4.3.0 of EiffelTestExecutionRecipeCollectionCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) (*TestExecutionRecipeCollectionCreated, error) {
+	return p.Factory().NewTestExecutionRecipeCollectionCreatedV4(modifiers...)
+}
+
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 4.3.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV4Builder {
+	return p.Factory().BuildTestExecutionRecipeCollectionCreatedV4(modifiers...)
+}
+
 // TestSuiteFinished represents version 3.3.0 of EiffelTestSuiteFinishedEvent.
 type TestSuiteFinished = eiffeleventsroot.TestSuiteFinishedV3
 
@@ -242,6 +1012,41 @@ func NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFin
 	return eiffeleventsroot.NewTestSuiteFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
 
+// BuildTestSuiteFinished returns a builder for version 3.3.0
+// of EiffelTestSuiteFinishedEvent. See eiffeleventsroot.BuildTestSuiteFinishedV3
+// for details.
+func BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV3Builder {
+	return eiffeleventsroot.BuildTestSuiteFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestSuiteFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestSuiteFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFinished, error) {
+	return f.root.NewTestSuiteFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildTestSuiteFinished returns a builder for version 3.3.0
+// of EiffelTestSuiteFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV3Builder {
+	return f.root.BuildTestSuiteFinishedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestSuiteFinished creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestSuiteFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFinished, error) {
+	return p.Factory().NewTestSuiteFinishedV3(modifiers...)
+}
+
+// BuildTestSuiteFinished returns a builder for version 3.3.0
+// of EiffelTestSuiteFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV3Builder {
+	return p.Factory().BuildTestSuiteFinishedV3(modifiers...)
+}
+
 // TestSuiteStarted represents version 3.3.0 of EiffelTestSuiteStartedEvent.
 type TestSuiteStarted = eiffeleventsroot.TestSuiteStartedV3
 
@@ -251,3 +1056,73 @@ type TestSuiteStarted = eiffeleventsroot.TestSuiteStartedV3
 func NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
 	return eiffeleventsroot.NewTestSuiteStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
 }
+
+// BuildTestSuiteStarted returns a builder for version 3.3.0
+// of EiffelTestSuiteStartedEvent. See eiffeleventsroot.BuildTestSuiteStartedV3
+// for details.
+func BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV3Builder {
+	return eiffeleventsroot.BuildTestSuiteStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestSuiteStarted creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestSuiteStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
+	return f.root.NewTestSuiteStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// BuildTestSuiteStarted returns a builder for version 3.3.0
+// of EiffelTestSuiteStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV3Builder {
+	return f.root.BuildTestSuiteStartedV3(append(modifiers, eiffeleventsroot.WithVersion("3.3.0"))...)
+}
+
+// NewTestSuiteStarted creates a new struct pointer that represents
+// version 3.3.0 of EiffelTestSuiteStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
+	return p.Factory().NewTestSuiteStartedV3(modifiers...)
+}
+
+// BuildTestSuiteStarted returns a builder for version 3.3.0
+// of EiffelTestSuiteStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV3Builder {
+	return p.Factory().BuildTestSuiteStartedV3(modifiers...)
+}
+
+// eventVersions maps the event types in this edition to their versions.
+var eventVersions = map[string]string{
+	"EiffelActivityCanceledEvent":                     "3.2.0",
+	"EiffelActivityFinishedEvent":                     "3.3.0",
+	"EiffelActivityStartedEvent":                      "4.3.0",
+	"EiffelActivityTriggeredEvent":                    "4.2.0",
+	"EiffelAnnouncementPublishedEvent":                "3.2.0",
+	"EiffelArtifactCreatedEvent":                      "3.3.0",
+	"EiffelArtifactPublishedEvent":                    "3.3.0",
+	"EiffelArtifactReusedEvent":                       "3.2.0",
+	"EiffelCompositionDefinedEvent":                   "3.3.0",
+	"EiffelConfidenceLevelModifiedEvent":              "3.2.0",
+	"EiffelEnvironmentDefinedEvent":                   "3.3.0",
+	"EiffelFlowContextDefinedEvent":                   "3.2.0",
+	"EiffelIssueDefinedEvent":                         "3.2.0",
+	"EiffelIssueVerifiedEvent":                        "4.2.0",
+	"EiffelSourceChangeCreatedEvent":                  "4.2.0",
+	"EiffelSourceChangeSubmittedEvent":                "3.2.0",
+	"EiffelTestCaseCanceledEvent":                     "3.2.0",
+	"EiffelTestCaseFinishedEvent":                     "3.3.0",
+	"EiffelTestCaseStartedEvent":                      "3.3.0",
+	"EiffelTestCaseTriggeredEvent":                    "3.2.0",
+	"EiffelTestExecutionRecipeCollectionCreatedEvent": "4.3.0",
+	"EiffelTestSuiteFinishedEvent":                    "3.3.0",
+	"EiffelTestSuiteStartedEvent":                     "3.3.0",
+}
+
+// Normalize unmarshals a JSON event of any supported version and, if necessary,
+// converts it to the major version used by this edition so that the returned
+// value (a struct pointer) is of one of the types declared in this package.
+// See eiffeleventsroot.Normalize for details.
+func Normalize(input []byte) (interface{}, error) {
+	return eiffeleventsroot.Normalize(input, eventVersions)
+}
@@ -22,6 +22,41 @@ import (
 	eiffeleventsroot "github.com/eiffel-community/eiffelevents-sdk-go"
 )
 
+// Factory creates events of the versions in this edition with a common
+// Generator and a common set of modifiers applied.
+// See eiffeleventsroot.Factory for details.
+type Factory struct {
+	root *eiffeleventsroot.Factory
+}
+
+// NewFactory returns a new Factory configured with the given options.
+// Modifiers are applied to all events the factory creates, in the given order.
+func NewFactory(options ...eiffeleventsroot.FactoryOption) *Factory {
+	return &Factory{root: eiffeleventsroot.NewFactory(options...)}
+}
+
+// With returns a new Factory with the configuration of the receiver and
+// the given options, i.e. any modifiers among the options are applied
+// after the modifiers of the receiver. The receiver is not modified.
+func (f *Factory) With(options ...eiffeleventsroot.FactoryOption) *Factory {
+	return &Factory{root: f.root.With(options...)}
+}
+
+// Producer creates events of the versions in this edition with a common
+// configuration applied. In addition to the methods of eiffeleventsroot.Producer
+// it has one NewXxx and one BuildXxx method per event type in the edition.
+type Producer struct {
+	*eiffeleventsroot.Producer
+}
+
+// NewProducer returns a new Producer with the given configuration.
+// The Versions field of the configuration is replaced with the event
+// versions of this edition.
+func NewProducer(cfg eiffeleventsroot.ProducerConfig) *Producer {
+	cfg.Versions = eventVersions
+	return &Producer{eiffeleventsroot.NewProducer(cfg)}
+}
+
 // ActivityCanceled represents version 1.1.0 of EiffelActivityCanceledEvent.
 type ActivityCanceled = eiffeleventsroot.ActivityCanceledV1
 
@@ -32,6 +67,41 @@ func NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCance
 	return eiffeleventsroot.NewActivityCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildActivityCanceled returns a builder for version 1.1.0
+// of EiffelActivityCanceledEvent. See eiffeleventsroot.BuildActivityCanceledV1
+// for details.
+func BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV1Builder {
+	return eiffeleventsroot.BuildActivityCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityCanceled creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityCanceledEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCanceled, error) {
+	return f.root.NewActivityCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildActivityCanceled returns a builder for version 1.1.0
+// of EiffelActivityCanceledEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV1Builder {
+	return f.root.BuildActivityCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityCanceled creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityCanceledEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityCanceled(modifiers ...eiffeleventsroot.Modifier) (*ActivityCanceled, error) {
+	return p.Factory().NewActivityCanceledV1(modifiers...)
+}
+
+// BuildActivityCanceled returns a builder for version 1.1.0
+// of EiffelActivityCanceledEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityCanceledV1Builder {
+	return p.Factory().BuildActivityCanceledV1(modifiers...)
+}
+
 // ActivityFinished represents version 1.1.0 of EiffelActivityFinishedEvent.
 type ActivityFinished = eiffeleventsroot.ActivityFinishedV1
 
@@ -42,6 +112,41 @@ func NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinis
 	return eiffeleventsroot.NewActivityFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildActivityFinished returns a builder for version 1.1.0
+// of EiffelActivityFinishedEvent. See eiffeleventsroot.BuildActivityFinishedV1
+// for details.
+func BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV1Builder {
+	return eiffeleventsroot.BuildActivityFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinished, error) {
+	return f.root.NewActivityFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildActivityFinished returns a builder for version 1.1.0
+// of EiffelActivityFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV1Builder {
+	return f.root.BuildActivityFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityFinished(modifiers ...eiffeleventsroot.Modifier) (*ActivityFinished, error) {
+	return p.Factory().NewActivityFinishedV1(modifiers...)
+}
+
+// BuildActivityFinished returns a builder for version 1.1.0
+// of EiffelActivityFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityFinishedV1Builder {
+	return p.Factory().BuildActivityFinishedV1(modifiers...)
+}
+
 // ActivityStarted represents version 1.1.0 of EiffelActivityStartedEvent.
 type ActivityStarted = eiffeleventsroot.ActivityStartedV1
 
@@ -52,6 +157,41 @@ func NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarte
 	return eiffeleventsroot.NewActivityStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildActivityStarted returns a builder for version 1.1.0
+// of EiffelActivityStartedEvent. See eiffeleventsroot.BuildActivityStartedV1
+// for details.
+func BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV1Builder {
+	return eiffeleventsroot.BuildActivityStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarted, error) {
+	return f.root.NewActivityStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildActivityStarted returns a builder for version 1.1.0
+// of EiffelActivityStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV1Builder {
+	return f.root.BuildActivityStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityStarted(modifiers ...eiffeleventsroot.Modifier) (*ActivityStarted, error) {
+	return p.Factory().NewActivityStartedV1(modifiers...)
+}
+
+// BuildActivityStarted returns a builder for version 1.1.0
+// of EiffelActivityStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityStartedV1Builder {
+	return p.Factory().BuildActivityStartedV1(modifiers...)
+}
+
 // ActivityTriggered represents version 1.1.0 of EiffelActivityTriggeredEvent.
 type ActivityTriggered = eiffeleventsroot.ActivityTriggeredV1
 
@@ -62,6 +202,41 @@ func NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTrig
 	return eiffeleventsroot.NewActivityTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildActivityTriggered returns a builder for version 1.1.0
+// of EiffelActivityTriggeredEvent. See eiffeleventsroot.BuildActivityTriggeredV1
+// for details.
+func BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV1Builder {
+	return eiffeleventsroot.BuildActivityTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityTriggered creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityTriggeredEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTriggered, error) {
+	return f.root.NewActivityTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildActivityTriggered returns a builder for version 1.1.0
+// of EiffelActivityTriggeredEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV1Builder {
+	return f.root.BuildActivityTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewActivityTriggered creates a new struct pointer that represents
+// version 1.1.0 of EiffelActivityTriggeredEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewActivityTriggered(modifiers ...eiffeleventsroot.Modifier) (*ActivityTriggered, error) {
+	return p.Factory().NewActivityTriggeredV1(modifiers...)
+}
+
+// BuildActivityTriggered returns a builder for version 1.1.0
+// of EiffelActivityTriggeredEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildActivityTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ActivityTriggeredV1Builder {
+	return p.Factory().BuildActivityTriggeredV1(modifiers...)
+}
+
 // AnnouncementPublished represents version 1.1.0 of EiffelAnnouncementPublishedEvent.
 type AnnouncementPublished = eiffeleventsroot.AnnouncementPublishedV1
 
@@ -72,6 +247,41 @@ func NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*Announce
 	return eiffeleventsroot.NewAnnouncementPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildAnnouncementPublished returns a builder for version 1.1.0
+// of EiffelAnnouncementPublishedEvent. See eiffeleventsroot.BuildAnnouncementPublishedV1
+// for details.
+func BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV1Builder {
+	return eiffeleventsroot.BuildAnnouncementPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewAnnouncementPublished creates a new struct pointer that represents
+// version 1.1.0 of EiffelAnnouncementPublishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*AnnouncementPublished, error) {
+	return f.root.NewAnnouncementPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildAnnouncementPublished returns a builder for version 1.1.0
+// of EiffelAnnouncementPublishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV1Builder {
+	return f.root.BuildAnnouncementPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewAnnouncementPublished creates a new struct pointer that represents
+// version 1.1.0 of EiffelAnnouncementPublishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) (*AnnouncementPublished, error) {
+	return p.Factory().NewAnnouncementPublishedV1(modifiers...)
+}
+
+// BuildAnnouncementPublished returns a builder for version 1.1.0
+// of EiffelAnnouncementPublishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildAnnouncementPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.AnnouncementPublishedV1Builder {
+	return p.Factory().BuildAnnouncementPublishedV1(modifiers...)
+}
+
 // ArtifactCreated represents version 1.1.0 of EiffelArtifactCreatedEvent.
 type ArtifactCreated = eiffeleventsroot.ArtifactCreatedV1
 
@@ -82,6 +292,41 @@ func NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreate
 	return eiffeleventsroot.NewArtifactCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildArtifactCreated returns a builder for version 1.1.0
+// of EiffelArtifactCreatedEvent. See eiffeleventsroot.BuildArtifactCreatedV1
+// for details.
+func BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV1Builder {
+	return eiffeleventsroot.BuildArtifactCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactCreated creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreated, error) {
+	return f.root.NewArtifactCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildArtifactCreated returns a builder for version 1.1.0
+// of EiffelArtifactCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV1Builder {
+	return f.root.BuildArtifactCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactCreated creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactCreated(modifiers ...eiffeleventsroot.Modifier) (*ArtifactCreated, error) {
+	return p.Factory().NewArtifactCreatedV1(modifiers...)
+}
+
+// BuildArtifactCreated returns a builder for version 1.1.0
+// of EiffelArtifactCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactCreatedV1Builder {
+	return p.Factory().BuildArtifactCreatedV1(modifiers...)
+}
+
 // ArtifactPublished represents version 1.1.0 of EiffelArtifactPublishedEvent.
 type ArtifactPublished = eiffeleventsroot.ArtifactPublishedV1
 
@@ -92,6 +337,41 @@ func NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPubl
 	return eiffeleventsroot.NewArtifactPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildArtifactPublished returns a builder for version 1.1.0
+// of EiffelArtifactPublishedEvent. See eiffeleventsroot.BuildArtifactPublishedV1
+// for details.
+func BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV1Builder {
+	return eiffeleventsroot.BuildArtifactPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactPublished creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactPublishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPublished, error) {
+	return f.root.NewArtifactPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildArtifactPublished returns a builder for version 1.1.0
+// of EiffelArtifactPublishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV1Builder {
+	return f.root.BuildArtifactPublishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactPublished creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactPublishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactPublished(modifiers ...eiffeleventsroot.Modifier) (*ArtifactPublished, error) {
+	return p.Factory().NewArtifactPublishedV1(modifiers...)
+}
+
+// BuildArtifactPublished returns a builder for version 1.1.0
+// of EiffelArtifactPublishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactPublished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactPublishedV1Builder {
+	return p.Factory().BuildArtifactPublishedV1(modifiers...)
+}
+
 // ArtifactReused represents version 1.1.0 of EiffelArtifactReusedEvent.
 type ArtifactReused = eiffeleventsroot.ArtifactReusedV1
 
@@ -102,6 +382,41 @@ func NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused,
 	return eiffeleventsroot.NewArtifactReusedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildArtifactReused returns a builder for version 1.1.0
+// of EiffelArtifactReusedEvent. See eiffeleventsroot.BuildArtifactReusedV1
+// for details.
+func BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV1Builder {
+	return eiffeleventsroot.BuildArtifactReusedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactReused creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactReusedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused, error) {
+	return f.root.NewArtifactReusedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildArtifactReused returns a builder for version 1.1.0
+// of EiffelArtifactReusedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV1Builder {
+	return f.root.BuildArtifactReusedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewArtifactReused creates a new struct pointer that represents
+// version 1.1.0 of EiffelArtifactReusedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewArtifactReused(modifiers ...eiffeleventsroot.Modifier) (*ArtifactReused, error) {
+	return p.Factory().NewArtifactReusedV1(modifiers...)
+}
+
+// BuildArtifactReused returns a builder for version 1.1.0
+// of EiffelArtifactReusedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildArtifactReused(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ArtifactReusedV1Builder {
+	return p.Factory().BuildArtifactReusedV1(modifiers...)
+}
+
 // CompositionDefined represents version 1.1.0 of EiffelCompositionDefinedEvent.
 type CompositionDefined = eiffeleventsroot.CompositionDefinedV1
 
@@ -112,6 +427,41 @@ func NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*Composition
 	return eiffeleventsroot.NewCompositionDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildCompositionDefined returns a builder for version 1.1.0
+// of EiffelCompositionDefinedEvent. See eiffeleventsroot.BuildCompositionDefinedV1
+// for details.
+func BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV1Builder {
+	return eiffeleventsroot.BuildCompositionDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewCompositionDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelCompositionDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*CompositionDefined, error) {
+	return f.root.NewCompositionDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildCompositionDefined returns a builder for version 1.1.0
+// of EiffelCompositionDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV1Builder {
+	return f.root.BuildCompositionDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewCompositionDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelCompositionDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewCompositionDefined(modifiers ...eiffeleventsroot.Modifier) (*CompositionDefined, error) {
+	return p.Factory().NewCompositionDefinedV1(modifiers...)
+}
+
+// BuildCompositionDefined returns a builder for version 1.1.0
+// of EiffelCompositionDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildCompositionDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.CompositionDefinedV1Builder {
+	return p.Factory().BuildCompositionDefinedV1(modifiers...)
+}
+
 // ConfidenceLevelModified represents version 1.1.0 of EiffelConfidenceLevelModifiedEvent.
 type ConfidenceLevelModified = eiffeleventsroot.ConfidenceLevelModifiedV1
 
@@ -122,6 +472,41 @@ func NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*Confid
 	return eiffeleventsroot.NewConfidenceLevelModifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildConfidenceLevelModified returns a builder for version 1.1.0
+// of EiffelConfidenceLevelModifiedEvent. See eiffeleventsroot.BuildConfidenceLevelModifiedV1
+// for details.
+func BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV1Builder {
+	return eiffeleventsroot.BuildConfidenceLevelModifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewConfidenceLevelModified creates a new struct pointer that represents
+// version 1.1.0 of EiffelConfidenceLevelModifiedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*ConfidenceLevelModified, error) {
+	return f.root.NewConfidenceLevelModifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildConfidenceLevelModified returns a builder for version 1.1.0
+// of EiffelConfidenceLevelModifiedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV1Builder {
+	return f.root.BuildConfidenceLevelModifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewConfidenceLevelModified creates a new struct pointer that represents
+// version 1.1.0 of EiffelConfidenceLevelModifiedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) (*ConfidenceLevelModified, error) {
+	return p.Factory().NewConfidenceLevelModifiedV1(modifiers...)
+}
+
+// BuildConfidenceLevelModified returns a builder for version 1.1.0
+// of EiffelConfidenceLevelModifiedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildConfidenceLevelModified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.ConfidenceLevelModifiedV1Builder {
+	return p.Factory().BuildConfidenceLevelModifiedV1(modifiers...)
+}
+
 // EnvironmentDefined represents version 1.1.0 of EiffelEnvironmentDefinedEvent.
 type EnvironmentDefined = eiffeleventsroot.EnvironmentDefinedV1
 
@@ -132,6 +517,41 @@ func NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*Environment
 	return eiffeleventsroot.NewEnvironmentDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildEnvironmentDefined returns a builder for version 1.1.0
+// of EiffelEnvironmentDefinedEvent. See eiffeleventsroot.BuildEnvironmentDefinedV1
+// for details.
+func BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV1Builder {
+	return eiffeleventsroot.BuildEnvironmentDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewEnvironmentDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelEnvironmentDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*EnvironmentDefined, error) {
+	return f.root.NewEnvironmentDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildEnvironmentDefined returns a builder for version 1.1.0
+// of EiffelEnvironmentDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV1Builder {
+	return f.root.BuildEnvironmentDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewEnvironmentDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelEnvironmentDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) (*EnvironmentDefined, error) {
+	return p.Factory().NewEnvironmentDefinedV1(modifiers...)
+}
+
+// BuildEnvironmentDefined returns a builder for version 1.1.0
+// of EiffelEnvironmentDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildEnvironmentDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.EnvironmentDefinedV1Builder {
+	return p.Factory().BuildEnvironmentDefinedV1(modifiers...)
+}
+
 // FlowContextDefined represents version 1.1.0 of EiffelFlowContextDefinedEvent.
 type FlowContextDefined = eiffeleventsroot.FlowContextDefinedV1
 
@@ -142,6 +562,41 @@ func NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContext
 	return eiffeleventsroot.NewFlowContextDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildFlowContextDefined returns a builder for version 1.1.0
+// of EiffelFlowContextDefinedEvent. See eiffeleventsroot.BuildFlowContextDefinedV1
+// for details.
+func BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV1Builder {
+	return eiffeleventsroot.BuildFlowContextDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewFlowContextDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelFlowContextDefinedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContextDefined, error) {
+	return f.root.NewFlowContextDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildFlowContextDefined returns a builder for version 1.1.0
+// of EiffelFlowContextDefinedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV1Builder {
+	return f.root.BuildFlowContextDefinedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewFlowContextDefined creates a new struct pointer that represents
+// version 1.1.0 of EiffelFlowContextDefinedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) (*FlowContextDefined, error) {
+	return p.Factory().NewFlowContextDefinedV1(modifiers...)
+}
+
+// BuildFlowContextDefined returns a builder for version 1.1.0
+// of EiffelFlowContextDefinedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildFlowContextDefined(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.FlowContextDefinedV1Builder {
+	return p.Factory().BuildFlowContextDefinedV1(modifiers...)
+}
+
 // IssueVerified represents version 1.1.0 of EiffelIssueVerifiedEvent.
 type IssueVerified = eiffeleventsroot.IssueVerifiedV1
 
@@ -152,6 +607,41 @@ func NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, e
 	return eiffeleventsroot.NewIssueVerifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildIssueVerified returns a builder for version 1.1.0
+// of EiffelIssueVerifiedEvent. See eiffeleventsroot.BuildIssueVerifiedV1
+// for details.
+func BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV1Builder {
+	return eiffeleventsroot.BuildIssueVerifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewIssueVerified creates a new struct pointer that represents
+// version 1.1.0 of EiffelIssueVerifiedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, error) {
+	return f.root.NewIssueVerifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildIssueVerified returns a builder for version 1.1.0
+// of EiffelIssueVerifiedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV1Builder {
+	return f.root.BuildIssueVerifiedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewIssueVerified creates a new struct pointer that represents
+// version 1.1.0 of EiffelIssueVerifiedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewIssueVerified(modifiers ...eiffeleventsroot.Modifier) (*IssueVerified, error) {
+	return p.Factory().NewIssueVerifiedV1(modifiers...)
+}
+
+// BuildIssueVerified returns a builder for version 1.1.0
+// of EiffelIssueVerifiedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildIssueVerified(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.IssueVerifiedV1Builder {
+	return p.Factory().BuildIssueVerifiedV1(modifiers...)
+}
+
 // SourceChangeCreated represents version 1.1.0 of EiffelSourceChangeCreatedEvent.
 type SourceChangeCreated = eiffeleventsroot.SourceChangeCreatedV1
 
@@ -162,6 +652,41 @@ func NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChan
 	return eiffeleventsroot.NewSourceChangeCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildSourceChangeCreated returns a builder for version 1.1.0
+// of EiffelSourceChangeCreatedEvent. See eiffeleventsroot.BuildSourceChangeCreatedV1
+// for details.
+func BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV1Builder {
+	return eiffeleventsroot.BuildSourceChangeCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewSourceChangeCreated creates a new struct pointer that represents
+// version 1.1.0 of EiffelSourceChangeCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeCreated, error) {
+	return f.root.NewSourceChangeCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildSourceChangeCreated returns a builder for version 1.1.0
+// of EiffelSourceChangeCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV1Builder {
+	return f.root.BuildSourceChangeCreatedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewSourceChangeCreated creates a new struct pointer that represents
+// version 1.1.0 of EiffelSourceChangeCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeCreated, error) {
+	return p.Factory().NewSourceChangeCreatedV1(modifiers...)
+}
+
+// BuildSourceChangeCreated returns a builder for version 1.1.0
+// of EiffelSourceChangeCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildSourceChangeCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeCreatedV1Builder {
+	return p.Factory().BuildSourceChangeCreatedV1(modifiers...)
+}
+
 // SourceChangeSubmitted represents version 1.1.0 of EiffelSourceChangeSubmittedEvent.
 type SourceChangeSubmitted = eiffeleventsroot.SourceChangeSubmittedV1
 
@@ -172,6 +697,41 @@ func NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceCh
 	return eiffeleventsroot.NewSourceChangeSubmittedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildSourceChangeSubmitted returns a builder for version 1.1.0
+// of EiffelSourceChangeSubmittedEvent. See eiffeleventsroot.BuildSourceChangeSubmittedV1
+// for details.
+func BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV1Builder {
+	return eiffeleventsroot.BuildSourceChangeSubmittedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewSourceChangeSubmitted creates a new struct pointer that represents
+// version 1.1.0 of EiffelSourceChangeSubmittedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeSubmitted, error) {
+	return f.root.NewSourceChangeSubmittedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildSourceChangeSubmitted returns a builder for version 1.1.0
+// of EiffelSourceChangeSubmittedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV1Builder {
+	return f.root.BuildSourceChangeSubmittedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewSourceChangeSubmitted creates a new struct pointer that represents
+// version 1.1.0 of EiffelSourceChangeSubmittedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) (*SourceChangeSubmitted, error) {
+	return p.Factory().NewSourceChangeSubmittedV1(modifiers...)
+}
+
+// BuildSourceChangeSubmitted returns a builder for version 1.1.0
+// of EiffelSourceChangeSubmittedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildSourceChangeSubmitted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.SourceChangeSubmittedV1Builder {
+	return p.Factory().BuildSourceChangeSubmittedV1(modifiers...)
+}
+
 // TestCaseCanceled represents version 1.1.0 of EiffelTestCaseCanceledEvent.
 type TestCaseCanceled = eiffeleventsroot.TestCaseCanceledV1
 
@@ -182,6 +742,41 @@ func NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCance
 	return eiffeleventsroot.NewTestCaseCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildTestCaseCanceled returns a builder for version 1.1.0
+// of EiffelTestCaseCanceledEvent. See eiffeleventsroot.BuildTestCaseCanceledV1
+// for details.
+func BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV1Builder {
+	return eiffeleventsroot.BuildTestCaseCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseCanceled creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseCanceledEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCanceled, error) {
+	return f.root.NewTestCaseCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestCaseCanceled returns a builder for version 1.1.0
+// of EiffelTestCaseCanceledEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV1Builder {
+	return f.root.BuildTestCaseCanceledV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseCanceled creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseCanceledEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) (*TestCaseCanceled, error) {
+	return p.Factory().NewTestCaseCanceledV1(modifiers...)
+}
+
+// BuildTestCaseCanceled returns a builder for version 1.1.0
+// of EiffelTestCaseCanceledEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseCanceled(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseCanceledV1Builder {
+	return p.Factory().BuildTestCaseCanceledV1(modifiers...)
+}
+
 // TestCaseFinished represents version 1.1.0 of EiffelTestCaseFinishedEvent.
 type TestCaseFinished = eiffeleventsroot.TestCaseFinishedV1
 
@@ -192,6 +787,41 @@ func NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinis
 	return eiffeleventsroot.NewTestCaseFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildTestCaseFinished returns a builder for version 1.1.0
+// of EiffelTestCaseFinishedEvent. See eiffeleventsroot.BuildTestCaseFinishedV1
+// for details.
+func BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV1Builder {
+	return eiffeleventsroot.BuildTestCaseFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinished, error) {
+	return f.root.NewTestCaseFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestCaseFinished returns a builder for version 1.1.0
+// of EiffelTestCaseFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV1Builder {
+	return f.root.BuildTestCaseFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) (*TestCaseFinished, error) {
+	return p.Factory().NewTestCaseFinishedV1(modifiers...)
+}
+
+// BuildTestCaseFinished returns a builder for version 1.1.0
+// of EiffelTestCaseFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseFinishedV1Builder {
+	return p.Factory().BuildTestCaseFinishedV1(modifiers...)
+}
+
 // TestCaseStarted represents version 1.1.0 of EiffelTestCaseStartedEvent.
 type TestCaseStarted = eiffeleventsroot.TestCaseStartedV1
 
@@ -202,6 +832,41 @@ func NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarte
 	return eiffeleventsroot.NewTestCaseStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildTestCaseStarted returns a builder for version 1.1.0
+// of EiffelTestCaseStartedEvent. See eiffeleventsroot.BuildTestCaseStartedV1
+// for details.
+func BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV1Builder {
+	return eiffeleventsroot.BuildTestCaseStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarted, error) {
+	return f.root.NewTestCaseStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestCaseStarted returns a builder for version 1.1.0
+// of EiffelTestCaseStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV1Builder {
+	return f.root.BuildTestCaseStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) (*TestCaseStarted, error) {
+	return p.Factory().NewTestCaseStartedV1(modifiers...)
+}
+
+// BuildTestCaseStarted returns a builder for version 1.1.0
+// of EiffelTestCaseStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseStartedV1Builder {
+	return p.Factory().BuildTestCaseStartedV1(modifiers...)
+}
+
 // TestCaseTriggered represents version 1.1.0 of EiffelTestCaseTriggeredEvent.
 type TestCaseTriggered = eiffeleventsroot.TestCaseTriggeredV1
 
@@ -212,6 +877,41 @@ func NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTrig
 	return eiffeleventsroot.NewTestCaseTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildTestCaseTriggered returns a builder for version 1.1.0
+// of EiffelTestCaseTriggeredEvent. See eiffeleventsroot.BuildTestCaseTriggeredV1
+// for details.
+func BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV1Builder {
+	return eiffeleventsroot.BuildTestCaseTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseTriggered creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseTriggeredEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTriggered, error) {
+	return f.root.NewTestCaseTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestCaseTriggered returns a builder for version 1.1.0
+// of EiffelTestCaseTriggeredEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV1Builder {
+	return f.root.BuildTestCaseTriggeredV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestCaseTriggered creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestCaseTriggeredEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) (*TestCaseTriggered, error) {
+	return p.Factory().NewTestCaseTriggeredV1(modifiers...)
+}
+
+// BuildTestCaseTriggered returns a builder for version 1.1.0
+// of EiffelTestCaseTriggeredEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestCaseTriggered(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestCaseTriggeredV1Builder {
+	return p.Factory().BuildTestCaseTriggeredV1(modifiers...)
+}
+
 // TestExecutionRecipeCollectionCreated represents version 2.1.0 of EiffelTestExecutionRecipeCollectionCreatedEvent.
 type TestExecutionRecipeCollectionCreated = eiffeleventsroot.TestExecutionRecipeCollectionCreatedV2
 
@@ -222,6 +922,41 @@ func NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modif
 	return eiffeleventsroot.NewTestExecutionRecipeCollectionCreatedV2(append(modifiers, eiffeleventsroot.WithVersion("2.1.0"))...)
 }
 
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 2.1.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent. See eiffeleventsroot.BuildTestExecutionRecipeCollectionCreatedV2
+// for details.
+func BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV2Builder {
+	return eiffeleventsroot.BuildTestExecutionRecipeCollectionCreatedV2(append(modifiers, eiffeleventsroot.WithVersion("2.1.0"))...)
+}
+
+// NewTestExecutionRecipeCollectionCreated creates a new struct pointer that represents
+// version 2.1.0 of EiffelTestExecutionRecipeCollectionCreatedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) (*TestExecutionRecipeCollectionCreated, error) {
+	return f.root.NewTestExecutionRecipeCollectionCreatedV2(append(modifiers, eiffeleventsroot.WithVersion("2.1.0"))...)
+}
+
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 2.1.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV2Builder {
+	return f.root.BuildTestExecutionRecipeCollectionCreatedV2(append(modifiers, eiffeleventsroot.WithVersion("2.1.0"))...)
+}
+
+// NewTestExecutionRecipeCollectionCreated creates a new struct pointer that represents
+// version 2.1.0 of EiffelTestExecutionRecipeCollectionCreatedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) (*TestExecutionRecipeCollectionCreated, error) {
+	return p.Factory().NewTestExecutionRecipeCollectionCreatedV2(modifiers...)
+}
+
+// BuildTestExecutionRecipeCollectionCreated returns a builder for version 2.1.0
+// of EiffelTestExecutionRecipeCollectionCreatedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestExecutionRecipeCollectionCreated(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestExecutionRecipeCollectionCreatedV2Builder {
+	return p.Factory().BuildTestExecutionRecipeCollectionCreatedV2(modifiers...)
+}
+
 // TestSuiteFinished represents version 1.1.0 of EiffelTestSuiteFinishedEvent.
 type TestSuiteFinished = eiffeleventsroot.TestSuiteFinishedV1
 
@@ -232,6 +967,41 @@ func NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFin
 	return eiffeleventsroot.NewTestSuiteFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
 
+// BuildTestSuiteFinished returns a builder for version 1.1.0
+// of EiffelTestSuiteFinishedEvent. See eiffeleventsroot.BuildTestSuiteFinishedV1
+// for details.
+func BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV1Builder {
+	return eiffeleventsroot.BuildTestSuiteFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestSuiteFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestSuiteFinishedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFinished, error) {
+	return f.root.NewTestSuiteFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestSuiteFinished returns a builder for version 1.1.0
+// of EiffelTestSuiteFinishedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV1Builder {
+	return f.root.BuildTestSuiteFinishedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestSuiteFinished creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestSuiteFinishedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteFinished, error) {
+	return p.Factory().NewTestSuiteFinishedV1(modifiers...)
+}
+
+// BuildTestSuiteFinished returns a builder for version 1.1.0
+// of EiffelTestSuiteFinishedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestSuiteFinished(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteFinishedV1Builder {
+	return p.Factory().BuildTestSuiteFinishedV1(modifiers...)
+}
+
 // TestSuiteStarted represents version 1.1.0 of EiffelTestSuiteStartedEvent.
 type TestSuiteStarted = eiffeleventsroot.TestSuiteStartedV1
 
@@ -241,3 +1011,72 @@ type TestSuiteStarted = eiffeleventsroot.TestSuiteStartedV1
 func NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
 	return eiffeleventsroot.NewTestSuiteStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
 }
+
+// BuildTestSuiteStarted returns a builder for version 1.1.0
+// of EiffelTestSuiteStartedEvent. See eiffeleventsroot.BuildTestSuiteStartedV1
+// for details.
+func BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV1Builder {
+	return eiffeleventsroot.BuildTestSuiteStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestSuiteStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestSuiteStartedEvent, with the factory's modifiers
+// applied before the given modifiers.
+func (f *Factory) NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
+	return f.root.NewTestSuiteStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// BuildTestSuiteStarted returns a builder for version 1.1.0
+// of EiffelTestSuiteStartedEvent that applies the factory's modifiers followed by
+// the given modifiers.
+func (f *Factory) BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV1Builder {
+	return f.root.BuildTestSuiteStartedV1(append(modifiers, eiffeleventsroot.WithVersion("1.1.0"))...)
+}
+
+// NewTestSuiteStarted creates a new struct pointer that represents
+// version 1.1.0 of EiffelTestSuiteStartedEvent, with the producer's configuration
+// applied followed by the given modifiers.
+func (p *Producer) NewTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) (*TestSuiteStarted, error) {
+	return p.Factory().NewTestSuiteStartedV1(modifiers...)
+}
+
+// BuildTestSuiteStarted returns a builder for version 1.1.0
+// of EiffelTestSuiteStartedEvent that applies the producer's configuration followed
+// by the given modifiers.
+func (p *Producer) BuildTestSuiteStarted(modifiers ...eiffeleventsroot.Modifier) *eiffeleventsroot.TestSuiteStartedV1Builder {
+	return p.Factory().BuildTestSuiteStartedV1(modifiers...)
+}
+
+// eventVersions maps the event types in this edition to their versions.
+var eventVersions = map[string]string{
+	"EiffelActivityCanceledEvent":                     "1.1.0",
+	"EiffelActivityFinishedEvent":                     "1.1.0",
+	"EiffelActivityStartedEvent":                      "1.1.0",
+	"EiffelActivityTriggeredEvent":                    "1.1.0",
+	"EiffelAnnouncementPublishedEvent":                "1.1.0",
+	"EiffelArtifactCreatedEvent":                      "1.1.0",
+	"EiffelArtifactPublishedEvent":                    "1.1.0",
+	"EiffelArtifactReusedEvent":                       "1.1.0",
+	"EiffelCompositionDefinedEvent":                   "1.1.0",
+	"EiffelConfidenceLevelModifiedEvent":              "1.1.0",
+	"EiffelEnvironmentDefinedEvent":                   "1.1.0",
+	"EiffelFlowContextDefinedEvent":                   "1.1.0",
+	"EiffelIssueVerifiedEvent":                        "1.1.0",
+	"EiffelSourceChangeCreatedEvent":                  "1.1.0",
+	"EiffelSourceChangeSubmittedEvent":                "1.1.0",
+	"EiffelTestCaseCanceledEvent":                     "1.1.0",
+	"EiffelTestCaseFinishedEvent":                     "1.1.0",
+	"EiffelTestCaseStartedEvent":                      "1.1.0",
+	"EiffelTestCaseTriggeredEvent":                    "1.1.0",
+	"EiffelTestExecutionRecipeCollectionCreatedEvent": "2.1.0",
+	"EiffelTestSuiteFinishedEvent":                    "1.1.0",
+	"EiffelTestSuiteStartedEvent":                     "1.1.0",
+}
+
+// Normalize unmarshals a JSON event of any supported version and, if necessary,
+// converts it to the major version used by this edition so that the returned
+// value (a struct pointer) is of one of the types declared in this package.
+// See eiffeleventsroot.Normalize for details.
+func Normalize(input []byte) (interface{}, error) {
+	return eiffeleventsroot.Normalize(input, eventVersions)
+}
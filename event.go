@@ -0,0 +1,82 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// Event is implemented by pointers to all event structs as well as Any,
+// and gives access to the parts of an event that are common to all event
+// types and versions without having to use type assertions or type switches
+// to get hold of the concrete event type.
+type Event interface {
+	CapabilityTeller
+	FieldGetter
+	FieldSetter
+	MetaTeller
+	json.Marshaler
+
+	// GetLinks returns the event's links. The returned slice implements
+	// LinkFinder. Use SetLinks, AddLink, or AddLinkByID to modify the links.
+	GetLinks() EventLinksV1
+
+	// SetLinks replaces the event's links.
+	SetLinks(links EventLinksV1)
+
+	// AddLink adds a new link of the specified type to a target event.
+	AddLink(linkType LinkType, target MetaTeller)
+
+	// AddLinkByID adds a new link of the specified type to a target event
+	// identified by an ID.
+	AddLinkByID(linkType LinkType, target string)
+
+	// CustomData returns the value of the data.customData field.
+	CustomData() []CustomDataV1
+
+	// Tags returns the value of the meta.tags field.
+	Tags() []string
+
+	// Source returns the value of the meta.source field.
+	Source() EventSource
+}
+
+// EventSource is a version-independent representation of the meta.source
+// field of an event.
+type EventSource struct {
+	DomainID string
+	Host     string
+	Name     string
+
+	// Serializer is a purl identifying the serializer. For events where
+	// the serializer is expressed with Maven coordinates (i.e. events with
+	// a meta field of version 1) the coordinates are converted to a purl.
+	Serializer string
+
+	URI string
+}
+
+// mavenPurl returns a purl identifying a Maven package,
+// or an empty string if all inputs are empty.
+func mavenPurl(groupID string, artifactID string, version string) string {
+	if groupID == "" && artifactID == "" && version == "" {
+		return ""
+	}
+	return packageurl.NewPackageURL(packageurl.TypeMaven, groupID, artifactID, version, packageurl.Qualifiers{}, "").String()
+}
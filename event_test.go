@@ -0,0 +1,110 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLinks(t *testing.T) {
+	addContext := func(event Event, contextID string) {
+		if event.GetLinks().FindFirst(LinkType_Context) == "" {
+			event.AddLinkByID(LinkType_Context, contextID)
+		}
+	}
+
+	actt, err := NewActivityTriggeredV1()
+	require.NoError(t, err)
+	scc, err := NewSourceChangeCreatedV4()
+	require.NoError(t, err)
+	scc.Links.AddByID(LinkType_Context, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1")
+
+	for _, event := range []Event{actt, scc} {
+		addContext(event, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2")
+	}
+	assert.Equal(t, []string{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"}, actt.Links.FindAll(LinkType_Context))
+	assert.Equal(t, []string{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}, scc.Links.FindAll(LinkType_Context))
+
+	actt.AddLink(LinkType_Cause, scc)
+	assert.Equal(t, scc.ID(), actt.GetLinks().FindFirst(LinkType_Cause))
+
+	actt.SetLinks(nil)
+	assert.Empty(t, actt.GetLinks())
+}
+
+func TestEventSource(t *testing.T) {
+	testcases := []struct {
+		name     string
+		event    Event
+		expected EventSource
+	}{
+		{
+			name: "MetaV1 with Maven serializer",
+			event: &ActivityTriggeredV1{Meta: MetaV1{Source: MetaV1Source{
+				Name: "name",
+				Serializer: MetaV1SourceSerializer{
+					GroupID:    "com.example",
+					ArtifactID: "app",
+					Version:    "1.0",
+				},
+			}}},
+			expected: EventSource{Name: "name", Serializer: "pkg:maven/com.example/app@1.0"},
+		},
+		{
+			name:     "MetaV1 without serializer",
+			event:    &ActivityTriggeredV1{Meta: MetaV1{Source: MetaV1Source{Host: "host"}}},
+			expected: EventSource{Host: "host"},
+		},
+		{
+			name: "MetaV3",
+			event: &ActivityTriggeredV4{Meta: MetaV3{Source: MetaV3Source{
+				DomainID:   "example.com",
+				Serializer: "pkg:golang/example.com/app@1.0",
+				URI:        "https://example.com",
+			}}},
+			expected: EventSource{DomainID: "example.com", Serializer: "pkg:golang/example.com/app@1.0", URI: "https://example.com"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.event.Source())
+		})
+	}
+}
+
+func TestEventCustomDataAndTags(t *testing.T) {
+	var event Any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"meta": {"type": "EiffelCompositionDefinedEvent", "version": "3.2.0", "time": 1234567890,
+			"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "tags": ["a", "b"]},
+		"data": {"name": "My Composition", "customData": [{"key": "k", "value": 42}]},
+		"links": [{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}]
+	}`), &event))
+
+	assert.Equal(t, []string{"a", "b"}, event.Tags())
+	require.Len(t, event.CustomData(), 1)
+	assert.Equal(t, "k", event.CustomData()[0].Key)
+	assert.Equal(t, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", event.GetLinks().FindFirst(LinkType_Cause))
+
+	// Mutations via Any affect the wrapped event.
+	event.AddLinkByID(LinkType_Context, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2")
+	assert.Len(t, event.Get().(*CompositionDefinedV3).Links, 2)
+}
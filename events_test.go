@@ -28,7 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-//go:generate go run ./internal/cmd/roundtriptestgen protocol/examples/events events_test_roundtripdata.go
+//go:generate go run ./internal/cmd/roundtriptestgen protocol/examples/events events_test_roundtripdata.go events_roundtripbenchmark_test.go
 
 // TestRoundtrip reads example events from the Eiffel protocol repository,
 // deserializes them into Go structs, verifies that we get the expected struct,
@@ -47,6 +47,12 @@ func TestRoundtrip(t *testing.T) {
 			output, err := json.Marshal(event)
 			require.NoError(t, err)
 			assert.JSONEq(t, string(input), string(output))
+
+			// The generated encoding code must produce exactly the same
+			// output as the reflection-based encoding.
+			expected, err := referenceMarshal(event)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(output))
 		})
 	}
 }
@@ -103,7 +109,7 @@ func TestLinksFindAll(t *testing.T) {
 	testcases := []struct {
 		name     string
 		links    EventLinksV1
-		wanted   string
+		wanted   LinkType
 		expected []string
 	}{
 		{
@@ -153,7 +159,7 @@ func TestLinksFindFirst(t *testing.T) {
 	testcases := []struct {
 		name     string
 		links    EventLinksV1
-		wanted   string
+		wanted   LinkType
 		expected string
 	}{
 		{
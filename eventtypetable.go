@@ -23,133 +23,290 @@ import "reflect"
 type majorEventVersion struct {
 	structType    reflect.Type
 	latestVersion string
+	newEvent      func() jsonDecoder
+	create        func(generator Generator, version string, modifiers []Modifier) (Event, error)
+	knownVersions []string
 }
 
 // eventTypeTable maps the major versions of each event to a struct containing
-// a type reference to the Go type used to represent that event and the most
-// recent version of that event within that major version.
+// a type reference to the Go type used to represent that event, the most
+// recent version of that event within that major version, a function
+// that returns a pointer to a new zero value of the Go type, a function
+// that creates a new event of a given version with the meta members
+// populated, and all versions within the major version that are known
+// by this SDK.
 var eventTypeTable = map[string]map[int64]majorEventVersion{
 	"EiffelActivityCanceledEvent": {
-		1: majorEventVersion{reflect.TypeOf(ActivityCanceledV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ActivityCanceledV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ActivityCanceledV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(ActivityCanceledV1{}), "1.1.0", func() jsonDecoder { return &ActivityCanceledV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityCanceledV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ActivityCanceledV2{}), "2.0.0", func() jsonDecoder { return &ActivityCanceledV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityCanceledV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ActivityCanceledV3{}), "3.2.0", func() jsonDecoder { return &ActivityCanceledV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityCanceledV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelActivityFinishedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ActivityFinishedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ActivityFinishedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ActivityFinishedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ActivityFinishedV1{}), "1.1.0", func() jsonDecoder { return &ActivityFinishedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityFinishedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ActivityFinishedV2{}), "2.0.0", func() jsonDecoder { return &ActivityFinishedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityFinishedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ActivityFinishedV3{}), "3.3.0", func() jsonDecoder { return &ActivityFinishedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityFinishedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelActivityStartedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ActivityStartedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ActivityStartedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ActivityStartedV3{}), "3.0.0"},
-		4: majorEventVersion{reflect.TypeOf(ActivityStartedV4{}), "4.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ActivityStartedV1{}), "1.1.0", func() jsonDecoder { return &ActivityStartedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityStartedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ActivityStartedV2{}), "2.0.0", func() jsonDecoder { return &ActivityStartedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityStartedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ActivityStartedV3{}), "3.0.0", func() jsonDecoder { return &ActivityStartedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityStartedV3(generator, version, modifiers))
+		}, []string{"3.0.0"}},
+		4: majorEventVersion{reflect.TypeOf(ActivityStartedV4{}), "4.3.0", func() jsonDecoder { return &ActivityStartedV4{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityStartedV4(generator, version, modifiers))
+		}, []string{"4.0.0", "4.1.0", "4.2.0", "4.3.0"}},
 	},
 	"EiffelActivityTriggeredEvent": {
-		1: majorEventVersion{reflect.TypeOf(ActivityTriggeredV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ActivityTriggeredV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ActivityTriggeredV3{}), "3.0.0"},
-		4: majorEventVersion{reflect.TypeOf(ActivityTriggeredV4{}), "4.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ActivityTriggeredV1{}), "1.1.0", func() jsonDecoder { return &ActivityTriggeredV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityTriggeredV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ActivityTriggeredV2{}), "2.0.0", func() jsonDecoder { return &ActivityTriggeredV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityTriggeredV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ActivityTriggeredV3{}), "3.0.0", func() jsonDecoder { return &ActivityTriggeredV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityTriggeredV3(generator, version, modifiers))
+		}, []string{"3.0.0"}},
+		4: majorEventVersion{reflect.TypeOf(ActivityTriggeredV4{}), "4.3.0", func() jsonDecoder { return &ActivityTriggeredV4{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newActivityTriggeredV4(generator, version, modifiers))
+		}, []string{"4.0.0", "4.1.0", "4.2.0", "4.3.0"}},
 	},
 	"EiffelAnnouncementPublishedEvent": {
-		1: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV1{}), "1.1.0", func() jsonDecoder { return &AnnouncementPublishedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newAnnouncementPublishedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV2{}), "2.0.0", func() jsonDecoder { return &AnnouncementPublishedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newAnnouncementPublishedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(AnnouncementPublishedV3{}), "3.2.0", func() jsonDecoder { return &AnnouncementPublishedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newAnnouncementPublishedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelArtifactCreatedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ArtifactCreatedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ArtifactCreatedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ArtifactCreatedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ArtifactCreatedV1{}), "1.1.0", func() jsonDecoder { return &ArtifactCreatedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactCreatedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ArtifactCreatedV2{}), "2.0.0", func() jsonDecoder { return &ArtifactCreatedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactCreatedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ArtifactCreatedV3{}), "3.3.0", func() jsonDecoder { return &ArtifactCreatedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactCreatedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelArtifactDeployedEvent": {
-		0: majorEventVersion{reflect.TypeOf(ArtifactDeployedV0_1_0{}), "0.1.0"},
+		0: majorEventVersion{reflect.TypeOf(ArtifactDeployedV0_1_0{}), "0.1.0", func() jsonDecoder { return &ArtifactDeployedV0_1_0{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactDeployedV0_1_0(generator, version, modifiers))
+		}, []string{"0.1.0"}},
 	},
 	"EiffelArtifactPublishedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ArtifactPublishedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ArtifactPublishedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ArtifactPublishedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ArtifactPublishedV1{}), "1.1.0", func() jsonDecoder { return &ArtifactPublishedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactPublishedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ArtifactPublishedV2{}), "2.0.0", func() jsonDecoder { return &ArtifactPublishedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactPublishedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ArtifactPublishedV3{}), "3.3.0", func() jsonDecoder { return &ArtifactPublishedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactPublishedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelArtifactReusedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ArtifactReusedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ArtifactReusedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ArtifactReusedV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(ArtifactReusedV1{}), "1.1.0", func() jsonDecoder { return &ArtifactReusedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactReusedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ArtifactReusedV2{}), "2.0.0", func() jsonDecoder { return &ArtifactReusedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactReusedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ArtifactReusedV3{}), "3.2.0", func() jsonDecoder { return &ArtifactReusedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newArtifactReusedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelCompositionDefinedEvent": {
-		1: majorEventVersion{reflect.TypeOf(CompositionDefinedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(CompositionDefinedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(CompositionDefinedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(CompositionDefinedV1{}), "1.1.0", func() jsonDecoder { return &CompositionDefinedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newCompositionDefinedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(CompositionDefinedV2{}), "2.0.0", func() jsonDecoder { return &CompositionDefinedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newCompositionDefinedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(CompositionDefinedV3{}), "3.3.0", func() jsonDecoder { return &CompositionDefinedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newCompositionDefinedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelConfidenceLevelModifiedEvent": {
-		1: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV1{}), "1.1.0", func() jsonDecoder { return &ConfidenceLevelModifiedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newConfidenceLevelModifiedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV2{}), "2.0.0", func() jsonDecoder { return &ConfidenceLevelModifiedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newConfidenceLevelModifiedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(ConfidenceLevelModifiedV3{}), "3.3.0", func() jsonDecoder { return &ConfidenceLevelModifiedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newConfidenceLevelModifiedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelEnvironmentDefinedEvent": {
-		1: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV1{}), "1.1.0", func() jsonDecoder { return &EnvironmentDefinedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newEnvironmentDefinedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV2{}), "2.0.0", func() jsonDecoder { return &EnvironmentDefinedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newEnvironmentDefinedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(EnvironmentDefinedV3{}), "3.3.0", func() jsonDecoder { return &EnvironmentDefinedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newEnvironmentDefinedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelFlowContextDefinedEvent": {
-		1: majorEventVersion{reflect.TypeOf(FlowContextDefinedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(FlowContextDefinedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(FlowContextDefinedV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(FlowContextDefinedV1{}), "1.1.0", func() jsonDecoder { return &FlowContextDefinedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newFlowContextDefinedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(FlowContextDefinedV2{}), "2.0.0", func() jsonDecoder { return &FlowContextDefinedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newFlowContextDefinedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(FlowContextDefinedV3{}), "3.2.0", func() jsonDecoder { return &FlowContextDefinedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newFlowContextDefinedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelIssueDefinedEvent": {
-		1: majorEventVersion{reflect.TypeOf(IssueDefinedV1{}), "1.0.0"},
-		2: majorEventVersion{reflect.TypeOf(IssueDefinedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(IssueDefinedV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(IssueDefinedV1{}), "1.0.0", func() jsonDecoder { return &IssueDefinedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueDefinedV1(generator, version, modifiers))
+		}, []string{"1.0.0"}},
+		2: majorEventVersion{reflect.TypeOf(IssueDefinedV2{}), "2.0.0", func() jsonDecoder { return &IssueDefinedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueDefinedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(IssueDefinedV3{}), "3.2.0", func() jsonDecoder { return &IssueDefinedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueDefinedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelIssueVerifiedEvent": {
-		1: majorEventVersion{reflect.TypeOf(IssueVerifiedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(IssueVerifiedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(IssueVerifiedV3{}), "3.0.0"},
-		4: majorEventVersion{reflect.TypeOf(IssueVerifiedV4{}), "4.3.0"},
+		1: majorEventVersion{reflect.TypeOf(IssueVerifiedV1{}), "1.1.0", func() jsonDecoder { return &IssueVerifiedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueVerifiedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(IssueVerifiedV2{}), "2.0.0", func() jsonDecoder { return &IssueVerifiedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueVerifiedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(IssueVerifiedV3{}), "3.0.0", func() jsonDecoder { return &IssueVerifiedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueVerifiedV3(generator, version, modifiers))
+		}, []string{"3.0.0"}},
+		4: majorEventVersion{reflect.TypeOf(IssueVerifiedV4{}), "4.3.0", func() jsonDecoder { return &IssueVerifiedV4{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newIssueVerifiedV4(generator, version, modifiers))
+		}, []string{"4.0.0", "4.1.0", "4.2.0", "4.3.0"}},
 	},
 	"EiffelSourceChangeCreatedEvent": {
-		1: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV3{}), "3.0.0"},
-		4: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV4{}), "4.2.0"},
+		1: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV1{}), "1.1.0", func() jsonDecoder { return &SourceChangeCreatedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeCreatedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV2{}), "2.0.0", func() jsonDecoder { return &SourceChangeCreatedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeCreatedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV3{}), "3.0.0", func() jsonDecoder { return &SourceChangeCreatedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeCreatedV3(generator, version, modifiers))
+		}, []string{"3.0.0"}},
+		4: majorEventVersion{reflect.TypeOf(SourceChangeCreatedV4{}), "4.2.0", func() jsonDecoder { return &SourceChangeCreatedV4{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeCreatedV4(generator, version, modifiers))
+		}, []string{"4.0.0", "4.1.0", "4.2.0"}},
 	},
 	"EiffelSourceChangeSubmittedEvent": {
-		1: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV1{}), "1.1.0", func() jsonDecoder { return &SourceChangeSubmittedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeSubmittedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV2{}), "2.0.0", func() jsonDecoder { return &SourceChangeSubmittedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeSubmittedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(SourceChangeSubmittedV3{}), "3.2.0", func() jsonDecoder { return &SourceChangeSubmittedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newSourceChangeSubmittedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelTestCaseCanceledEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestCaseCanceledV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestCaseCanceledV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestCaseCanceledV3{}), "3.2.0"},
+		1: majorEventVersion{reflect.TypeOf(TestCaseCanceledV1{}), "1.1.0", func() jsonDecoder { return &TestCaseCanceledV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseCanceledV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestCaseCanceledV2{}), "2.0.0", func() jsonDecoder { return &TestCaseCanceledV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseCanceledV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestCaseCanceledV3{}), "3.2.0", func() jsonDecoder { return &TestCaseCanceledV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseCanceledV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0"}},
 	},
 	"EiffelTestCaseFinishedEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestCaseFinishedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestCaseFinishedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestCaseFinishedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(TestCaseFinishedV1{}), "1.1.0", func() jsonDecoder { return &TestCaseFinishedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseFinishedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.0.1", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestCaseFinishedV2{}), "2.0.0", func() jsonDecoder { return &TestCaseFinishedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseFinishedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestCaseFinishedV3{}), "3.3.0", func() jsonDecoder { return &TestCaseFinishedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseFinishedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelTestCaseStartedEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestCaseStartedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestCaseStartedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestCaseStartedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(TestCaseStartedV1{}), "1.1.0", func() jsonDecoder { return &TestCaseStartedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseStartedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestCaseStartedV2{}), "2.0.0", func() jsonDecoder { return &TestCaseStartedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseStartedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestCaseStartedV3{}), "3.3.0", func() jsonDecoder { return &TestCaseStartedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseStartedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelTestCaseTriggeredEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV3{}), "3.5.0"},
+		1: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV1{}), "1.1.0", func() jsonDecoder { return &TestCaseTriggeredV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseTriggeredV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV2{}), "2.0.0", func() jsonDecoder { return &TestCaseTriggeredV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseTriggeredV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestCaseTriggeredV3{}), "3.5.0", func() jsonDecoder { return &TestCaseTriggeredV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestCaseTriggeredV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0", "3.4.0", "3.5.0"}},
 	},
 	"EiffelTestExecutionRecipeCollectionCreatedEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV1{}), "1.0.0"},
-		2: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV2{}), "2.1.0"},
-		3: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV3{}), "3.0.0"},
-		4: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV4{}), "4.3.0"},
+		1: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV1{}), "1.0.0", func() jsonDecoder { return &TestExecutionRecipeCollectionCreatedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestExecutionRecipeCollectionCreatedV1(generator, version, modifiers))
+		}, []string{"1.0.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV2{}), "2.1.0", func() jsonDecoder { return &TestExecutionRecipeCollectionCreatedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestExecutionRecipeCollectionCreatedV2(generator, version, modifiers))
+		}, []string{"2.0.0", "2.1.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV3{}), "3.0.0", func() jsonDecoder { return &TestExecutionRecipeCollectionCreatedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestExecutionRecipeCollectionCreatedV3(generator, version, modifiers))
+		}, []string{"3.0.0"}},
+		4: majorEventVersion{reflect.TypeOf(TestExecutionRecipeCollectionCreatedV4{}), "4.3.0", func() jsonDecoder { return &TestExecutionRecipeCollectionCreatedV4{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestExecutionRecipeCollectionCreatedV4(generator, version, modifiers))
+		}, []string{"4.0.0", "4.1.0", "4.1.1", "4.2.0", "4.3.0"}},
 	},
 	"EiffelTestSuiteFinishedEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV3{}), "3.3.0"},
+		1: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV1{}), "1.1.0", func() jsonDecoder { return &TestSuiteFinishedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteFinishedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV2{}), "2.0.0", func() jsonDecoder { return &TestSuiteFinishedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteFinishedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestSuiteFinishedV3{}), "3.3.0", func() jsonDecoder { return &TestSuiteFinishedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteFinishedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0"}},
 	},
 	"EiffelTestSuiteStartedEvent": {
-		1: majorEventVersion{reflect.TypeOf(TestSuiteStartedV1{}), "1.1.0"},
-		2: majorEventVersion{reflect.TypeOf(TestSuiteStartedV2{}), "2.0.0"},
-		3: majorEventVersion{reflect.TypeOf(TestSuiteStartedV3{}), "3.4.0"},
+		1: majorEventVersion{reflect.TypeOf(TestSuiteStartedV1{}), "1.1.0", func() jsonDecoder { return &TestSuiteStartedV1{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteStartedV1(generator, version, modifiers))
+		}, []string{"1.0.0", "1.1.0"}},
+		2: majorEventVersion{reflect.TypeOf(TestSuiteStartedV2{}), "2.0.0", func() jsonDecoder { return &TestSuiteStartedV2{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteStartedV2(generator, version, modifiers))
+		}, []string{"2.0.0"}},
+		3: majorEventVersion{reflect.TypeOf(TestSuiteStartedV3{}), "3.4.0", func() jsonDecoder { return &TestSuiteStartedV3{} }, func(generator Generator, version string, modifiers []Modifier) (Event, error) {
+			return asEvent(newTestSuiteStartedV3(generator, version, modifiers))
+		}, []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0", "3.4.0"}},
 	},
 }
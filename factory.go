@@ -0,0 +1,111 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import "slices"
+
+// Factory creates events of any type with a common Generator and a common
+// set of modifiers applied, e.g. to set meta.source.domainId for all events
+// emitted by an application or to make all events reproducible in tests:
+//
+//	factory := eiffelevents.NewFactory(
+//		eiffelevents.WithClock(fakeClock),
+//		eiffelevents.WithIDSource(eiffelevents.NewSequentialIDSource(namespace)),
+//		eiffelevents.WithSourceDomainID("example.com"),
+//	)
+//	event, err := factory.NewCompositionDefinedV3()
+//
+// Each event type has a NewXxx and a BuildXxx method that work like the
+// package-level functions with the same names, except that meta.id and
+// meta.time come from the factory's Generator and that the factory's
+// modifiers are applied before the modifiers passed to the method.
+// A Factory is safe for concurrent use as long as its Generator and
+// modifiers are.
+type Factory struct {
+	generator Generator
+	modifiers []Modifier
+}
+
+// FactoryOption configures a Factory. Besides the options returned by
+// WithGenerator, WithIDSource, and WithClock, any Modifier is a
+// FactoryOption that adds the modifier to the factory.
+type FactoryOption interface {
+	applyToFactory(f *Factory)
+}
+
+// factoryOptionFunc is a function that implements FactoryOption.
+type factoryOptionFunc func(f *Factory)
+
+func (fn factoryOptionFunc) applyToFactory(f *Factory) {
+	fn(f)
+}
+
+func (m Modifier) applyToFactory(f *Factory) {
+	f.modifiers = append(f.modifiers, m)
+}
+
+// WithGenerator makes a Factory use the given Generator
+// for the meta.id and meta.time values of new events.
+func WithGenerator(generator Generator) FactoryOption {
+	return factoryOptionFunc(func(f *Factory) {
+		f.generator = generator
+	})
+}
+
+// WithIDSource makes a Factory use the given IDSource for the meta.id
+// values of new events instead of random UUIDs.
+func WithIDSource(source IDSource) FactoryOption {
+	return factoryOptionFunc(func(f *Factory) {
+		f.generator.IDSource = source
+	})
+}
+
+// WithClock makes a Factory use the given Clock for the meta.time
+// values of new events instead of the current time.
+func WithClock(clock Clock) FactoryOption {
+	return factoryOptionFunc(func(f *Factory) {
+		f.generator.Clock = clock
+	})
+}
+
+// NewFactory returns a new Factory configured with the given options.
+// Modifiers are applied to all events the factory creates, in the given order.
+func NewFactory(options ...FactoryOption) *Factory {
+	return (&Factory{}).With(options...)
+}
+
+// With returns a new Factory with the configuration of the receiver and
+// the given options, i.e. any modifiers among the options are applied
+// after the modifiers of the receiver. The receiver is not modified.
+func (f *Factory) With(options ...FactoryOption) *Factory {
+	derived := &Factory{
+		generator: f.generator,
+		modifiers: slices.Clone(f.modifiers),
+	}
+	for _, option := range options {
+		option.applyToFactory(derived)
+	}
+	return derived
+}
+
+// withModifiers returns a new slice with the factory's modifiers
+// followed by the given modifiers.
+func (f *Factory) withModifiers(modifiers []Modifier) []Modifier {
+	result := make([]Modifier, 0, len(f.modifiers)+len(modifiers))
+	result = append(result, f.modifiers...)
+	return append(result, modifiers...)
+}
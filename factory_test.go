@@ -0,0 +1,91 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFactory() *Factory {
+	return NewFactory(
+		WithClock(ClockFunc(func() time.Time { return time.UnixMilli(1234567890) })),
+		WithIDSource(NewSequentialIDSource(testNamespace)),
+	)
+}
+
+func TestFactoryIsReproducible(t *testing.T) {
+	createEvents := func(t *testing.T, factory *Factory) []string {
+		event1, err := factory.NewCompositionDefinedV3()
+		require.NoError(t, err)
+		event2, err := factory.NewArtifactCreatedV3()
+		require.NoError(t, err)
+		event3, err := factory.BuildCompositionDefinedV3().Name("c").Build()
+		require.NoError(t, err)
+		return []string{event1.String(), event2.String(), event3.String()}
+	}
+
+	events := createEvents(t, newTestFactory())
+	assert.Equal(t, events, createEvents(t, newTestFactory()))
+	assert.Contains(t, events[0], `"time":1234567890`)
+}
+
+func TestFactoryModifierOrder(t *testing.T) {
+	factory := NewFactory(WithSourceDomainID("factory"), WithVersion("3.1.0"))
+
+	event, err := factory.NewCompositionDefinedV3(WithSourceDomainID("call"))
+	require.NoError(t, err)
+	assert.Equal(t, "call", event.Meta.Source.DomainID)
+	assert.Equal(t, "3.1.0", event.Meta.Version)
+
+	event, err = factory.BuildCompositionDefinedV3(WithSourceDomainID("call")).Name("c").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "call", event.Meta.Source.DomainID)
+}
+
+func TestFactoryWith(t *testing.T) {
+	base := NewFactory(WithSourceDomainID("base"))
+	derived := base.With(WithSourceHost("host"))
+
+	event, err := base.NewCompositionDefinedV3()
+	require.NoError(t, err)
+	assert.Equal(t, "base", event.Meta.Source.DomainID)
+	assert.Empty(t, event.Meta.Source.Host)
+
+	event, err = derived.NewCompositionDefinedV3()
+	require.NoError(t, err)
+	assert.Equal(t, "base", event.Meta.Source.DomainID)
+	assert.Equal(t, "host", event.Meta.Source.Host)
+
+	// The generator is inherited but can be replaced.
+	clock := func(timestamp int64) Clock {
+		return ClockFunc(func() time.Time { return time.UnixMilli(timestamp) })
+	}
+	base = NewFactory(WithClock(clock(1000)))
+	event, err = base.With(WithSourceHost("host")).NewCompositionDefinedV3()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), event.Meta.Time)
+	event, err = base.With(WithClock(clock(2000))).NewCompositionDefinedV3()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), event.Meta.Time)
+	event, err = base.NewCompositionDefinedV3()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), event.Meta.Time)
+}
@@ -0,0 +1,133 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// FieldGetter gets the value of a struct field by name using the usual
+// toplevelfield.subfield notation, i.e. it's the reading counterpart of
+// FieldSetter. Fields should be expressed with their JSON names, i.e. based
+// on the "json" tag. Array elements are selected with [index], e.g.
+// "data.batches[0].recipes[2].id". The [*] wildcard selects all elements
+// of an array, in which case a []interface{} with all matching values
+// is returned. Errors wrap the same errors as those returned by FieldSetter.
+type FieldGetter interface {
+	GetField(fieldName string) (interface{}, error)
+}
+
+// getField returns the value of a struct field whose path is expressed
+// with dot notation using the field names in the "json" tag. See FieldGetter
+// for a description of the path syntax. The reflect.Value passed as the
+// source must be a pointer to a struct or a struct.
+func getField(source reflect.Value, fieldName string) (interface{}, error) {
+	if source.Kind() == reflect.Ptr {
+		if source.IsNil() {
+			return nil, errors.New("source value is a nil pointer")
+		}
+		source = source.Elem()
+	}
+	if source.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("the source value must be a struct or point to a struct but was a %s", source.Kind())
+	}
+
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return nil, err
+	}
+	values, err := resolveFieldPath(source, path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, elem := range path {
+		if elem.kind == fieldPathWildcard {
+			result := make([]interface{}, 0, len(values))
+			for _, v := range values {
+				result = append(result, v.Interface())
+			}
+			return result, nil
+		}
+	}
+	return values[0].Interface(), nil
+}
+
+// resolveFieldPath follows a parsed field path from a value and returns
+// all values at the end of the path. Unless the path contains wildcards
+// there'll be exactly one value. The parent parameter is the path leading
+// up to the value and is only used in error messages.
+func resolveFieldPath(value reflect.Value, path []fieldPathElement, parent string) ([]reflect.Value, error) {
+	if len(path) == 0 {
+		return []reflect.Value{value}, nil
+	}
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, fmt.Errorf("%w: field %q is nil", ErrFieldNotFound, parent)
+		}
+		value = value.Elem()
+	}
+
+	elem := path[0]
+	current := joinFieldPathElement(parent, elem)
+
+	switch elem.kind {
+	case fieldPathName:
+		switch value.Kind() {
+		case reflect.Struct:
+			field, err := getJSONField(value, elem.name)
+			if err != nil {
+				return nil, err
+			}
+			return resolveFieldPath(field, path[1:], current)
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				break
+			}
+			mapValue := value.MapIndex(reflect.ValueOf(elem.name).Convert(value.Type().Key()))
+			if !mapValue.IsValid() {
+				return nil, fmt.Errorf("%w: the map %q did not contain the key %q", ErrFieldNotFound, parent, elem.name)
+			}
+			return resolveFieldPath(mapValue, path[1:], current)
+		}
+		return nil, fmt.Errorf("%w: field %q is a %s and has no field %q", ErrIncompatibleType, parent, value.Kind(), elem.name)
+	case fieldPathIndex:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return nil, fmt.Errorf("%w: field %q is a %s and can't be indexed", ErrIncompatibleType, parent, value.Kind())
+		}
+		if elem.index >= value.Len() {
+			return nil, fmt.Errorf("%w: index %d of field %q is out of range (length %d)", ErrIndexOutOfRange, elem.index, parent, value.Len())
+		}
+		return resolveFieldPath(value.Index(elem.index), path[1:], current)
+	case fieldPathWildcard:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return nil, fmt.Errorf("%w: field %q is a %s and can't be indexed", ErrIncompatibleType, parent, value.Kind())
+		}
+		var result []reflect.Value
+		for i := 0; i < value.Len(); i++ {
+			values, err := resolveFieldPath(value.Index(i), path[1:], fmt.Sprintf("%s[%d]", parent, i))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, values...)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("%w: %q can't be used when getting a field", ErrInvalidFieldName, elem)
+}
@@ -0,0 +1,157 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type getFieldTestStruct struct {
+	String  string                     `json:"string"`
+	Struct  setFieldLevel2TestStruct   `json:"struct"`
+	Structs []setFieldLevel2TestStruct `json:"structs"`
+	Matrix  [][]int                    `json:"matrix"`
+	Any     interface{}                `json:"any"`
+}
+
+func TestGetField(t *testing.T) {
+	input := getFieldTestStruct{
+		String: "value",
+		Struct: setFieldLevel2TestStruct{Level2String: "nested value"},
+		Structs: []setFieldLevel2TestStruct{
+			{Level2String: "first"},
+			{Level2String: "second"},
+		},
+		Matrix: [][]int{{1, 2}, {3, 4}},
+		Any: map[string]interface{}{
+			"key": []interface{}{"a", "b"},
+		},
+	}
+	testcases := []struct {
+		name        string
+		field       string
+		expected    interface{}
+		expectedErr string
+	}{
+		{
+			name:     "First level field",
+			field:    "string",
+			expected: "value",
+		},
+		{
+			name:     "Struct field",
+			field:    "struct",
+			expected: setFieldLevel2TestStruct{Level2String: "nested value"},
+		},
+		{
+			name:     "Second level field",
+			field:    "struct.level_2_string",
+			expected: "nested value",
+		},
+		{
+			name:     "Array index",
+			field:    "structs[1].level_2_string",
+			expected: "second",
+		},
+		{
+			name:     "Multiple array indexes",
+			field:    "matrix[1][0]",
+			expected: 3,
+		},
+		{
+			name:     "Wildcard",
+			field:    "structs[*].level_2_string",
+			expected: []interface{}{"first", "second"},
+		},
+		{
+			name:     "Nested wildcards",
+			field:    "matrix[*][*]",
+			expected: []interface{}{1, 2, 3, 4},
+		},
+		{
+			name:     "Map inside interface value",
+			field:    "any.key[1]",
+			expected: "b",
+		},
+		{
+			name:        "Non-existent field",
+			field:       "struct.bogus",
+			expectedErr: "struct did not contain a field with the JSON name",
+		},
+		{
+			name:        "Index out of range",
+			field:       "structs[2]",
+			expectedErr: "out of range",
+		},
+		{
+			name:        "Indexing a non-array",
+			field:       "string[0]",
+			expectedErr: "can't be indexed",
+		},
+		{
+			name:        "Invalid index",
+			field:       "structs[x]",
+			expectedErr: "invalid array index",
+		},
+		{
+			name:        "Missing closing bracket",
+			field:       "structs[0",
+			expectedErr: "missing closing bracket",
+		},
+		{
+			name:        "Empty path element",
+			field:       "struct..level_2_string",
+			expectedErr: "invalid field name",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := getField(reflect.ValueOf(&input), tc.field)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestEventGetField(t *testing.T) {
+	event, err := NewTestExecutionRecipeCollectionCreatedV4(WithSourceHost("example.com"))
+	require.NoError(t, err)
+	event.Data.Batches = []TERCCV4DataBatch{
+		{Recipes: []TERCCV4DataBatchRecipe{{ID: "recipe-1"}, {ID: "recipe-2"}}},
+	}
+
+	value, err := event.GetField("meta.source.host")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", value)
+
+	value, err = event.GetField("data.batches[0].recipes[1].id")
+	require.NoError(t, err)
+	assert.Equal(t, "recipe-2", value)
+
+	value, err = event.GetField("data.batches[*].recipes[*].id")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"recipe-1", "recipe-2"}, value)
+}
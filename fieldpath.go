@@ -0,0 +1,100 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fieldPathElementKind describes what kind of element a fieldPathElement is.
+type fieldPathElementKind int
+
+const (
+	fieldPathName     fieldPathElementKind = iota // A struct field or map key, e.g. "meta".
+	fieldPathIndex                                // An array index, e.g. "[2]".
+	fieldPathWildcard                             // All array elements, i.e. "[*]".
+	fieldPathAppend                               // A new element at the end of an array, i.e. "[+]".
+)
+
+// fieldPathElement is a single element of a parsed field path.
+type fieldPathElement struct {
+	kind  fieldPathElementKind
+	name  string
+	index int
+}
+
+func (e fieldPathElement) String() string {
+	switch e.kind {
+	case fieldPathIndex:
+		return fmt.Sprintf("[%d]", e.index)
+	case fieldPathWildcard:
+		return "[*]"
+	case fieldPathAppend:
+		return "[+]"
+	}
+	return e.name
+}
+
+// parseFieldPath parses a field path expressed with dot notation using
+// JSON field names, optionally with array indexes, wildcards, or appends,
+// e.g. "data.batches[0].recipes[*].id" or "data.customData[+]".
+func parseFieldPath(path string) ([]fieldPathElement, error) {
+	if path == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFieldName, path)
+	}
+	var elements []fieldPathElement
+	for _, segment := range strings.Split(path, ".") {
+		name, rest, _ := strings.Cut(segment, "[")
+		if name == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidFieldName, path)
+		}
+		elements = append(elements, fieldPathElement{kind: fieldPathName, name: name})
+		if rest == "" && !strings.Contains(segment, "[") {
+			continue
+		}
+
+		// Parse one or more bracketed expressions, e.g. "0]" or "0][1]"
+		// (the first opening bracket has already been consumed).
+		for {
+			expr, remainder, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("%w %q: missing closing bracket", ErrInvalidFieldName, path)
+			}
+			if expr == "*" {
+				elements = append(elements, fieldPathElement{kind: fieldPathWildcard})
+			} else if expr == "+" {
+				elements = append(elements, fieldPathElement{kind: fieldPathAppend})
+			} else {
+				index, err := strconv.Atoi(expr)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("%w %q: invalid array index %q", ErrInvalidFieldName, path, expr)
+				}
+				elements = append(elements, fieldPathElement{kind: fieldPathIndex, index: index})
+			}
+			if remainder == "" {
+				break
+			}
+			if !strings.HasPrefix(remainder, "[") {
+				return nil, fmt.Errorf("%w %q: unexpected %q after closing bracket", ErrInvalidFieldName, path, remainder)
+			}
+			rest = remainder[1:]
+		}
+	}
+	return elements, nil
+}
@@ -17,18 +17,45 @@
 package eiffelevents
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
-	"strings"
+)
+
+var (
+	ErrFieldNotFound    error = errors.New("field not found")
+	ErrIndexOutOfRange  error = errors.New("array index out of range")
+	ErrIncompatibleType error = errors.New("incompatible type")
+	ErrInvalidEnumValue error = errors.New("invalid enum value")
+	ErrInvalidFieldName error = errors.New("invalid field name")
 )
 
 // FieldSetter sets struct field by name using the usual toplevelfield.subfield notation.
 // Fields should be expressed with their JSON names, i.e. based on the "json" tag.
+// Array elements are selected with [index], e.g. "data.customData[0].value",
+// and [+] appends a new element to an array, e.g. "data.customData[+]".
+//
+// The value is converted to the field's type if possible. Integers may be
+// assigned to fields of any numeric type as long as the value fits,
+// floating point numbers may be assigned to integer fields if they don't
+// have a fractional part, strings may be assigned to fields of named string
+// types (e.g. enums, in which case the value must be one of the enum's
+// values), maps may be assigned to struct fields, and slices may be
+// assigned to slice fields as long as each element can be converted.
+//
+// Errors wrap one of ErrFieldNotFound, ErrIndexOutOfRange,
+// ErrIncompatibleType, ErrInvalidEnumValue, and ErrInvalidFieldName.
 type FieldSetter interface {
 	SetField(fieldName string, value interface{}) error
 }
 
+// enumValidator is implemented by all generated enum types.
+type enumValidator interface {
+	IsValid() bool
+}
+
 // setField sets the value of a struct field whose path is expressed
 // with dot notation using the field names in the "json" tag. The reflect.Value
 // passed as the target must be a pointer to a struct.
@@ -41,57 +68,209 @@ func setField(target reflect.Value, fieldName string, value interface{}) error {
 		return fmt.Errorf("the target value must point to a struct but pointed to a %s", elemVal.Kind())
 	}
 
-	// Recurse if there's a dot in the field name, otherwise try to set that field.
-	dotIndex := strings.Index(fieldName, ".")
-	if dotIndex == 0 {
-		return fmt.Errorf("invalid field name: %q", fieldName)
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
 	}
-	if dotIndex > 0 {
-		firstLevelFieldName := fieldName[0:dotIndex]
-		remainderFieldName := fieldName[dotIndex+1:]
+	return setFieldPath(elemVal, path, "", value)
+}
 
-		// Locate the struct to recurse into
-		field, err := getJSONField(elemVal, firstLevelFieldName)
-		if err != nil {
-			return err
+// setFieldPath follows a parsed field path from a settable value and sets
+// the value at the end of the path. The parent parameter is the path leading
+// up to the value and is only used in error messages.
+func setFieldPath(target reflect.Value, path []fieldPathElement, parent string, value interface{}) error {
+	if len(path) == 0 {
+		return assignValue(target, parent, value)
+	}
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
 		}
-		if !field.CanAddr() {
-			return fmt.Errorf("struct field %q is not addressable", firstLevelFieldName)
+		target = target.Elem()
+	}
+
+	elem := path[0]
+	current := joinFieldPathElement(parent, elem)
+	switch elem.kind {
+	case fieldPathName:
+		if target.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: field %q is a %s and has no field %q", ErrIncompatibleType, parent, target.Kind(), elem.name)
 		}
-		return setField(field.Addr(), remainderFieldName, value)
-	} else {
-		// Locate the field to set
-		field, err := getJSONField(elemVal, fieldName)
+		field, err := getJSONField(target, elem.name)
 		if err != nil {
 			return err
 		}
 		if !field.CanSet() {
-			return fmt.Errorf("struct field %q cannot be set", fieldName)
+			return fmt.Errorf("struct field %q cannot be set", current)
+		}
+		return setFieldPath(field, path[1:], current, value)
+	case fieldPathIndex:
+		if target.Kind() != reflect.Slice && target.Kind() != reflect.Array {
+			return fmt.Errorf("%w: field %q is a %s and can't be indexed", ErrIncompatibleType, parent, target.Kind())
+		}
+		if elem.index >= target.Len() {
+			return fmt.Errorf("%w: index %d of field %q is out of range (length %d)", ErrIndexOutOfRange, elem.index, parent, target.Len())
+		}
+		return setFieldPath(target.Index(elem.index), path[1:], current, value)
+	case fieldPathAppend:
+		if target.Kind() != reflect.Slice {
+			return fmt.Errorf("%w: field %q is a %s and can't be appended to", ErrIncompatibleType, parent, target.Kind())
+		}
+		// Populate the new element before appending it so that
+		// the slice is left untouched if something goes wrong.
+		newElem := reflect.New(target.Type().Elem()).Elem()
+		if err := setFieldPath(newElem, path[1:], current, value); err != nil {
+			return err
+		}
+		target.Set(reflect.Append(target, newElem))
+		return nil
+	}
+	return fmt.Errorf("%w: %q can't be used when setting a field", ErrInvalidFieldName, elem)
+}
+
+// assignValue assigns a value to a settable reflect.Value, converting
+// the value to the target's type if needed and possible. The path parameter
+// is the path to the target and is only used in error messages.
+func assignValue(target reflect.Value, path string, value interface{}) error {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	source := reflect.ValueOf(value)
+	incompatible := fmt.Errorf("%w: cannot assign value of type %s to field %q of type %s",
+		ErrIncompatibleType, source.Type(), path, target.Type())
+
+	// Numbers encoded as json.Number are easiest to deal with as strings,
+	// but not if we're assigning them to string fields.
+	if n, ok := value.(json.Number); ok && target.Kind() != reflect.String {
+		if i, err := n.Int64(); err == nil {
+			source = reflect.ValueOf(i)
+		} else if f, err := n.Float64(); err == nil {
+			source = reflect.ValueOf(f)
 		}
-		field.Set(reflect.ValueOf(value))
 	}
 
+	if source.Type().AssignableTo(target.Type()) {
+		if err := checkEnumValue(source, path); err != nil {
+			return err
+		}
+		target.Set(source)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.String, reflect.Bool:
+		if source.Kind() != target.Kind() {
+			return incompatible
+		}
+		converted := source.Convert(target.Type())
+		if err := checkEnumValue(converted, path); err != nil {
+			return err
+		}
+		target.Set(converted)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i = source.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if source.Uint() > math.MaxInt64 {
+				return fmt.Errorf("%w: value %d overflows field %q of type %s", ErrIncompatibleType, source.Uint(), path, target.Type())
+			}
+			i = int64(source.Uint())
+		case reflect.Float32, reflect.Float64:
+			f := source.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return fmt.Errorf("%w: value %v can't be represented by field %q of type %s", ErrIncompatibleType, f, path, target.Type())
+			}
+			i = int64(f)
+		default:
+			return incompatible
+		}
+		if target.OverflowInt(i) {
+			return fmt.Errorf("%w: value %d overflows field %q of type %s", ErrIncompatibleType, i, path, target.Type())
+		}
+		target.SetInt(i)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f = float64(source.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			f = float64(source.Uint())
+		case reflect.Float32, reflect.Float64:
+			f = source.Float()
+		default:
+			return incompatible
+		}
+		if target.OverflowFloat(f) {
+			return fmt.Errorf("%w: value %v overflows field %q of type %s", ErrIncompatibleType, f, path, target.Type())
+		}
+		target.SetFloat(f)
+		return nil
+	case reflect.Struct:
+		if source.Kind() != reflect.Map || source.Type().Key().Kind() != reflect.String {
+			return incompatible
+		}
+		// Populate a new struct so that the target is left
+		// untouched if something goes wrong.
+		newStruct := reflect.New(target.Type()).Elem()
+		iter := source.MapRange()
+		for iter.Next() {
+			name := iter.Key().String()
+			field, err := getJSONField(newStruct, name)
+			if err != nil {
+				return fmt.Errorf("%w (in %q)", err, path)
+			}
+			if err := assignValue(field, joinFieldPath(path, name), iter.Value().Interface()); err != nil {
+				return err
+			}
+		}
+		target.Set(newStruct)
+		return nil
+	case reflect.Slice:
+		if source.Kind() != reflect.Slice && source.Kind() != reflect.Array {
+			return incompatible
+		}
+		newSlice := reflect.MakeSlice(target.Type(), source.Len(), source.Len())
+		for i := 0; i < source.Len(); i++ {
+			if err := assignValue(newSlice.Index(i), fmt.Sprintf("%s[%d]", path, i), source.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		target.Set(newSlice)
+		return nil
+	}
+	return incompatible
+}
+
+// checkEnumValue returns an ErrInvalidEnumValue error if the
+// value is of an enum type but isn't one of the enum's values.
+func checkEnumValue(value reflect.Value, path string) error {
+	if ev, ok := value.Interface().(enumValidator); ok && !ev.IsValid() {
+		return fmt.Errorf("%w: %q isn't a valid value for field %q of type %s", ErrInvalidEnumValue, value.String(), path, value.Type())
+	}
 	return nil
 }
 
+// joinFieldPathElement appends an element to a field path expressed
+// in the notation understood by parseFieldPath.
+func joinFieldPathElement(path string, elem fieldPathElement) string {
+	if elem.kind == fieldPathName {
+		return joinFieldPath(path, elem.name)
+	}
+	return path + elem.String()
+}
+
 // getJSONField returns the value of a struct field that has the given JSON name in the "json" tag.
 func getJSONField(structVal reflect.Value, fieldName string) (reflect.Value, error) {
 	structType := structVal.Type()
 	for i := 0; i < structType.NumField(); i++ {
-		jsonField := structType.Field(i).Tag.Get("json")
-		if jsonField == "" || jsonField == "-" {
-			continue
-		}
-
-		// Trim any additional options from the end,
-		// i.e. turn "fieldname,omitempty" into plain "fieldname".
-		if commaIndex := strings.Index(jsonField, ","); commaIndex != -1 {
-			jsonField = jsonField[0:commaIndex]
-		}
-
-		if jsonField == fieldName {
+		if name, _ := jsonFieldName(structType.Field(i)); name == fieldName {
 			return structVal.Field(i), nil
 		}
 	}
-	return reflect.Value{}, fmt.Errorf("the struct did not contain a field with the JSON name %q", fieldName)
+	return reflect.Value{}, fmt.Errorf("%w: the struct did not contain a field with the JSON name %q", ErrFieldNotFound, fieldName)
 }
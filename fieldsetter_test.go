@@ -17,6 +17,7 @@
 package eiffelevents
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -106,3 +107,196 @@ func TestSetField(t *testing.T) {
 		})
 	}
 }
+
+type setFieldEnumTestType string
+
+const setFieldEnumTestType_Valid setFieldEnumTestType = "VALID"
+
+func (e setFieldEnumTestType) IsValid() bool {
+	return e == setFieldEnumTestType_Valid
+}
+
+type setFieldCoercionTestStruct struct {
+	Int64   int64                      `json:"int64"`
+	Int8    int8                       `json:"int8"`
+	Float   float64                    `json:"float"`
+	Enum    setFieldEnumTestType       `json:"enum"`
+	Struct  setFieldLevel2TestStruct   `json:"struct"`
+	Structs []setFieldLevel2TestStruct `json:"structs"`
+	Strings []string                   `json:"strings"`
+	Ptr     *setFieldLevel2TestStruct  `json:"ptr"`
+}
+
+func TestSetFieldCoercion(t *testing.T) {
+	testcases := []struct {
+		name     string
+		input    setFieldCoercionTestStruct
+		field    string
+		value    interface{}
+		expected setFieldCoercionTestStruct
+		errorIs  error
+	}{
+		{
+			name:     "Int to int64",
+			field:    "int64",
+			value:    123,
+			expected: setFieldCoercionTestStruct{Int64: 123},
+		},
+		{
+			name:     "Whole float to int64",
+			field:    "int64",
+			value:    123.0,
+			expected: setFieldCoercionTestStruct{Int64: 123},
+		},
+		{
+			name:     "json.Number to int64",
+			field:    "int64",
+			value:    json.Number("123"),
+			expected: setFieldCoercionTestStruct{Int64: 123},
+		},
+		{
+			name:    "Fractional float to int64",
+			field:   "int64",
+			value:   1.5,
+			errorIs: ErrIncompatibleType,
+		},
+		{
+			name:    "Overflowing int",
+			field:   "int8",
+			value:   300,
+			errorIs: ErrIncompatibleType,
+		},
+		{
+			name:     "Int to float",
+			field:    "float",
+			value:    int32(2),
+			expected: setFieldCoercionTestStruct{Float: 2},
+		},
+		{
+			name:    "String to int",
+			field:   "int64",
+			value:   "123",
+			errorIs: ErrIncompatibleType,
+		},
+		{
+			name:     "Valid string to enum",
+			field:    "enum",
+			value:    "VALID",
+			expected: setFieldCoercionTestStruct{Enum: setFieldEnumTestType_Valid},
+		},
+		{
+			name:    "Invalid string to enum",
+			field:   "enum",
+			value:   "INVALID",
+			errorIs: ErrInvalidEnumValue,
+		},
+		{
+			name:    "Invalid enum value",
+			field:   "enum",
+			value:   setFieldEnumTestType("INVALID"),
+			errorIs: ErrInvalidEnumValue,
+		},
+		{
+			name:     "Map to struct",
+			field:    "struct",
+			value:    map[string]interface{}{"level_2_string": "value"},
+			expected: setFieldCoercionTestStruct{Struct: setFieldLevel2TestStruct{Level2String: "value"}},
+		},
+		{
+			name:    "Map with unknown key to struct",
+			field:   "struct",
+			value:   map[string]interface{}{"bogus": "value"},
+			errorIs: ErrFieldNotFound,
+		},
+		{
+			name:     "Slice of maps to slice of structs",
+			field:    "structs",
+			value:    []interface{}{map[string]interface{}{"level_2_string": "value"}},
+			expected: setFieldCoercionTestStruct{Structs: []setFieldLevel2TestStruct{{Level2String: "value"}}},
+		},
+		{
+			name:     "Set indexed element",
+			input:    setFieldCoercionTestStruct{Strings: []string{"a", "b"}},
+			field:    "strings[1]",
+			value:    "c",
+			expected: setFieldCoercionTestStruct{Strings: []string{"a", "c"}},
+		},
+		{
+			name:     "Set field of indexed element",
+			input:    setFieldCoercionTestStruct{Structs: []setFieldLevel2TestStruct{{}}},
+			field:    "structs[0].level_2_string",
+			value:    "value",
+			expected: setFieldCoercionTestStruct{Structs: []setFieldLevel2TestStruct{{Level2String: "value"}}},
+		},
+		{
+			name:    "Index out of range",
+			input:   setFieldCoercionTestStruct{Strings: []string{"a"}},
+			field:   "strings[1]",
+			value:   "b",
+			errorIs: ErrIndexOutOfRange,
+		},
+		{
+			name:     "Append element",
+			input:    setFieldCoercionTestStruct{Strings: []string{"a"}},
+			field:    "strings[+]",
+			value:    "b",
+			expected: setFieldCoercionTestStruct{Strings: []string{"a", "b"}},
+		},
+		{
+			name:     "Append and set field of new element",
+			field:    "structs[+].level_2_string",
+			value:    "value",
+			expected: setFieldCoercionTestStruct{Structs: []setFieldLevel2TestStruct{{Level2String: "value"}}},
+		},
+		{
+			name:    "Failed append leaves slice untouched",
+			input:   setFieldCoercionTestStruct{Strings: []string{"a"}},
+			field:   "strings[+]",
+			value:   1,
+			errorIs: ErrIncompatibleType,
+		},
+		{
+			name:     "Set field via nil pointer",
+			field:    "ptr.level_2_string",
+			value:    "value",
+			expected: setFieldCoercionTestStruct{Ptr: &setFieldLevel2TestStruct{Level2String: "value"}},
+		},
+		{
+			name:    "Wildcards aren't supported",
+			field:   "strings[*]",
+			value:   "value",
+			errorIs: ErrInvalidFieldName,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := tc.expected
+			if tc.errorIs != nil {
+				expected = tc.input
+			}
+			err := setField(reflect.ValueOf(&tc.input), tc.field, tc.value) // nolint:gosec
+			if tc.errorIs != nil {
+				require.ErrorIs(t, err, tc.errorIs)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, expected, tc.input)
+		})
+	}
+}
+
+func TestEventSetField(t *testing.T) {
+	event, err := NewTestCaseFinishedV3()
+	require.NoError(t, err)
+
+	require.NoError(t, event.SetField("data.outcome.verdict", "PASSED"))
+	assert.Equal(t, TCFV3DataOutcomeVerdict_Passed, event.Data.Outcome.Verdict)
+	require.ErrorIs(t, event.SetField("data.outcome.verdict", "BOGUS"), ErrInvalidEnumValue)
+
+	require.NoError(t, event.SetField("meta.time", 1234567890))
+	assert.Equal(t, int64(1234567890), event.Meta.Time)
+
+	require.NoError(t, event.SetField("data.customData[+]", map[string]interface{}{"key": "k", "value": 1}))
+	require.NoError(t, event.SetField("data.customData[+].key", "k2"))
+	assert.Equal(t, []CustomDataV1{{Key: "k", Value: 1}, {Key: "k2"}}, event.Data.CustomData)
+}
@@ -0,0 +1,147 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IDSource generates IDs for new events. The IDs must be UUIDs.
+type IDSource interface {
+	NewID() string
+}
+
+// IDSourceFunc is a function that implements IDSource.
+type IDSourceFunc func() string
+
+func (f IDSourceFunc) NewID() string {
+	return f()
+}
+
+// NewSequentialIDSource returns an IDSource whose IDs are name-based
+// UUIDs (version 5) derived from the namespace and a counter that starts
+// at one. Two sources with the same namespace therefore return the same
+// sequence of IDs, which makes them useful for golden-file tests.
+// The returned IDSource is safe for concurrent use.
+func NewSequentialIDSource(namespace uuid.UUID) IDSource {
+	var counter atomic.Uint64
+	return IDSourceFunc(func() string {
+		n := counter.Add(1)
+		return uuid.NewSHA1(namespace, []byte(strconv.FormatUint(n, 10))).String()
+	})
+}
+
+// Clock provides the current time for new events.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is a function that implements Clock.
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// Generator provides the meta.id and meta.time values of the events created
+// by the factory functions, the builders, and New. The zero value, which is
+// what the package-level functions use, gives random UUIDs (version 4) and
+// the current time. A Factory can be configured with another Generator
+// (see WithGenerator, WithIDSource, and WithClock), e.g. to make all events
+// created by the factory reproducible in tests.
+type Generator struct {
+	// IDSource provides the meta.id values. If nil, random UUIDs are used.
+	IDSource IDSource
+
+	// Clock provides the meta.time values. If nil, the current time is used.
+	Clock Clock
+}
+
+// newID returns the meta.id value of a new event.
+func (g Generator) newID() string {
+	if g.IDSource == nil {
+		return uuid.NewString()
+	}
+	return g.IDSource.NewID()
+}
+
+// timestamp returns the meta.time value of a new event.
+func (g Generator) timestamp() int64 {
+	if g.Clock == nil {
+		return time.Now().UnixMilli()
+	}
+	return g.Clock.Now().UnixMilli()
+}
+
+// WithDeterministicID sets the meta.id field of a newly created event to
+// a name-based UUID (version 5) derived from the namespace, the event type,
+// and the keys. Events of the same type created with the same keys will get
+// the same ID, so by choosing keys that identify what the event describes
+// (e.g. the purl of an artifact for ArtifactCreated) a retried job will
+// re-emit an event with the same ID rather than a second event.
+//
+// The namespace should be unique to the application or organization.
+// The keys are combined in an unambiguous way, i.e. ("ab", "c") and
+// ("a", "bc") give different IDs.
+func WithDeterministicID(namespace uuid.UUID, keys ...string) Modifier {
+	return func(fieldSetter FieldSetter) error {
+		name := make([]string, 0, len(keys)+1)
+		if mt, ok := fieldSetter.(MetaTeller); ok {
+			name = append(name, mt.Type())
+		}
+		name = append(name, keys...)
+		nameJSON, err := json.Marshal(name)
+		if err != nil {
+			return fmt.Errorf("error encoding ID keys: %w", err)
+		}
+		return fieldSetter.SetField("meta.id", uuid.NewSHA1(namespace, nameJSON).String())
+	}
+}
+
+// WithContentDerivedID sets the meta.id field of an event to a name-based
+// UUID (version 5) derived from the namespace and the event's canonical JSON
+// representation, excluding the meta.id, meta.time, and meta.security fields
+// (see Canonicalize and ExcludeVolatileMeta). Events with the same contents
+// will therefore get the same ID regardless of when they're created.
+//
+// Since the ID depends on the contents of the event the modifier must be
+// applied after the event has been fully populated, e.g. as the last
+// modifier passed to a builder or by calling the modifier directly:
+//
+//	event, err := eiffelevents.NewArtifactCreatedV3()
+//	...
+//	event.Data.Identity = "pkg:generic/my-artifact@1.0"
+//	err = eiffelevents.WithContentDerivedID(namespace)(event)
+func WithContentDerivedID(namespace uuid.UUID) Modifier {
+	return func(fieldSetter FieldSetter) error {
+		event, ok := fieldSetter.(json.Marshaler)
+		if !ok {
+			return fmt.Errorf("unable to derive ID from contents of %T since it can't be marshaled", fieldSetter)
+		}
+		canonical, err := Canonicalize(event, ExcludeVolatileMeta())
+		if err != nil {
+			return fmt.Errorf("error deriving ID from event contents: %w", err)
+		}
+		return fieldSetter.SetField("meta.id", uuid.NewSHA1(namespace, canonical).String())
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNamespace = uuid.MustParse("aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeeee")
+
+func TestGenerator(t *testing.T) {
+	counter := 0
+	ids := IDSourceFunc(func() string {
+		counter++
+		return fmt.Sprintf("aaaaaaaa-bbbb-5ccc-8ddd-%012d", counter)
+	})
+	clock := ClockFunc(func() time.Time {
+		return time.UnixMilli(1234567890)
+	})
+	factory := NewFactory(WithIDSource(ids), WithClock(clock))
+
+	event1, err := factory.NewCompositionDefinedV3()
+	require.NoError(t, err)
+	event2, err := factory.BuildCompositionDefinedV3().Name("c").Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "aaaaaaaa-bbbb-5ccc-8ddd-000000000001", event1.Meta.ID)
+	assert.Equal(t, "aaaaaaaa-bbbb-5ccc-8ddd-000000000002", event2.Meta.ID)
+	assert.Equal(t, int64(1234567890), event1.Meta.Time)
+	assert.Equal(t, int64(1234567890), event2.Meta.Time)
+
+	// The zero value gives random UUIDs and the current time.
+	var generator Generator
+	_, err = uuid.Parse(generator.newID())
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Now().UnixMilli(), generator.timestamp(), 60000)
+}
+
+func TestWithDeterministicID(t *testing.T) {
+	newID := func(t *testing.T, factory func(...Modifier) (string, error), keys ...string) string {
+		id, err := factory(WithDeterministicID(testNamespace, keys...))
+		require.NoError(t, err)
+		return id
+	}
+	artC := func(modifiers ...Modifier) (string, error) {
+		event, err := NewArtifactCreatedV3(modifiers...)
+		if err != nil {
+			return "", err
+		}
+		return event.ID(), nil
+	}
+	artP := func(modifiers ...Modifier) (string, error) {
+		event, err := NewArtifactPublishedV3(modifiers...)
+		if err != nil {
+			return "", err
+		}
+		return event.ID(), nil
+	}
+
+	id := newID(t, artC, "pkg:generic/a@1")
+	assert.Equal(t, id, newID(t, artC, "pkg:generic/a@1"), "Same keys should give the same ID")
+	assert.NotEqual(t, id, newID(t, artC, "pkg:generic/a@2"), "Different keys should give different IDs")
+	assert.NotEqual(t, id, newID(t, artP, "pkg:generic/a@1"), "Different event types should give different IDs")
+	assert.NotEqual(t, newID(t, artC, "ab", "c"), newID(t, artC, "a", "bc"), "Keys should be combined unambiguously")
+
+	parsed, err := uuid.Parse(id)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(5), parsed.Version())
+}
+
+func TestWithContentDerivedID(t *testing.T) {
+	newEvent := func(name string, timestamp int64) *CompositionDefinedV3 {
+		factory := NewFactory(WithClock(ClockFunc(func() time.Time { return time.UnixMilli(timestamp) })))
+		event, err := factory.BuildCompositionDefinedV3().
+			Name(name).
+			With(WithContentDerivedID(testNamespace)).
+			Build()
+		require.NoError(t, err)
+		return event
+	}
+
+	event1 := newEvent("my-composition", 1000)
+	event2 := newEvent("my-composition", 2000)
+	event3 := newEvent("other-composition", 1000)
+	assert.Equal(t, event1.ID(), event2.ID())
+	assert.NotEqual(t, event1.ID(), event3.ID())
+	assert.NoError(t, event1.Validate())
+}
+
+func TestNewSequentialIDSource(t *testing.T) {
+	source1 := NewSequentialIDSource(testNamespace)
+	source2 := NewSequentialIDSource(testNamespace)
+
+	first := source1.NewID()
+	second := source1.NewID()
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, first, source2.NewID())
+	assert.Equal(t, second, source2.NewID())
+
+	parsed, err := uuid.Parse(first)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(5), parsed.Version())
+}
@@ -0,0 +1,163 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// builderSetter describes a method of an event builder that sets a field
+// somewhere below the event's data field.
+type builderSetter struct {
+	MethodName string
+	FieldExpr  string // The Go expression that selects the field, relative to the event, e.g. Data.Outcome.Verdict.
+	JSONPath   string // The dot-separated JSON path to the field, e.g. data.outcome.verdict.
+	ParamType  string // The type of the parameter, e.g. string or ...string for variadic slice parameters.
+	Required   bool   // Is the field mandatory, i.e. is it and all its parents required?
+	Tracked    bool   // Is the field a mandatory number or boolean whose zero value can't be told apart from a missing value?
+	path       []string
+}
+
+// builderLinkMethod describes the methods of an event builder that add
+// links of a particular type.
+type builderLinkMethod struct {
+	MethodName string
+	ConstName  string
+	LinkType   string
+	Required   bool
+	Multiple   bool
+}
+
+// reservedBuilderMethods are the names of the builder methods
+// that aren't derived from fields or link types.
+var reservedBuilderMethods = map[string]bool{
+	"Build": true,
+	"With":  true,
+}
+
+// builderSetters returns a setter for each leaf field below the data field
+// of an event. A leaf is any field that isn't a struct declared in the same
+// file, i.e. slices of structs are considered leaves. The method names
+// are the Go field names, prefixed with the names of the parent fields
+// if needed to make them unique.
+func builderSetters(data *goStructField) []*builderSetter {
+	var setters []*builderSetter
+	collectBuilderSetters(data, nil, nil, true, &setters)
+
+	// Each setter starts with just the field name as the method name.
+	// Setters whose names clash get another parent field name prepended
+	// until there are no more clashes.
+	depth := make([]int, len(setters))
+	for i := range setters {
+		depth[i] = 1
+	}
+	for {
+		names := map[string][]int{}
+		for i, s := range setters {
+			s.MethodName = strings.Join(s.path[len(s.path)-depth[i]:], "")
+			names[s.MethodName] = append(names[s.MethodName], i)
+		}
+		changed := false
+		for _, indexes := range names {
+			if len(indexes) < 2 {
+				continue
+			}
+			for _, i := range indexes {
+				if depth[i] < len(setters[i].path) {
+					depth[i]++
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	for _, s := range setters {
+		if reservedBuilderMethods[s.MethodName] {
+			s.MethodName = "Set" + s.MethodName
+		}
+	}
+	sort.Slice(setters, func(i int, j int) bool {
+		return setters[i].JSONPath < setters[j].JSONPath
+	})
+	return setters
+}
+
+func collectBuilderSetters(field *goStructField, goPath []string, jsonPath []string, required bool, result *[]*builderSetter) {
+	goPath = append(goPath[:len(goPath):len(goPath)], field.Name)
+	jsonPath = append(jsonPath[:len(jsonPath):len(jsonPath)], field.JSONField)
+	required = required && field.Required
+	if s, ok := field.Type.(*goStruct); ok {
+		for _, member := range s.Fields {
+			collectBuilderSetters(member, goPath, jsonPath, required, result)
+		}
+		return
+	}
+	paramType := field.Type.String()
+	if slice, ok := field.Type.(*goSlice); ok {
+		paramType = "..." + slice.Type.String()
+	}
+	// Setters of mandatory numbers and booleans record that they've been
+	// called so that the builder can report such fields as missing even
+	// though they'd be marshaled. Fields whose parents are optional need
+	// this too since they're mandatory as soon as the parent is populated.
+	primitive, isPrimitive := field.Type.(*goPrimitiveType)
+	tracked := field.Required && isPrimitive && primitive.name != "string"
+	*result = append(*result, &builderSetter{
+		FieldExpr: strings.Join(goPath, "."),
+		JSONPath:  strings.Join(jsonPath, "."),
+		ParamType: paramType,
+		Required:  required,
+		Tracked:   tracked,
+		// Leave out the data field itself from the path used to form method names.
+		path: goPath[1:],
+	})
+}
+
+// builderLinkMethods returns the link methods of an event builder given
+// the event's link definitions. Methods are named after the link type,
+// but are prefixed with "Link" if that would clash with another method.
+func builderLinkMethods(linkDefs map[string]linkDefinition, setters []*builderSetter) []*builderLinkMethod {
+	taken := map[string]bool{}
+	for name := range reservedBuilderMethods {
+		taken[name] = true
+	}
+	for _, s := range setters {
+		taken[s.MethodName] = true
+	}
+
+	methods := make([]*builderLinkMethod, 0, len(linkDefs))
+	for linkType, def := range linkDefs {
+		name := stringToEnum(linkType)
+		if taken[name] || taken[name+"ByID"] {
+			name = "Link" + name
+		}
+		methods = append(methods, &builderLinkMethod{
+			MethodName: name,
+			ConstName:  linkTypeConstName(linkType),
+			LinkType:   linkType,
+			Required:   def.Required,
+			Multiple:   def.Multiple,
+		})
+	}
+	sort.Slice(methods, func(i int, j int) bool {
+		return methods[i].MethodName < methods[j].MethodName
+	})
+	return methods
+}
@@ -0,0 +1,125 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilderSetters(t *testing.T) {
+	str := &goPrimitiveType{name: "string"}
+	gitID := &goStruct{
+		Name: "GitIdentifier",
+		Fields: []*goStructField{
+			{Name: "Branch", JSONField: "branch", Type: str},
+			{Name: "CommitID", JSONField: "commitId", Type: str, Required: true},
+		},
+	}
+	svnID := &goStruct{
+		Name: "SvnIdentifier",
+		Fields: []*goStructField{
+			{Name: "Branch", JSONField: "branch", Type: str},
+			{Name: "Revision", JSONField: "revision", Type: &goPrimitiveType{name: "int64"}, Required: true},
+		},
+	}
+	data := &goStructField{
+		Name:      "Data",
+		JSONField: "data",
+		Required:  true,
+		Type: &goStruct{
+			Name: "Data",
+			Fields: []*goStructField{
+				{Name: "Build", JSONField: "build", Type: str},
+				{Name: "GitIdentifier", JSONField: "gitIdentifier", Type: gitID},
+				{Name: "Names", JSONField: "names", Type: &goSlice{Type: str}, Required: true},
+				{Name: "SvnIdentifier", JSONField: "svnIdentifier", Type: svnID, Required: true},
+			},
+		},
+	}
+
+	setters := builderSetters(data)
+	actual := map[string]builderSetter{}
+	for _, s := range setters {
+		actual[s.JSONPath] = builderSetter{
+			MethodName: s.MethodName,
+			FieldExpr:  s.FieldExpr,
+			JSONPath:   s.JSONPath,
+			ParamType:  s.ParamType,
+			Required:   s.Required,
+			Tracked:    s.Tracked,
+		}
+	}
+	assert.Equal(t, map[string]builderSetter{
+		"data.build": {
+			MethodName: "SetBuild",
+			FieldExpr:  "Data.Build",
+			JSONPath:   "data.build",
+			ParamType:  "string",
+		},
+		"data.gitIdentifier.branch": {
+			MethodName: "GitIdentifierBranch",
+			FieldExpr:  "Data.GitIdentifier.Branch",
+			JSONPath:   "data.gitIdentifier.branch",
+			ParamType:  "string",
+		},
+		"data.gitIdentifier.commitId": {
+			// Not mandatory since the parent isn't.
+			MethodName: "CommitID",
+			FieldExpr:  "Data.GitIdentifier.CommitID",
+			JSONPath:   "data.gitIdentifier.commitId",
+			ParamType:  "string",
+		},
+		"data.names": {
+			MethodName: "Names",
+			FieldExpr:  "Data.Names",
+			JSONPath:   "data.names",
+			ParamType:  "...string",
+			Required:   true,
+		},
+		"data.svnIdentifier.branch": {
+			MethodName: "SvnIdentifierBranch",
+			FieldExpr:  "Data.SvnIdentifier.Branch",
+			JSONPath:   "data.svnIdentifier.branch",
+			ParamType:  "string",
+		},
+		"data.svnIdentifier.revision": {
+			MethodName: "Revision",
+			FieldExpr:  "Data.SvnIdentifier.Revision",
+			JSONPath:   "data.svnIdentifier.revision",
+			ParamType:  "int64",
+			Required:   true,
+			Tracked:    true,
+		},
+	}, actual)
+}
+
+func TestBuilderLinkMethods(t *testing.T) {
+	setters := []*builderSetter{{MethodName: "Context"}}
+	linkDefs := map[string]linkDefinition{
+		"CAUSE":        {Multiple: true},
+		"CONTEXT":      {},
+		"FLOW_CONTEXT": {Required: true},
+	}
+	methods := builderLinkMethods(linkDefs, setters)
+	assert.Equal(t, []*builderLinkMethod{
+		{MethodName: "Cause", ConstName: "LinkType_Cause", LinkType: "CAUSE", Multiple: true},
+		{MethodName: "FlowContext", ConstName: "LinkType_FlowContext", LinkType: "FLOW_CONTEXT", Required: true},
+		{MethodName: "LinkContext", ConstName: "LinkType_Context", LinkType: "CONTEXT"},
+	}, methods)
+}
@@ -0,0 +1,257 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The methods in this file generate the Go code that goes into the
+// appendJSON, isEmptyJSON, and decodeJSON methods of each struct type.
+// The generated code relies on helper functions in the eiffelevents
+// package (see jsoncodec.go) and must produce exactly the same output as
+// github.com/clarketm/json would for the same struct.
+//
+// All code snippets operate on an addressable expression (expr). If the
+// expression is a pointer dereference, e.g. "*elem", the dereference is
+// dropped when the address of the value is needed.
+
+// addressOf returns an expression that evaluates to the address of expr.
+func addressOf(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return expr[1:]
+	}
+	return "&" + expr
+}
+
+// receiver returns an expression suitable as the receiver
+// of a method call with a pointer receiver.
+func receiver(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return expr[1:]
+	}
+	return expr
+}
+
+// appendWithErrorCheck returns a statement that assigns the results of
+// an append function call returning ([]byte, error) and returns any error.
+func appendWithErrorCheck(call string) string {
+	return fmt.Sprintf("if b, err = %s; err != nil {\nreturn nil, err\n}", call)
+}
+
+// emptyCheck returns either the comparison expr==zero or expr!=zero.
+func emptyCheck(expr string, zero string, empty bool) string {
+	if empty {
+		return fmt.Sprintf("%s == %s", expr, zero)
+	}
+	return fmt.Sprintf("%s != %s", expr, zero)
+}
+
+// jsonKeyLiteral returns a Go string literal with the JSON encoding of
+// an object key, preceded by a comma and followed by a colon.
+func jsonKeyLiteral(name string) string {
+	key, err := json.Marshal(name)
+	if err != nil {
+		// Marshaling a string never fails.
+		panic(err)
+	}
+	literal := "," + string(key) + ":"
+	if strings.Contains(literal, "`") {
+		return strconv.Quote(literal)
+	}
+	return "`" + literal + "`"
+}
+
+func (t *goPrimitiveType) helperSuffix() string {
+	switch t.name {
+	case "int64":
+		return "Int"
+	case "bool":
+		return "Bool"
+	}
+	return "String"
+}
+
+func (t *goPrimitiveType) encodeJSON(expr string) string {
+	return fmt.Sprintf("b = appendJSON%s(b, %s)", t.helperSuffix(), expr)
+}
+
+func (t *goPrimitiveType) emptyJSON(expr string, empty bool) string {
+	switch t.name {
+	case "int64":
+		return emptyCheck(expr, "0", empty)
+	case "bool":
+		if empty {
+			return "!" + expr
+		}
+		return expr
+	}
+	return emptyCheck(expr, `""`, empty)
+}
+
+func (t *goPrimitiveType) decodeJSON(expr string, value string) string {
+	return fmt.Sprintf("decodeJSON%s(%s, %s, %q)", t.helperSuffix(), value, addressOf(expr), t.reflectTypeName())
+}
+
+func (t *goPrimitiveType) reflectTypeName() string {
+	return t.name
+}
+
+func (t *goInterface) encodeJSON(expr string) string {
+	return appendWithErrorCheck(fmt.Sprintf("appendJSONValue(b, %s)", expr))
+}
+
+func (t *goInterface) emptyJSON(expr string, empty bool) string {
+	return emptyCheck(expr, "nil", empty)
+}
+
+func (t *goInterface) decodeJSON(expr string, value string) string {
+	return fmt.Sprintf("decodeJSONValue(%s, %s)", value, addressOf(expr))
+}
+
+func (t *goInterface) reflectTypeName() string {
+	return "interface {}"
+}
+
+func (t *goEnum) encodeJSON(expr string) string {
+	return t.Type.encodeJSON(fmt.Sprintf("%s(%s)", t.Type, expr))
+}
+
+func (t *goEnum) emptyJSON(expr string, empty bool) string {
+	return t.Type.emptyJSON(expr, empty)
+}
+
+func (t *goEnum) decodeJSON(expr string, value string) string {
+	// Decode into the underlying type, but report errors using the enum type.
+	primitive, ok := t.Type.(*goPrimitiveType)
+	if !ok {
+		panic(fmt.Sprintf("enum %s has unsupported underlying type %s", t.Name, t.Type))
+	}
+	return fmt.Sprintf("decodeJSON%s(%s, (*%s)(%s), %q)",
+		primitive.helperSuffix(), value, t.Type, addressOf(expr), t.reflectTypeName())
+}
+
+func (t *goEnum) reflectTypeName() string {
+	return "eiffelevents." + t.Name
+}
+
+func (t *goStruct) encodeJSON(expr string) string {
+	return appendWithErrorCheck(fmt.Sprintf("%s.appendJSON(b)", receiver(expr)))
+}
+
+func (t *goStruct) emptyJSON(expr string, empty bool) string {
+	if empty {
+		return receiver(expr) + ".isEmptyJSON()"
+	}
+	return "!" + receiver(expr) + ".isEmptyJSON()"
+}
+
+func (t *goStruct) decodeJSON(expr string, value string) string {
+	return fmt.Sprintf("%s.decodeJSON(%s, opts)", receiver(expr), value)
+}
+
+func (t *goStruct) reflectTypeName() string {
+	return "eiffelevents." + t.Name
+}
+
+func (t *goPredeclaredType) encodeJSON(expr string) string {
+	return appendWithErrorCheck(fmt.Sprintf("%s.appendJSON(b)", receiver(expr)))
+}
+
+func (t *goPredeclaredType) emptyJSON(expr string, empty bool) string {
+	if empty {
+		return receiver(expr) + ".isEmptyJSON()"
+	}
+	return "!" + receiver(expr) + ".isEmptyJSON()"
+}
+
+func (t *goPredeclaredType) decodeJSON(expr string, value string) string {
+	return fmt.Sprintf("%s.decodeJSON(%s, opts)", receiver(expr), value)
+}
+
+func (t *goPredeclaredType) reflectTypeName() string {
+	return "eiffelevents." + t.TypeName
+}
+
+func (t *goSlice) encodeJSON(expr string) string {
+	return appendWithErrorCheck(sliceAppendCall(expr, t.Type))
+}
+
+func (t *goSlice) emptyJSON(expr string, empty bool) string {
+	return emptyCheck(fmt.Sprintf("len(%s)", expr), "0", empty)
+}
+
+func (t *goSlice) decodeJSON(expr string, value string) string {
+	return sliceDecodeCall(expr, value, t.Type, t.reflectTypeName())
+}
+
+func (t *goSlice) reflectTypeName() string {
+	return "[]" + t.Type.reflectTypeName()
+}
+
+func (t *goLinkSlice) encodeJSON(expr string) string {
+	// Unlike other slices, a nil links slice is encoded as an empty array.
+	return fmt.Sprintf("if %s == nil {\nb = append(b, `[]`...)\n} else %s",
+		expr, appendWithErrorCheck(sliceAppendCall(expr, t.SlicedType)))
+}
+
+func (t *goLinkSlice) emptyJSON(expr string, empty bool) string {
+	return emptyCheck(fmt.Sprintf("len(%s)", expr), "0", empty)
+}
+
+func (t *goLinkSlice) decodeJSON(expr string, value string) string {
+	return sliceDecodeCall(expr, value, t.SlicedType, t.reflectTypeName())
+}
+
+func (t *goLinkSlice) reflectTypeName() string {
+	return "eiffelevents." + t.TypeName
+}
+
+// structMethod returns a method expression for a method of a struct type,
+// or an empty string if the type isn't a struct type.
+func structMethod(t goType, method string) string {
+	switch t.(type) {
+	case *goStruct, *goPredeclaredType:
+		return fmt.Sprintf("(*%s).%s", t, method)
+	}
+	return ""
+}
+
+// sliceAppendCall returns a call to appendJSONArray that encodes
+// the slice in expr.
+func sliceAppendCall(expr string, elemType goType) string {
+	appendElem := structMethod(elemType, "appendJSON")
+	if appendElem == "" {
+		appendElem = fmt.Sprintf("func(elem *%s, b []byte) (_ []byte, err error) {\n%s\nreturn b, nil\n}",
+			elemType, elemType.encodeJSON("*elem"))
+	}
+	return fmt.Sprintf("appendJSONArray(b, %s, %s)", expr, appendElem)
+}
+
+// sliceDecodeCall returns a call to decodeJSONArray that decodes
+// the JSON array in value into the slice in expr.
+func sliceDecodeCall(expr string, value string, elemType goType, typeName string) string {
+	decodeElem := structMethod(elemType, "decodeJSON")
+	if decodeElem == "" {
+		decodeElem = fmt.Sprintf("func(elem *%s, value gjson.Result, opts decodeOptions) error {\nreturn %s\n}",
+			elemType, elemType.decodeJSON("*elem", "value"))
+	}
+	return fmt.Sprintf("decodeJSONArray(%s, %s, %q, opts, %s)", value, addressOf(expr), typeName, decodeElem)
+}
@@ -0,0 +1,93 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONKeyLiteral(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"plain", "`,\"plain\":`"},
+		{"<html>", "`,\"\\u003chtml\\u003e\":`"},
+		{"back`tick", `",\"back` + "`" + `tick\":"`},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, jsonKeyLiteral(tc.input))
+		})
+	}
+}
+
+func TestCodecSnippets(t *testing.T) {
+	str := &goPrimitiveType{name: "string"}
+	enum := &goEnum{Name: "MyEnum", Type: str}
+	strct := &goStruct{Name: "MyStruct"}
+	testcases := []struct {
+		name           string
+		typ            goType
+		expr           string
+		expectedEncode string
+		expectedEmpty  string
+		expectedDecode string
+	}{
+		{
+			name:           "String field",
+			typ:            str,
+			expr:           "s.Name",
+			expectedEncode: "b = appendJSONString(b, s.Name)",
+			expectedEmpty:  `s.Name == ""`,
+			expectedDecode: `decodeJSONString(v, &s.Name, "string")`,
+		},
+		{
+			name:           "Enum slice element",
+			typ:            enum,
+			expr:           "*elem",
+			expectedEncode: "b = appendJSONString(b, string(*elem))",
+			expectedEmpty:  `*elem == ""`,
+			expectedDecode: `decodeJSONString(v, (*string)(elem), "eiffelevents.MyEnum")`,
+		},
+		{
+			name:           "Struct field",
+			typ:            strct,
+			expr:           "s.Data",
+			expectedEncode: "if b, err = s.Data.appendJSON(b); err != nil {\nreturn nil, err\n}",
+			expectedEmpty:  "s.Data.isEmptyJSON()",
+			expectedDecode: "s.Data.decodeJSON(v, opts)",
+		},
+		{
+			name:           "Slice of structs",
+			typ:            &goSlice{Type: strct},
+			expr:           "s.Items",
+			expectedEncode: "if b, err = appendJSONArray(b, s.Items, (*MyStruct).appendJSON); err != nil {\nreturn nil, err\n}",
+			expectedEmpty:  "len(s.Items) == 0",
+			expectedDecode: `decodeJSONArray(v, &s.Items, "[]eiffelevents.MyStruct", opts, (*MyStruct).decodeJSON)`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedEncode, tc.typ.encodeJSON(tc.expr))
+			assert.Equal(t, tc.expectedEmpty, tc.typ.emptyJSON(tc.expr, true))
+			assert.Equal(t, tc.expectedDecode, tc.typ.decodeJSON(tc.expr, "v"))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+)
+
+// The methods in this file generate the Go code that goes into the
+// deepCopy and equal methods of each struct type. The generated code
+// relies on helper functions in the eiffelevents package (see deepcopy.go).
+
+func (t *goPrimitiveType) deepCopy(dst string, src string) string {
+	return ""
+}
+
+func (t *goPrimitiveType) equal(a string, b string) string {
+	return fmt.Sprintf("%s == %s", a, b)
+}
+
+func (t *goInterface) deepCopy(dst string, src string) string {
+	return fmt.Sprintf("%s = deepCopyValue(%s)", dst, src)
+}
+
+func (t *goInterface) equal(a string, b string) string {
+	return fmt.Sprintf("equalValues(%s, %s)", a, b)
+}
+
+func (t *goEnum) deepCopy(dst string, src string) string {
+	return ""
+}
+
+func (t *goEnum) equal(a string, b string) string {
+	return fmt.Sprintf("%s == %s", a, b)
+}
+
+func (t *goStruct) deepCopy(dst string, src string) string {
+	return fmt.Sprintf("%s = %s.deepCopy()", dst, receiver(src))
+}
+
+func (t *goStruct) equal(a string, b string) string {
+	return fmt.Sprintf("%s.equal(%s)", receiver(a), addressOf(b))
+}
+
+func (t *goPredeclaredType) deepCopy(dst string, src string) string {
+	return fmt.Sprintf("%s = %s.deepCopy()", dst, receiver(src))
+}
+
+func (t *goPredeclaredType) equal(a string, b string) string {
+	return fmt.Sprintf("%s.equal(%s)", receiver(a), addressOf(b))
+}
+
+func (t *goSlice) deepCopy(dst string, src string) string {
+	return fmt.Sprintf("%s = %s", dst, sliceDeepCopyCall(src, t.Type))
+}
+
+func (t *goSlice) equal(a string, b string) string {
+	return sliceEqualCall(a, b, t.Type)
+}
+
+func (t *goLinkSlice) deepCopy(dst string, src string) string {
+	return fmt.Sprintf("%s = %s", dst, sliceDeepCopyCall(src, t.SlicedType))
+}
+
+func (t *goLinkSlice) equal(a string, b string) string {
+	return sliceEqualCall(a, b, t.SlicedType)
+}
+
+// sliceDeepCopyCall returns a call to deepCopySlice that copies
+// the slice in expr.
+func sliceDeepCopyCall(expr string, elemType goType) string {
+	copyElem := structMethod(elemType, "deepCopy")
+	if copyElem == "" {
+		if elemCopy := elemType.deepCopy("c", "*elem"); elemCopy != "" {
+			copyElem = fmt.Sprintf("func(elem *%s) %s {\nc := *elem\n%s\nreturn c\n}", elemType, elemType, elemCopy)
+		} else {
+			// Assigning the elements is enough.
+			copyElem = "nil"
+		}
+	}
+	return fmt.Sprintf("deepCopySlice(%s, %s)", expr, copyElem)
+}
+
+// sliceEqualCall returns a call to equalSlices that compares
+// the slices in a and b.
+func sliceEqualCall(a string, b string, elemType goType) string {
+	equalElem := structMethod(elemType, "equal")
+	if equalElem == "" {
+		equalElem = fmt.Sprintf("func(a *%s, b *%s) bool {\nreturn %s\n}", elemType, elemType, elemType.equal("*a", "*b"))
+	}
+	return fmt.Sprintf("equalSlices(%s, %s, %s)", a, b, equalElem)
+}
@@ -0,0 +1,78 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepCopyAndEqualSnippets(t *testing.T) {
+	str := &goPrimitiveType{name: "string"}
+	strct := &goStruct{Name: "MyStruct"}
+	testcases := []struct {
+		name          string
+		typ           goType
+		expectedCopy  string
+		expectedEqual string
+	}{
+		{
+			name:          "String",
+			typ:           str,
+			expectedCopy:  "",
+			expectedEqual: "s.F == other.F",
+		},
+		{
+			name:          "Interface",
+			typ:           &goInterface{},
+			expectedCopy:  "c.F = deepCopyValue(s.F)",
+			expectedEqual: "equalValues(s.F, other.F)",
+		},
+		{
+			name:          "Struct",
+			typ:           strct,
+			expectedCopy:  "c.F = s.F.deepCopy()",
+			expectedEqual: "s.F.equal(&other.F)",
+		},
+		{
+			name:          "Slice of strings",
+			typ:           &goSlice{Type: str},
+			expectedCopy:  "c.F = deepCopySlice(s.F, nil)",
+			expectedEqual: "equalSlices(s.F, other.F, func(a *string, b *string) bool {\nreturn *a == *b\n})",
+		},
+		{
+			name:          "Slice of structs",
+			typ:           &goSlice{Type: strct},
+			expectedCopy:  "c.F = deepCopySlice(s.F, (*MyStruct).deepCopy)",
+			expectedEqual: "equalSlices(s.F, other.F, (*MyStruct).equal)",
+		},
+		{
+			name:          "Slice of interfaces",
+			typ:           &goSlice{Type: &goInterface{}},
+			expectedCopy:  "c.F = deepCopySlice(s.F, func(elem *interface{}) interface{} {\nc := *elem\nc = deepCopyValue(*elem)\nreturn c\n})",
+			expectedEqual: "equalSlices(s.F, other.F, func(a *interface{}, b *interface{}) bool {\nreturn equalValues(*a, *b)\n})",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			field := &goStructField{Name: "F", Type: tc.typ}
+			assert.Equal(t, tc.expectedCopy, field.DeepCopy())
+			assert.Equal(t, tc.expectedEqual, field.Equal())
+		})
+	}
+}
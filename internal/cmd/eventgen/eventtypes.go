@@ -33,9 +33,39 @@ import (
 
 // goType represents a Go type that's capable of formatting itself
 // as a string that can be included in a variable declaration or
-// struct field definition.
+// struct field definition, and of generating code for encoding
+// and decoding values of the type to and from JSON.
 type goType interface {
 	fmt.Stringer
+
+	// encodeJSON returns statements that append the JSON encoding of
+	// expr to the byte slice b, returning any errors.
+	encodeJSON(expr string) string
+
+	// emptyJSON returns a boolean expression that checks whether expr is
+	// empty (or non-empty) in the sense of the omitempty struct tag option.
+	emptyJSON(expr string, empty bool) string
+
+	// decodeJSON returns an expression that decodes the gjson.Result
+	// in value into expr and evaluates to an error.
+	decodeJSON(expr string, value string) string
+
+	// reflectTypeName returns the name of the type as formatted by
+	// reflect.Type.String, for use in error messages.
+	reflectTypeName() string
+
+	// validateJSON returns statements that check that expr adheres to
+	// the schema constraints in c and the constraints of the type itself,
+	// reporting problems at the JSON pointer that pointer evaluates to.
+	validateJSON(expr string, pointer string, c valueConstraints) string
+
+	// deepCopy returns statements that replace any memory that dst,
+	// a shallow copy of src, shares with src. Returns an empty string
+	// if a shallow copy is enough.
+	deepCopy(dst string, src string) string
+
+	// equal returns a boolean expression that's true if a equals b.
+	equal(a string, b string) string
 }
 
 // goTypeDeclarer represents a Go type that needs to declare itself in
@@ -18,6 +18,7 @@ package main
 
 import (
 	_ "embed"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -29,6 +30,7 @@ import (
 type MajorEventVersion struct {
 	StructName    string
 	LatestVersion string
+	KnownVersions []string
 	latest        *semver.Version
 }
 
@@ -56,13 +58,30 @@ func generateEventTypeTable(schemas map[string][]schemaDefinitionRenderer, outpu
 			major := int(schema.Version().Major())
 			if current, exists := table[schema.TypeName()][major]; !exists || current.latest.LessThan(schema.Version()) {
 				table[schema.TypeName()][major] = MajorEventVersion{
-					eiffelevents.VersionedStructName(schema.TypeName(), schema.Version()),
-					schema.Version().String(),
-					schema.Version(),
+					StructName:    eiffelevents.VersionedStructName(schema.TypeName(), schema.Version()),
+					LatestVersion: schema.Version().String(),
+					latest:        schema.Version(),
 				}
 			}
 		}
 	}
+
+	// Record all versions represented by each struct type. Each experimental
+	// version has its own struct type so only the latest one is included.
+	for _, eventSchemas := range schemas {
+		sortedSchemas := append([]schemaDefinitionRenderer(nil), eventSchemas...)
+		sort.Slice(sortedSchemas, func(i, j int) bool {
+			return sortedSchemas[i].Version().LessThan(sortedSchemas[j].Version())
+		})
+		for _, schema := range sortedSchemas {
+			majorVersion, exists := table[schema.TypeName()][int(schema.Version().Major())]
+			if !exists || (schema.Version().Major() == 0 && !schema.Version().Equal(majorVersion.latest)) {
+				continue
+			}
+			majorVersion.KnownVersions = append(majorVersion.KnownVersions, schema.Version().String())
+			table[schema.TypeName()][int(schema.Version().Major())] = majorVersion
+		}
+	}
 	output := codetemplate.New(outputFile)
 	if err := output.ExpandTemplate(eventTableFileTemplate, table, template.FuncMap{}); err != nil {
 		return err
@@ -0,0 +1,147 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	_ "embed"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/eiffel-community/eiffelevents-sdk-go/internal/codetemplate"
+)
+
+// linkDefinition represents a single link type in the _links section
+// of an event definition file.
+type linkDefinition struct {
+	Required bool `yaml:"required"`
+	Multiple bool `yaml:"multiple"`
+	Targets  struct {
+		AnyType bool     `yaml:"any_type"`
+		Types   []string `yaml:"types"`
+	} `yaml:"targets"`
+}
+
+// linkRule is the template-friendly representation of a linkDefinition.
+type linkRule struct {
+	ConstName     string
+	Required      bool
+	Multiple      bool
+	AnyTargetType bool
+	TargetTypes   []string
+}
+
+// linkTypeConst represents a generated constant for a link type.
+type linkTypeConst struct {
+	ConstName string
+	Value     string
+}
+
+// readLinkDefinitions reads the _links section of an event definition file.
+// Returns a nil map if the file doesn't have such a section.
+func readLinkDefinitions(filename string) (map[string]linkDefinition, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var def struct {
+		Links map[string]linkDefinition `yaml:"_links"`
+	}
+	if err := yaml.NewDecoder(f).Decode(&def); err != nil {
+		return nil, err
+	}
+	return def.Links, nil
+}
+
+// linkTypeConstName returns the name of the constant generated for a link type,
+// e.g. LinkType_FlowContext for FLOW_CONTEXT.
+func linkTypeConstName(linkType string) string {
+	return "LinkType_" + stringToEnum(linkType)
+}
+
+//go:embed templates/linkruletable.tmpl
+var linkRuleTableFileTemplate string
+
+// generateLinkRuleTable generates a Go source file with constants for all
+// link types found in the event definitions and a variable that maps each
+// event type and version to the rules for the links that such an event may
+// carry, i.e. which link types are allowed, whether they're required or may
+// occur multiple times, and which event types they may point to.
+func generateLinkRuleTable(schemas map[string][]schemaDefinitionRenderer, outputFile string) error {
+	table := make(map[string]map[string][]linkRule)
+	linkTypes := make(map[string]struct{})
+	for typeName, typeSchemas := range schemas {
+		if !strings.HasSuffix(typeName, "Event") {
+			continue
+		}
+		for _, schema := range typeSchemas {
+			linkDefs, err := readLinkDefinitions(schema.Filename())
+			if err != nil {
+				return err
+			}
+			if linkDefs == nil {
+				continue
+			}
+
+			rules := make([]linkRule, 0, len(linkDefs))
+			for linkType, def := range linkDefs {
+				linkTypes[linkType] = struct{}{}
+				rules = append(rules, linkRule{
+					ConstName:     linkTypeConstName(linkType),
+					Required:      def.Required,
+					Multiple:      def.Multiple,
+					AnyTargetType: def.Targets.AnyType,
+					TargetTypes:   def.Targets.Types,
+				})
+			}
+			sort.Slice(rules, func(i int, j int) bool {
+				return rules[i].ConstName < rules[j].ConstName
+			})
+
+			if table[typeName] == nil {
+				table[typeName] = make(map[string][]linkRule)
+			}
+			table[typeName][schema.Version().String()] = rules
+		}
+	}
+
+	consts := make([]linkTypeConst, 0, len(linkTypes))
+	for linkType := range linkTypes {
+		consts = append(consts, linkTypeConst{linkTypeConstName(linkType), linkType})
+	}
+	sort.Slice(consts, func(i int, j int) bool {
+		return consts[i].ConstName < consts[j].ConstName
+	})
+
+	data := struct {
+		LinkTypes []linkTypeConst
+		Table     map[string]map[string][]linkRule
+	}{
+		LinkTypes: consts,
+		Table:     table,
+	}
+	output := codetemplate.New(outputFile)
+	if err := output.ExpandTemplate(linkRuleTableFileTemplate, data, template.FuncMap{}); err != nil {
+		return err
+	}
+	return output.Close()
+}
@@ -0,0 +1,90 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLinkDefinitions(t *testing.T) {
+	testcases := []struct {
+		name     string
+		contents string
+		expected map[string]linkDefinition
+	}{
+		{
+			name:     "No _links section",
+			contents: "type: object\n",
+			expected: nil,
+		},
+		{
+			name: "Links with and without target types",
+			contents: `
+type: object
+_links:
+  ACTIVITY_EXECUTION:
+    description: The activity execution.
+    required: true
+    multiple: false
+    targets:
+      any_type: false
+      types:
+        - EiffelActivityTriggeredEvent
+  CAUSE:
+    required: false
+    multiple: true
+    targets:
+      any_type: true
+      types: []
+`,
+			expected: map[string]linkDefinition{
+				"ACTIVITY_EXECUTION": func() linkDefinition {
+					var def linkDefinition
+					def.Required = true
+					def.Targets.Types = []string{"EiffelActivityTriggeredEvent"}
+					return def
+				}(),
+				"CAUSE": func() linkDefinition {
+					var def linkDefinition
+					def.Multiple = true
+					def.Targets.AnyType = true
+					def.Targets.Types = []string{}
+					return def
+				}(),
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "1.0.0.yml")
+			require.NoError(t, os.WriteFile(filename, []byte(tc.contents), 0600))
+			defs, err := readLinkDefinitions(filename)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, defs)
+		})
+	}
+}
+
+func TestLinkTypeConstName(t *testing.T) {
+	assert.Equal(t, "LinkType_Cause", linkTypeConstName("CAUSE"))
+	assert.Equal(t, "LinkType_FlowContext", linkTypeConstName("FLOW_CONTEXT"))
+}
@@ -36,4 +36,7 @@ func main() {
 	if err := generateEventTypeTable(schemas, "eventtypetable.go"); err != nil {
 		log.Fatalf("%s: %s", filepath.Base(os.Args[0]), err)
 	}
+	if err := generateLinkRuleTable(schemas, "linkruletable.go"); err != nil {
+		log.Fatalf("%s: %s", filepath.Base(os.Args[0]), err)
+	}
 }
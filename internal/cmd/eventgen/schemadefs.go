@@ -63,6 +63,9 @@ func (edf *definitionFile) Version() *semver.Version {
 //go:embed templates/eventfile.tmpl
 var eventFileTemplate string
 
+//go:embed templates/builder.tmpl
+var builderTemplate string
+
 // eventTypeAbbrevMap maps event type name to their abbreviations. For now
 // the contents of this map is created by running
 //
@@ -143,6 +146,8 @@ func (edf *eventDefinitionFile) Render(schema io.Reader, outputFile string) erro
 	if err != nil {
 		return err
 	}
+	rootStruct.IsEvent = true
+	rootStruct.PreservesUnknownFields = true
 
 	// Populate eventMeta.SupportsSigning by checking which version of
 	// the meta field that introduced the second-generation signing
@@ -170,12 +175,51 @@ func (edf *eventDefinitionFile) Render(schema io.Reader, outputFile string) erro
 	if err := ct.ExpandTemplate(eventFileTemplate, eventMeta, funcs); err != nil {
 		return err
 	}
+	if err := edf.renderBuilder(ct, eventMeta.StructName, rootStruct); err != nil {
+		return err
+	}
 	if err := rootStruct.declare(ct); err != nil {
 		return err
 	}
 	return ct.Close()
 }
 
+// renderBuilder expands the template that declares the builder type
+// for the event, with setters for the data fields and methods for adding
+// the link types allowed by the event definition.
+func (edf *eventDefinitionFile) renderBuilder(ct *codetemplate.OutputFile, structName string, rootStruct *goStruct) error {
+	var setters []*builderSetter
+	for _, member := range rootStruct.Fields {
+		if member.JSONField == "data" {
+			setters = builderSetters(member)
+		}
+	}
+	linkDefs, err := readLinkDefinitions(edf.filename)
+	if err != nil {
+		return err
+	}
+	hasTrackedSetters := false
+	for _, s := range setters {
+		hasTrackedSetters = hasTrackedSetters || s.Tracked
+	}
+	builderMeta := struct {
+		EventType         string
+		StructName        string
+		Version           *semver.Version
+		Setters           []*builderSetter
+		HasTrackedSetters bool // Does any setter record that it has been called?
+		LinkMethods       []*builderLinkMethod
+	}{
+		EventType:         edf.typeName,
+		StructName:        structName,
+		Version:           edf.version,
+		Setters:           setters,
+		HasTrackedSetters: hasTrackedSetters,
+		LinkMethods:       builderLinkMethods(linkDefs, setters),
+	}
+	return ct.ExpandTemplate(builderTemplate, builderMeta, template.FuncMap{})
+}
+
 //go:embed templates/linkfile.tmpl
 var linkStructFileTemplate string
 
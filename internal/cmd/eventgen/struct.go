@@ -18,6 +18,7 @@ package main
 
 import (
 	_ "embed"
+	"fmt"
 	"sort"
 	"strings"
 	"text/template"
@@ -29,12 +30,14 @@ import (
 
 // goStruct represents a struct Go type, including its fields.
 type goStruct struct {
-	Name              string
-	SubTypeNamePrefix string // The prefix of any sub type of this struct, i.e. any nested object.
-	JSONField         string // The struct's name in the JSON schema, as given in the parent object.
-	Fields            []*goStructField
-	required          []string // A list of required properties.
-	parent            *goStruct
+	Name                   string
+	SubTypeNamePrefix      string // The prefix of any sub type of this struct, i.e. any nested object.
+	JSONField              string // The struct's name in the JSON schema, as given in the parent object.
+	Fields                 []*goStructField
+	PreservesUnknownFields bool     // Should the struct have a field for unknown fields?
+	IsEvent                bool     // Does the struct represent an event? If so, all fields are always encoded.
+	required               []string // A list of required properties.
+	parent                 *goStruct
 }
 
 // newEventStruct creates a top-level goStruct, i.e. one that represents an event.
@@ -105,10 +108,11 @@ func (t *goStruct) populate(members map[string]*jsschema.Schema) error {
 		}
 
 		member := &goStructField{
-			Name:      fieldTitle(name),
-			Type:      typ,
-			JSONField: name,
-			Required:  required,
+			Name:        fieldTitle(name),
+			Type:        typ,
+			JSONField:   name,
+			Required:    required,
+			constraints: newValueConstraints(def),
 		}
 		t.Fields = append(t.Fields, member)
 	}
@@ -127,12 +131,83 @@ func (t *goStruct) String() string {
 	return t.Name
 }
 
+// ReflectTypeName returns the name of the struct type as formatted
+// by reflect.Type.String.
+func (t *goStruct) ReflectTypeName() string {
+	return t.reflectTypeName()
+}
+
 // goStructField represents a single field within a Go struct.
 type goStructField struct {
-	Name      string
-	Type      goType
-	JSONField string
-	Required  bool
+	Name        string
+	Type        goType
+	JSONField   string
+	Required    bool
+	constraints valueConstraints
+}
+
+// JSONKey returns a Go string literal with the field's JSON object key,
+// for use in the struct's appendJSON method.
+func (f *goStructField) JSONKey() string {
+	return jsonKeyLiteral(f.JSONField)
+}
+
+// EncodeJSON returns code that appends the field's JSON encoding to b.
+func (f *goStructField) EncodeJSON() string {
+	return f.Type.encodeJSON("s." + f.Name)
+}
+
+// IsEmptyJSON returns an expression that's true if the field
+// is empty in the sense of the omitempty struct tag option.
+func (f *goStructField) IsEmptyJSON() string {
+	return f.Type.emptyJSON("s."+f.Name, true)
+}
+
+// IsNonEmptyJSON returns the negation of IsEmptyJSON.
+func (f *goStructField) IsNonEmptyJSON() string {
+	return f.Type.emptyJSON("s."+f.Name, false)
+}
+
+// DecodeJSON returns an expression that decodes the gjson.Result
+// in the member variable into the field.
+func (f *goStructField) DecodeJSON() string {
+	return f.Type.decodeJSON("s."+f.Name, "member")
+}
+
+// DeepCopy returns code that makes the field of the struct copy c
+// independent of the original struct s.
+func (f *goStructField) DeepCopy() string {
+	return f.Type.deepCopy("c."+f.Name, "s."+f.Name)
+}
+
+// Equal returns an expression that's true if the field has the same value
+// in the struct s and the struct other.
+func (f *goStructField) Equal() string {
+	return f.Type.equal("s."+f.Name, "other."+f.Name)
+}
+
+// ValidateJSON returns code that checks that the field adheres to the
+// constraints in the schema. Optional fields are only checked if they're
+// non-empty, i.e. if they're included in the JSON encoding.
+func (f *goStructField) ValidateJSON() string {
+	expr := "s." + f.Name
+	pointer := jsonPointerExpr("pointer", f.JSONField)
+	check := f.Type.validateJSON(expr, pointer, f.constraints)
+	if !f.Required {
+		if check == "" {
+			return ""
+		}
+		return fmt.Sprintf("if %s {\n%s\n}", f.Type.emptyJSON(expr, false), check)
+	}
+	missing := missingCheck(f.Type, expr)
+	if missing == "" {
+		return check
+	}
+	stmt := fmt.Sprintf("if %s {\naddFieldProblem(problems, %s, \"mandatory field is missing\")\n}", missing, pointer)
+	if check != "" {
+		stmt += fmt.Sprintf(" else {\n%s\n}", check)
+	}
+	return stmt
 }
 
 // capitalizationExceptions is a table containing exceptions to the normal
@@ -0,0 +1,177 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsschema "github.com/lestrrat-go/jsschema"
+)
+
+// The methods in this file generate the Go code that goes into the
+// validate method of each struct type. Each snippet checks a value that
+// will be included in the JSON encoding of the struct, i.e. it's up to
+// the caller to skip empty optional fields. Problems are appended to
+// a problems variable of the type *[]FieldError, using the helper
+// functions in the eiffelevents package (see validate.go).
+
+// valueConstraints holds the constraints from a JSON schema that the
+// generated validation code checks, apart from the mandatory fields and
+// enums that are represented in the Go types themselves.
+type valueConstraints struct {
+	Pattern   string
+	Format    string
+	MinLength int // -1 if not set.
+	MaxLength int // -1 if not set.
+	MinItems  int // -1 if not set.
+	MaxItems  int // -1 if not set.
+	Items     *valueConstraints
+}
+
+// newValueConstraints extracts the constraints of a schema node.
+// For nodes that are JSON references the constraints are found in the
+// referenced schema and are checked by the referenced type.
+func newValueConstraints(schema *jsschema.Schema) valueConstraints {
+	c := valueConstraints{
+		Format:    string(schema.Format),
+		MinLength: optionalInt(schema.MinLength),
+		MaxLength: optionalInt(schema.MaxLength),
+		MinItems:  optionalInt(schema.MinItems),
+		MaxItems:  optionalInt(schema.MaxItems),
+	}
+	if schema.Pattern != nil {
+		c.Pattern = schema.Pattern.String()
+	}
+	if schema.Items != nil && len(schema.Items.Schemas) > 0 {
+		items := newValueConstraints(schema.Items.Schemas[0])
+		c.Items = &items
+	}
+	return c
+}
+
+func optionalInt(i jsschema.Integer) int {
+	if !i.Initialized {
+		return -1
+	}
+	return i.Val
+}
+
+// itemConstraints returns the constraints of the items of an array.
+func (c valueConstraints) itemConstraints() valueConstraints {
+	if c.Items == nil {
+		return valueConstraints{MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1}
+	}
+	return *c.Items
+}
+
+// jsonPointerExpr returns an expression that appends a reference token
+// to the JSON pointer in the pointer expression.
+func jsonPointerExpr(pointer string, token string) string {
+	token = strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	return fmt.Sprintf("%s + %s", pointer, strconv.Quote("/"+token))
+}
+
+// elementsLoop returns a loop that runs the validation code returned
+// by validateElem for each element of the slice in expr.
+func elementsLoop(expr string, pointer string, validateElem func(elemExpr string, elemPointer string) string) string {
+	check := validateElem("*elem", fmt.Sprintf("indexPointer(%s, i)", pointer))
+	if check == "" {
+		return ""
+	}
+	if strings.HasPrefix(expr, "*") {
+		expr = "(" + expr + ")"
+	}
+	return fmt.Sprintf("for i := range %s {\nelem := &%s[i]\n%s\n}", expr, expr, check)
+}
+
+func (t *goPrimitiveType) validateJSON(expr string, pointer string, c valueConstraints) string {
+	if t.name != "string" {
+		return ""
+	}
+	var checks []string
+	if c.Pattern != "" {
+		checks = append(checks, fmt.Sprintf("checkPattern(problems, %s, %s, %s)", pointer, expr, strconv.Quote(c.Pattern)))
+	}
+	if c.Format != "" {
+		checks = append(checks, fmt.Sprintf("checkFormat(problems, %s, %s, %q)", pointer, expr, c.Format))
+	}
+	if c.MinLength >= 0 || c.MaxLength >= 0 {
+		checks = append(checks, fmt.Sprintf("checkLength(problems, %s, %s, %d, %d)", pointer, expr, c.MinLength, c.MaxLength))
+	}
+	return strings.Join(checks, "\n")
+}
+
+func (t *goInterface) validateJSON(expr string, pointer string, c valueConstraints) string {
+	return ""
+}
+
+func (t *goEnum) validateJSON(expr string, pointer string, c valueConstraints) string {
+	return fmt.Sprintf("if !%s.IsValid() {\naddFieldProblem(problems, %s, \"%%q is not a valid %%s value\", %s(%s), %q)\n}",
+		receiver(expr), pointer, t.Type, expr, t.Name)
+}
+
+func (t *goStruct) validateJSON(expr string, pointer string, c valueConstraints) string {
+	return fmt.Sprintf("%s.validate(%s, problems)", receiver(expr), pointer)
+}
+
+func (t *goPredeclaredType) validateJSON(expr string, pointer string, c valueConstraints) string {
+	return fmt.Sprintf("%s.validate(%s, problems)", receiver(expr), pointer)
+}
+
+func (t *goSlice) validateJSON(expr string, pointer string, c valueConstraints) string {
+	var checks []string
+	if c.MinItems >= 0 || c.MaxItems >= 0 {
+		checks = append(checks, fmt.Sprintf("checkItemCount(problems, %s, len(%s), %d, %d)", pointer, expr, c.MinItems, c.MaxItems))
+	}
+	loop := elementsLoop(expr, pointer, func(elemExpr string, elemPointer string) string {
+		return t.Type.validateJSON(elemExpr, elemPointer, c.itemConstraints())
+	})
+	if loop != "" {
+		checks = append(checks, loop)
+	}
+	return strings.Join(checks, "\n")
+}
+
+func (t *goLinkSlice) validateJSON(expr string, pointer string, c valueConstraints) string {
+	return elementsLoop(expr, pointer, func(elemExpr string, elemPointer string) string {
+		return t.SlicedType.validateJSON(elemExpr, elemPointer, c.itemConstraints())
+	})
+}
+
+// missingCheck returns an expression that's true if a mandatory value
+// of the given type should be considered missing, or an empty string if
+// the type's zero value can't be distinguished from a deliberately set
+// value. Empty strings and enum values are considered missing, just like
+// when an event is built, and so are nil interface values and slices since
+// the field would've been absent when the event was unmarshaled.
+func missingCheck(t goType, expr string) string {
+	switch t := t.(type) {
+	case *goPrimitiveType:
+		if t.name == "string" {
+			return expr + ` == ""`
+		}
+	case *goEnum:
+		return expr + ` == ""`
+	case *goInterface:
+		return expr + " == nil"
+	case *goSlice:
+		return expr + " == nil"
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	jsschema "github.com/lestrrat-go/jsschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValueConstraints(t *testing.T) {
+	schema := jsschema.New()
+	schema.Pattern = regexp.MustCompile("^pkg:")
+	schema.Format = "uri"
+	schema.MinLength = jsschema.Integer{Val: 1, Initialized: true}
+	schema.Items = &jsschema.ItemSpec{Schemas: jsschema.SchemaList{jsschema.New()}}
+	schema.Items.Schemas[0].MaxLength = jsschema.Integer{Val: 10, Initialized: true}
+
+	assert.Equal(t, valueConstraints{
+		Pattern:   "^pkg:",
+		Format:    "uri",
+		MinLength: 1,
+		MaxLength: -1,
+		MinItems:  -1,
+		MaxItems:  -1,
+		Items: &valueConstraints{
+			MinLength: -1,
+			MaxLength: 10,
+			MinItems:  -1,
+			MaxItems:  -1,
+		},
+	}, newValueConstraints(schema))
+}
+
+func TestFieldValidation(t *testing.T) {
+	str := &goPrimitiveType{name: "string"}
+	noConstraints := valueConstraints{MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1}
+	testcases := []struct {
+		name     string
+		field    goStructField
+		expected string
+	}{
+		{
+			name:     "Optional string without constraints",
+			field:    goStructField{Name: "Name", JSONField: "name", Type: str, constraints: noConstraints},
+			expected: "",
+		},
+		{
+			name:     "Mandatory string without constraints",
+			field:    goStructField{Name: "Name", JSONField: "name", Type: str, Required: true, constraints: noConstraints},
+			expected: "if s.Name == \"\" {\naddFieldProblem(problems, pointer + \"/name\", \"mandatory field is missing\")\n}",
+		},
+		{
+			name: "Mandatory string with minimum length",
+			field: goStructField{Name: "Name", JSONField: "name", Type: str, Required: true, constraints: valueConstraints{
+				MinLength: 1, MaxLength: -1, MinItems: -1, MaxItems: -1,
+			}},
+			expected: "if s.Name == \"\" {\naddFieldProblem(problems, pointer + \"/name\", \"mandatory field is missing\")\n} else {\n" +
+				"checkLength(problems, pointer + \"/name\", s.Name, 1, -1)\n}",
+		},
+		{
+			name: "Mandatory string with pattern rejecting empty string",
+			field: goStructField{Name: "ID", JSONField: "id", Type: str, Required: true, constraints: valueConstraints{
+				Pattern: "^[0-9a-f]+$", MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1,
+			}},
+			expected: "if s.ID == \"\" {\naddFieldProblem(problems, pointer + \"/id\", \"mandatory field is missing\")\n} else {\n" +
+				"checkPattern(problems, pointer + \"/id\", s.ID, \"^[0-9a-f]+$\")\n}",
+		},
+		{
+			name: "Mandatory string with pattern accepting empty string",
+			field: goStructField{Name: "ID", JSONField: "id", Type: str, Required: true, constraints: valueConstraints{
+				Pattern: "^[0-9a-f]*$", MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1,
+			}},
+			expected: "if s.ID == \"\" {\naddFieldProblem(problems, pointer + \"/id\", \"mandatory field is missing\")\n} else {\n" +
+				"checkPattern(problems, pointer + \"/id\", s.ID, \"^[0-9a-f]*$\")\n}",
+		},
+		{
+			name: "Mandatory string with format",
+			field: goStructField{Name: "URI", JSONField: "uri", Type: str, Required: true, constraints: valueConstraints{
+				Format: "uri", MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1,
+			}},
+			expected: "if s.URI == \"\" {\naddFieldProblem(problems, pointer + \"/uri\", \"mandatory field is missing\")\n} else {\n" +
+				"checkFormat(problems, pointer + \"/uri\", s.URI, \"uri\")\n}",
+		},
+		{
+			name: "Optional string with pattern and format",
+			field: goStructField{Name: "URI", JSONField: "uri", Type: str, constraints: valueConstraints{
+				Pattern: "^https:", Format: "uri", MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1,
+			}},
+			expected: "if s.URI != \"\" {\n" +
+				"checkPattern(problems, pointer + \"/uri\", s.URI, \"^https:\")\n" +
+				"checkFormat(problems, pointer + \"/uri\", s.URI, \"uri\")\n}",
+		},
+		{
+			name: "Mandatory slice of strings with item count and item pattern",
+			field: goStructField{Name: "Tags", JSONField: "tags", Type: &goSlice{Type: str}, Required: true, constraints: valueConstraints{
+				MinLength: -1, MaxLength: -1, MinItems: 1, MaxItems: -1,
+				Items: &valueConstraints{Pattern: "^[a-z]+$", MinLength: -1, MaxLength: -1, MinItems: -1, MaxItems: -1},
+			}},
+			expected: "if s.Tags == nil {\naddFieldProblem(problems, pointer + \"/tags\", \"mandatory field is missing\")\n} else {\n" +
+				"checkItemCount(problems, pointer + \"/tags\", len(s.Tags), 1, -1)\n" +
+				"for i := range s.Tags {\nelem := &s.Tags[i]\n" +
+				"checkPattern(problems, indexPointer(pointer + \"/tags\", i), *elem, \"^[a-z]+$\")\n}\n}",
+		},
+		{
+			name:     "Mandatory interface",
+			field:    goStructField{Name: "Value", JSONField: "value", Type: &goInterface{}, Required: true, constraints: noConstraints},
+			expected: "if s.Value == nil {\naddFieldProblem(problems, pointer + \"/value\", \"mandatory field is missing\")\n}",
+		},
+		{
+			name:     "Mandatory struct",
+			field:    goStructField{Name: "Outcome", JSONField: "outcome", Type: &goStruct{Name: "Outcome"}, Required: true, constraints: noConstraints},
+			expected: "s.Outcome.validate(pointer + \"/outcome\", problems)",
+		},
+		{
+			name:     "Optional enum",
+			field:    goStructField{Name: "Verdict", JSONField: "verdict", Type: &goEnum{Name: "Verdict", Type: str}, constraints: noConstraints},
+			expected: "if s.Verdict != \"\" {\nif !s.Verdict.IsValid() {\naddFieldProblem(problems, pointer + \"/verdict\", \"%q is not a valid %s value\", string(s.Verdict), \"Verdict\")\n}\n}",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.field.ValidateJSON())
+		})
+	}
+}
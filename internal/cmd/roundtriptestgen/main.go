@@ -39,48 +39,68 @@ type tableEntry struct {
 //go:embed templates/roundtriptable.tmpl
 var tableFileTemplate string
 
-func generateExampleTable(exampleDir string, output *codetemplate.OutputFile) error {
+//go:embed templates/roundtripbenchmark.tmpl
+var benchmarkFileTemplate string
+
+// readExamples returns a table entry for each example event file
+// found in the given directory.
+func readExamples(exampleDir string) ([]tableEntry, error) {
 	filenames, err := filepath.Glob(filepath.Join(exampleDir, "*/*.json"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var table []tableEntry
 	for _, filename := range filenames {
 		eventExample, err := os.ReadFile(filename)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Extract the event type and version from the JSON payload.
 		schemaSelectors := gjson.GetManyBytes(eventExample, "meta.type", "meta.version")
 		metaType := schemaSelectors[0].String()
 		if metaType == "" {
-			return fmt.Errorf("unable to extract meta.type from input file %s", filename)
+			return nil, fmt.Errorf("unable to extract meta.type from input file %s", filename)
 		}
 		metaVersion := schemaSelectors[1].String()
 		if metaVersion == "" {
-			return fmt.Errorf("unable to extract meta.version from input file %s", filename)
+			return nil, fmt.Errorf("unable to extract meta.version from input file %s", filename)
 		}
 		v, err := semver.NewVersion(metaVersion)
 		if err != nil {
-			return fmt.Errorf("error parsing %q version: %w", metaVersion, err)
+			return nil, fmt.Errorf("error parsing %q version: %w", metaVersion, err)
 		}
 		table = append(table, tableEntry{filename, eiffelevents.VersionedStructName(metaType, v)})
 	}
+	return table, nil
+}
 
-	return output.ExpandTemplate(tableFileTemplate, table, template.FuncMap{})
+// generateFile expands a template with the example table as input
+// and writes the result to the named file.
+func generateFile(filename string, fileTemplate string, table []tableEntry) error {
+	output := codetemplate.New(filename)
+	if err := output.ExpandTemplate(fileTemplate, table, template.FuncMap{}); err != nil {
+		return fmt.Errorf("error generating code: %w", err)
+	}
+	if err := output.Close(); err != nil {
+		return fmt.Errorf("error writing final output file: %w", err)
+	}
+	return nil
 }
 
 func main() {
-	if len(os.Args) < 3 {
-		log.Fatalf("Usage: %s EXAMPLE_DIR OUTPUT_FILE", filepath.Base(os.Args[0]))
+	if len(os.Args) < 4 {
+		log.Fatalf("Usage: %s EXAMPLE_DIR TABLE_FILE BENCHMARK_FILE", filepath.Base(os.Args[0]))
+	}
+	table, err := readExamples(os.Args[1])
+	if err != nil {
+		log.Fatalf("Error reading examples: %s", err)
 	}
-	output := codetemplate.New(os.Args[2])
-	if err := generateExampleTable(os.Args[1], output); err != nil {
-		log.Fatalf("Error generating code: %s", err)
+	if err := generateFile(os.Args[2], tableFileTemplate, table); err != nil {
+		log.Fatal(err)
 	}
-	if err := output.Close(); err != nil {
-		log.Fatalf("Error writing final output file: %s", err)
+	if err := generateFile(os.Args[3], benchmarkFileTemplate, table); err != nil {
+		log.Fatal(err)
 	}
 }
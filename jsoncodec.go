@@ -0,0 +1,277 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/clarketm/json"
+	"github.com/tidwall/gjson"
+)
+
+// This file contains the building blocks of the generated appendJSON and
+// decodeJSON methods that every struct type has. Together they allow events
+// to be marshaled and unmarshaled without the reflection that
+// encoding/json and github.com/clarketm/json use, while producing
+// exactly the same output.
+
+// jsonDecoder is implemented by all generated struct types.
+type jsonDecoder interface {
+	// decodeJSON populates the struct from a JSON value. Like with
+	// encoding/json, null values are ignored and struct fields without
+	// a corresponding object member are left untouched. Object keys are
+	// matched to struct fields case-insensitively if there's no exact match.
+	decodeJSON(value gjson.Result, opts decodeOptions) error
+}
+
+// decodeOptions controls the behavior of the generated decodeJSON methods.
+type decodeOptions struct {
+	// unknownFields collects the object members that don't correspond
+	// to any struct field. If nil, such members are ignored.
+	unknownFields *unknownFieldCollector
+}
+
+const jsonHexDigits = "0123456789abcdef"
+
+// appendJSONString appends the JSON encoding of a string to b. The escaping
+// is identical to that of encoding/json with HTML escaping enabled.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '\\', '"':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', jsonHexDigits[c>>4], jsonHexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid in JSON strings but not in
+		// JavaScript, so encoding/json always escapes them.
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', jsonHexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+func appendJSONInt(b []byte, i int64) []byte {
+	return strconv.AppendInt(b, i, 10)
+}
+
+func appendJSONBool(b []byte, v bool) []byte {
+	return strconv.AppendBool(b, v)
+}
+
+// appendJSONValue appends the JSON encoding of an arbitrary value to b.
+// This is used for fields whose type isn't known at compile time,
+// so reflection can't be avoided.
+func appendJSONValue(b []byte, v interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, encoded...), nil
+}
+
+// appendJSONArray appends the JSON encoding of a slice to b, using
+// appendElem to encode each element. A nil slice is encoded as null.
+func appendJSONArray[T any](b []byte, s []T, appendElem func(elem *T, b []byte) ([]byte, error)) ([]byte, error) {
+	if s == nil {
+		return append(b, "null"...), nil
+	}
+	b = append(b, '[')
+	for i := range s {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		var err error
+		if b, err = appendElem(&s[i], b); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// newJSONTypeError returns an error describing that a JSON value couldn't
+// be stored in a value of the given type. The message mimics that of
+// encoding/json.UnmarshalTypeError. The JSON pointer in the returned error
+// is empty and gets populated by the callers as the error bubbles up.
+func newJSONTypeError(value gjson.Result, typeName string) error {
+	return newJSONTypeErrorWithKind(jsonValueKind(value), typeName)
+}
+
+func newJSONTypeErrorWithKind(kind string, typeName string) error {
+	return &FieldError{Message: fmt.Sprintf("cannot unmarshal JSON %s into value of type %s", kind, typeName)}
+}
+
+// jsonValueKind returns the kind of a JSON value in the same terms
+// as encoding/json uses in its error messages.
+func jsonValueKind(value gjson.Result) string {
+	switch value.Type {
+	case gjson.String:
+		return "string"
+	case gjson.Number:
+		return "number"
+	case gjson.True, gjson.False:
+		return "bool"
+	case gjson.Null:
+		return "null"
+	case gjson.JSON:
+		if value.IsArray() {
+			return "array"
+		}
+	}
+	return "object"
+}
+
+// prependJSONPointerToken adds a reference token to the beginning
+// of the JSON pointer of a *FieldError.
+func prependJSONPointerToken(err error, token string) error {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		fieldErr.Pointer = "/" + escapeJSONPointerToken(token) + fieldErr.Pointer
+	}
+	return err
+}
+
+func decodeJSONString(value gjson.Result, target *string, typeName string) error {
+	switch value.Type {
+	case gjson.Null:
+		return nil
+	case gjson.String:
+		*target = value.Str
+		return nil
+	}
+	return newJSONTypeError(value, typeName)
+}
+
+func decodeJSONInt(value gjson.Result, target *int64, typeName string) error {
+	switch value.Type {
+	case gjson.Null:
+		return nil
+	case gjson.Number:
+		i, err := strconv.ParseInt(value.Raw, 10, 64)
+		if err != nil {
+			return newJSONTypeErrorWithKind("number "+value.Raw, typeName)
+		}
+		*target = i
+		return nil
+	}
+	return newJSONTypeError(value, typeName)
+}
+
+func decodeJSONBool(value gjson.Result, target *bool, typeName string) error {
+	switch value.Type {
+	case gjson.Null:
+		return nil
+	case gjson.True, gjson.False:
+		*target = value.Bool()
+		return nil
+	}
+	return newJSONTypeError(value, typeName)
+}
+
+// decodeJSONValue decodes a JSON value of any kind into the same
+// Go representation that encoding/json would've used.
+func decodeJSONValue(value gjson.Result, target *interface{}) error {
+	*target = value.Value()
+	return nil
+}
+
+// foldJSONName returns the first of the given JSON field names that
+// differs from name but is equal to it under Unicode case-folding,
+// i.e. the field that encoding/json would pick for an object member
+// whose key doesn't match any field exactly.
+func foldJSONName(name string, fieldNames ...string) (string, bool) {
+	for _, fieldName := range fieldNames {
+		if fieldName != name && strings.EqualFold(fieldName, name) {
+			return fieldName, true
+		}
+	}
+	return "", false
+}
+
+// decodeJSONArray decodes a JSON array into a slice, using decodeElem to
+// decode each element. A null value results in a nil slice.
+func decodeJSONArray[S ~[]T, T any](value gjson.Result, target *S, typeName string, opts decodeOptions, decodeElem func(elem *T, value gjson.Result, opts decodeOptions) error) error {
+	if value.Type == gjson.Null {
+		*target = nil
+		return nil
+	}
+	if !value.IsArray() {
+		return newJSONTypeError(value, typeName)
+	}
+	// Count the elements first to avoid the allocation made by value.Array().
+	n := 0
+	value.ForEach(func(_ gjson.Result, _ gjson.Result) bool {
+		n++
+		return true
+	})
+	result := make(S, n)
+	var err error
+	i := 0
+	value.ForEach(func(_ gjson.Result, elem gjson.Result) bool {
+		opts.unknownFields.pushIndex(i)
+		err = decodeElem(&result[i], elem, opts)
+		opts.unknownFields.pop()
+		if err != nil {
+			err = prependJSONPointerToken(err, strconv.Itoa(i))
+			return false
+		}
+		i++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*target = result
+	return nil
+}
@@ -0,0 +1,332 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clarketm/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/sjson"
+)
+
+// referenceMarshal marshals an event the way MarshalJSON did before the
+// encoding code was generated, i.e. via reflection in github.com/clarketm/json.
+// It's used to verify that the generated code produces identical output.
+func referenceMarshal(event interface{}) ([]byte, error) {
+	v := reflect.ValueOf(event).Elem()
+	links := v.FieldByName("Links")
+	if links.IsNil() {
+		links = reflect.MakeSlice(links.Type(), 0, 0)
+	}
+	s := struct {
+		Data  interface{} `json:"data"`
+		Links interface{} `json:"links"`
+		Meta  interface{} `json:"meta"`
+	}{
+		Data:  v.FieldByName("Data").Addr().Interface(),
+		Links: links.Interface(),
+		Meta:  v.FieldByName("Meta").Addr().Interface(),
+	}
+	return json.Marshal(s)
+}
+
+// referenceUnmarshal unmarshals an event of the given type the way
+// UnmarshalAny did before the decoding code was generated, i.e. via
+// reflection in encoding/json. Each top-level field is unmarshaled
+// separately since the event type itself isn't free of generated code.
+func referenceUnmarshal(input []byte, structType reflect.Type) (interface{}, error) {
+	var members map[string]stdjson.RawMessage
+	if err := stdjson.Unmarshal(input, &members); err != nil {
+		return nil, err
+	}
+	value := reflect.New(structType)
+	for i := 0; i < structType.NumField(); i++ {
+		name, _ := jsonFieldName(structType.Field(i))
+		raw, ok := members[name]
+		if name == "" || !ok {
+			continue
+		}
+		if err := stdjson.Unmarshal(raw, value.Elem().Field(i).Addr().Interface()); err != nil {
+			var typeErr *stdjson.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				typeErr.Field = strings.TrimSuffix(name+"."+typeErr.Field, ".")
+			}
+			return nil, err
+		}
+	}
+	return value.Interface(), nil
+}
+
+// Values used when filling structs with random data. The strings
+// exercise the escaping rules of encoding/json.
+var (
+	randomStrings = []string{
+		"", "a", "hello world", `quote " and backslash \`, "<html> & co",
+		"tab\tnewline\ncr\r", "\x00\x1f\x7f", "åäö", "line para ", "invalid \xff utf-8",
+	}
+	randomInts   = []int64{0, 1, -1, 1234567890123, -9223372036854775808, 9223372036854775807}
+	randomValues = []interface{}{
+		nil, "string", 1.5, float64(42), -1e-7, true, false,
+		[]interface{}{}, []interface{}{"a", 2.0, nil},
+		map[string]interface{}{}, map[string]interface{}{"b": map[string]interface{}{"c": "<d>"}, "a": []interface{}{true}},
+	}
+)
+
+// fillRandom populates a value with random data. Roughly a quarter of all
+// fields and slices are left empty to exercise the omitempty handling.
+func fillRandom(v reflect.Value, r *rand.Rand) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() && r.Intn(4) > 0 {
+				fillRandom(v.Field(i), r)
+			}
+		}
+	case reflect.Slice:
+		switch n := r.Intn(5); n {
+		case 0:
+			v.Set(reflect.Zero(v.Type()))
+		default:
+			v.Set(reflect.MakeSlice(v.Type(), n-1, n-1))
+			for i := 0; i < v.Len(); i++ {
+				fillRandom(v.Index(i), r)
+			}
+		}
+	case reflect.String:
+		v.SetString(randomStrings[r.Intn(len(randomStrings))])
+	case reflect.Int64:
+		v.SetInt(randomInts[r.Intn(len(randomInts))])
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Interface:
+		if value := randomValues[r.Intn(len(randomValues))]; value != nil {
+			v.Set(reflect.ValueOf(value))
+		}
+	default:
+		panic(fmt.Sprintf("unsupported kind %s", v.Kind()))
+	}
+}
+
+// TestJSONCodecParity fills all event types with random data and checks
+// that the generated encoding and decoding code behaves exactly like
+// the reflection-based code in github.com/clarketm/json and encoding/json.
+func TestJSONCodecParity(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) // nolint:gosec
+	for eventType, majorVersions := range eventTypeTable {
+		for _, majorVersion := range majorVersions {
+			t.Run(majorVersion.structType.Name(), func(t *testing.T) {
+				for i := 0; i < 50; i++ {
+					event := majorVersion.newEvent()
+					v := reflect.ValueOf(event).Elem()
+					fillRandom(v, r)
+					v.FieldByName("Meta").FieldByName("Type").SetString(eventType)
+					v.FieldByName("Meta").FieldByName("Version").SetString(majorVersion.latestVersion)
+
+					expected, err := referenceMarshal(event)
+					require.NoError(t, err)
+					actual, err := json.Marshal(event)
+					require.NoError(t, err)
+					require.Equal(t, string(expected), string(actual))
+
+					expectedEvent, err := referenceUnmarshal(actual, majorVersion.structType)
+					require.NoError(t, err)
+					actualEvent, err := UnmarshalAny(actual)
+					require.NoError(t, err)
+					require.Equal(t, expectedEvent, actualEvent)
+				}
+			})
+		}
+	}
+}
+
+// TestJSONCodecCaseInsensitiveKeys checks that object keys that only
+// match struct fields case-insensitively are decoded like encoding/json
+// does, and that they aren't considered unknown fields.
+func TestJSONCodecCaseInsensitiveKeys(t *testing.T) {
+	testcases := []struct {
+		path  string
+		value string
+	}{
+		{"meta.ID", `"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"`},
+		{"meta.TAGS", `["a","b"]`},
+		{"meta.Source", `{"Name":"x","HOST":"y"}`},
+		{"meta.sEcUrItY", `{"authoridentity":"CN=a"}`},
+		{"data.Outcome.Description", `"text"`},
+		{"data.outcome.Metrics", `[{"NAME":"duration","Value":1}]`},
+		{"data.CustomData", `[{"Key":"k","VALUE":{"Inner":true}}]`},
+		{"links", `[{"Type":"CAUSE","TARGET":"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"}]`},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.path, func(t *testing.T) {
+			input, err := sjson.SetRaw(strictTestEvent, tc.path, tc.value)
+			require.NoError(t, err)
+
+			expected, err := referenceUnmarshal([]byte(input), reflect.TypeOf(TestCaseFinishedV3{}))
+			require.NoError(t, err)
+			actual, err := UnmarshalAny([]byte(input))
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+
+			// Members matched case-insensitively aren't unknown, so
+			// preserving unknown fields shouldn't make a difference.
+			preserved, err := UnmarshalAny([]byte(input), PreserveUnknownFields())
+			require.NoError(t, err)
+			assert.Equal(t, expected, preserved)
+		})
+	}
+}
+
+func TestJSONCodecDuplicateKeys(t *testing.T) {
+	// With several members matching the same field the last one wins,
+	// regardless of whether it's an exact match.
+	for _, input := range []string{
+		`{"meta":{"type":"EiffelTestCaseFinishedEvent","version":"3.3.0","id":"a","ID":"b"}}`,
+		`{"meta":{"type":"EiffelTestCaseFinishedEvent","version":"3.3.0","ID":"b","id":"a"}}`,
+	} {
+		expected, err := referenceUnmarshal([]byte(input), reflect.TypeOf(TestCaseFinishedV3{}))
+		require.NoError(t, err)
+		actual, err := UnmarshalAny([]byte(input))
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual, input)
+	}
+}
+
+func TestJSONCodecEmptyEvent(t *testing.T) {
+	event := &TestCaseFinishedV3{}
+	output, err := event.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"outcome":{"conclusion":"","verdict":""}},"links":[],"meta":{"id":"","time":0,"type":"","version":""}}`, string(output))
+
+	expected, err := referenceMarshal(event)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(output))
+}
+
+func TestJSONCodecTypeErrors(t *testing.T) {
+	testcases := []struct {
+		path  string
+		value string
+	}{
+		{"meta.time", `"yesterday"`},
+		{"meta.time", `1.5`},
+		{"meta.time", `1e30`},
+		{"meta.tags", `{"a":1}`},
+		{"meta.tags.1", `true`},
+		{"meta.source", `[]`},
+		{"links", `"none"`},
+		{"links.0", `null`},
+		{"links.0.target", `42`},
+		{"data", `false`},
+		{"data.outcome.verdict", `1`},
+		{"data.outcome.metrics.0.name", `{}`},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.path+"="+tc.value, func(t *testing.T) {
+			input, err := sjson.SetRaw(strictTestEvent, "meta.tags", `["a","b"]`)
+			require.NoError(t, err)
+			input, err = sjson.SetRaw(input, tc.path, tc.value)
+			require.NoError(t, err)
+
+			expected, expectedErr := referenceUnmarshal([]byte(input), reflect.TypeOf(TestCaseFinishedV3{}))
+			actual, actualErr := UnmarshalAny([]byte(input))
+
+			var typeErr *stdjson.UnmarshalTypeError
+			if !errors.As(expectedErr, &typeErr) {
+				// Some values, like null, aren't errors.
+				require.NoError(t, expectedErr)
+				require.NoError(t, actualErr)
+				assert.Equal(t, expected, actual)
+				return
+			}
+			require.ErrorIs(t, actualErr, ErrMalformedInput)
+			var fieldErr *FieldError
+			require.ErrorAs(t, actualErr, &fieldErr)
+			assert.Equal(t, fieldPathToJSONPointer(typeErr.Field), fieldErr.Pointer)
+			assert.Equal(t, fmt.Sprintf("cannot unmarshal JSON %s into value of type %s", typeErr.Value, typeErr.Type), fieldErr.Message)
+		})
+	}
+}
+
+func TestAppendJSONString(t *testing.T) {
+	var allBytes strings.Builder
+	for i := 0; i < 256; i++ {
+		allBytes.WriteByte(byte(i))
+	}
+	for _, s := range append(randomStrings, allBytes.String(), "å‧  ‪\U0001F600") {
+		expected, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(appendJSONString(nil, s)))
+	}
+}
+
+// benchmarkEvent returns a moderately sized event for use in benchmarks.
+func benchmarkEvent(b *testing.B) *TestCaseFinishedV3 {
+	b.Helper()
+	event, err := NewTestCaseFinishedV3(
+		WithSourceHost("host.example.com"),
+		WithSourceName("benchmark"),
+	)
+	require.NoError(b, err)
+	event.Meta.Tags = []string{"a", "b", "c"}
+	event.Data.Outcome.Conclusion = TCFV3DataOutcomeConclusion_Successful
+	event.Data.Outcome.Verdict = TCFV3DataOutcomeVerdict_Passed
+	event.Data.Outcome.Description = "The test case passed without <incident>."
+	event.Data.CustomData = []CustomDataV1{{Key: "k", Value: "v"}}
+	event.Data.PersistentLogs = []TCFV3DataPersistentLog{{Name: "log", URI: "https://example.com/log.txt"}}
+	event.AddLinkByID(LinkType_TestCaseExecution, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1")
+	return event
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	event := benchmarkEvent(b)
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = event.MarshalJSON()
+		}
+	})
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = referenceMarshal(event)
+		}
+	})
+}
+
+func BenchmarkUnmarshalAny(b *testing.B) {
+	input := []byte(benchmarkEvent(b).String())
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = UnmarshalAny(input)
+		}
+	})
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = referenceUnmarshal(input, reflect.TypeOf(TestCaseFinishedV3{}))
+		}
+	})
+}
@@ -21,9 +21,9 @@ package eiffelevents
 type LinkFinder interface {
 	// FindAll returns the IDs of all links of the specified type,
 	// or an empty slice if no such links are found.
-	FindAll(linkType string) []string
+	FindAll(linkType LinkType) []string
 
 	// FindFirst returns the ID of the first encountered link of the
 	// specified type, or an empty string if no such link is found.
-	FindFirst(linkType string) string
+	FindFirst(linkType LinkType) string
 }
@@ -0,0 +1,74 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"slices"
+)
+
+// LinkRule describes how a particular link type may be used by a particular
+// version of an event type, as declared in the event's definition.
+type LinkRule struct {
+	// Type is the link type, e.g. CAUSE. See the LinkType_* constants.
+	Type LinkType
+
+	// Required is true if an event must have at least one link of this type.
+	Required bool
+
+	// Multiple is true if an event may have more than one link of this type.
+	Multiple bool
+
+	// AnyTargetType is true if the link may point to an event of any type.
+	AnyTargetType bool
+
+	// TargetTypes lists the event types that the link may point to.
+	// Only relevant if AnyTargetType is false.
+	TargetTypes []string
+}
+
+// AllowsTarget returns true if a link of this type may point to an event
+// of the given type.
+func (lr LinkRule) AllowsTarget(eventType string) bool {
+	return lr.AnyTargetType || slices.Contains(lr.TargetTypes, eventType)
+}
+
+// LinkRules returns the rules for the link types that the given version
+// of an event type may carry, sorted by link type. Link types not included
+// in the result aren't allowed. The returned slice must not be modified.
+//
+// An ErrUnsupportedEvent error is returned if no rules are known for
+// the event type and version.
+func LinkRules(eventType string, version string) ([]LinkRule, error) {
+	rules, ok := linkRuleTable[eventType][version]
+	if !ok {
+		return nil, fmt.Errorf("%w: no link rules known for %s %s", ErrUnsupportedEvent, eventType, version)
+	}
+	return rules, nil
+}
+
+// FindLinkRule returns the rule for a particular link type in the given version
+// of an event type. The second return value is false if the link type isn't
+// allowed or if no rules are known for the event type and version.
+func FindLinkRule(eventType string, version string, linkType LinkType) (LinkRule, bool) {
+	for _, rule := range linkRuleTable[eventType][version] {
+		if rule.Type == linkType {
+			return rule, true
+		}
+	}
+	return LinkRule{}, false
+}
@@ -0,0 +1,74 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleLinkRules() {
+	rules, _ := LinkRules("EiffelTestCaseFinishedEvent", "3.3.0")
+	for _, rule := range rules {
+		if rule.Required {
+			fmt.Println(rule.Type)
+		}
+	}
+	// Output: TEST_CASE_EXECUTION
+}
+
+func TestLinkRules(t *testing.T) {
+	rules, err := LinkRules("EiffelActivityFinishedEvent", "3.3.0")
+	require.NoError(t, err)
+	require.NotEmpty(t, rules)
+	for i := 1; i < len(rules); i++ {
+		assert.Less(t, rules[i-1].Type, rules[i].Type, "rules should be sorted by link type")
+	}
+
+	_, err = LinkRules("EiffelActivityFinishedEvent", "99.0.0")
+	require.ErrorIs(t, err, ErrUnsupportedEvent)
+	_, err = LinkRules("EiffelBogusEvent", "1.0.0")
+	require.ErrorIs(t, err, ErrUnsupportedEvent)
+}
+
+func TestLinkRulesCoverAllEventTypes(t *testing.T) {
+	for eventType, majorVersions := range eventTypeTable {
+		for _, mev := range majorVersions {
+			_, err := LinkRules(eventType, mev.latestVersion)
+			assert.NoError(t, err, "%s %s", eventType, mev.latestVersion)
+		}
+	}
+}
+
+func TestFindLinkRule(t *testing.T) {
+	rule, ok := FindLinkRule("EiffelActivityFinishedEvent", "3.3.0", LinkType_ActivityExecution)
+	require.True(t, ok)
+	assert.True(t, rule.Required)
+	assert.False(t, rule.Multiple)
+	assert.True(t, rule.AllowsTarget("EiffelActivityTriggeredEvent"))
+	assert.False(t, rule.AllowsTarget("EiffelTestCaseTriggeredEvent"))
+
+	rule, ok = FindLinkRule("EiffelActivityFinishedEvent", "3.3.0", LinkType_Cause)
+	require.True(t, ok)
+	assert.True(t, rule.AllowsTarget("EiffelTestCaseTriggeredEvent"))
+
+	_, ok = FindLinkRule("EiffelActivityFinishedEvent", "3.3.0", LinkType_Iut)
+	assert.False(t, ok)
+}
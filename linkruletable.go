@@ -0,0 +1,1223 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by eventgen. DO NOT EDIT.
+
+package eiffelevents
+
+// LinkType is the type of a link from one Eiffel event to another, e.g. CAUSE.
+type LinkType string
+
+// Link types defined by at least one version of an Eiffel event type.
+const (
+	LinkType_ActivityExecution         LinkType = "ACTIVITY_EXECUTION"
+	LinkType_Artifact                  LinkType = "ARTIFACT"
+	LinkType_Base                      LinkType = "BASE"
+	LinkType_Cause                     LinkType = "CAUSE"
+	LinkType_Change                    LinkType = "CHANGE"
+	LinkType_Composition               LinkType = "COMPOSITION"
+	LinkType_Context                   LinkType = "CONTEXT"
+	LinkType_DeresolvedIssue           LinkType = "DERESOLVED_ISSUE"
+	LinkType_Element                   LinkType = "ELEMENT"
+	LinkType_Environment               LinkType = "ENVIRONMENT"
+	LinkType_FailedIssue               LinkType = "FAILED_ISSUE"
+	LinkType_FlowContext               LinkType = "FLOW_CONTEXT"
+	LinkType_InconclusiveIssue         LinkType = "INCONCLUSIVE_ISSUE"
+	LinkType_Iut                       LinkType = "IUT"
+	LinkType_ModifiedAnnouncement      LinkType = "MODIFIED_ANNOUNCEMENT"
+	LinkType_PartiallyResolvedIssue    LinkType = "PARTIALLY_RESOLVED_ISSUE"
+	LinkType_Precursor                 LinkType = "PRECURSOR"
+	LinkType_PreviousActivityExecution LinkType = "PREVIOUS_ACTIVITY_EXECUTION"
+	LinkType_PreviousVersion           LinkType = "PREVIOUS_VERSION"
+	LinkType_ResolvedIssue             LinkType = "RESOLVED_ISSUE"
+	LinkType_ReusedArtifact            LinkType = "REUSED_ARTIFACT"
+	LinkType_RuntimeEnvironment        LinkType = "RUNTIME_ENVIRONMENT"
+	LinkType_SubConfidenceLevel        LinkType = "SUB_CONFIDENCE_LEVEL"
+	LinkType_Subject                   LinkType = "SUBJECT"
+	LinkType_SuccessfulIssue           LinkType = "SUCCESSFUL_ISSUE"
+	LinkType_Terc                      LinkType = "TERC"
+	LinkType_TestCaseExecution         LinkType = "TEST_CASE_EXECUTION"
+	LinkType_TestSuiteExecution        LinkType = "TEST_SUITE_EXECUTION"
+	LinkType_VerificationBasis         LinkType = "VERIFICATION_BASIS"
+)
+
+// linkRuleTable maps each event type and version to the rules for the links
+// that such an event may carry, sorted by link type.
+var linkRuleTable = map[string]map[string][]LinkRule{
+	"EiffelActivityCanceledEvent": {
+		"1.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelActivityFinishedEvent": {
+		"1.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelActivityStartedEvent": {
+		"1.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"4.0.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"4.1.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"4.2.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"4.3.0": {
+			{Type: LinkType_ActivityExecution, Required: true, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousActivityExecution, TargetTypes: []string{"EiffelActivityTriggeredEvent"}},
+			{Type: LinkType_RuntimeEnvironment, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+	},
+	"EiffelActivityTriggeredEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelAnnouncementPublishedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ModifiedAnnouncement, TargetTypes: []string{"EiffelAnnouncementPublishedEvent"}},
+		},
+	},
+	"EiffelArtifactCreatedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+	},
+	"EiffelArtifactDeployedEvent": {
+		"0.1.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, Required: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelArtifactPublishedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Artifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelArtifactReusedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Composition, Required: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_ReusedArtifact, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent"}},
+		},
+	},
+	"EiffelCompositionDefinedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Element, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelCompositionDefinedEvent"}},
+		},
+	},
+	"EiffelConfidenceLevelModifiedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_SubConfidenceLevel, Multiple: true, TargetTypes: []string{"EiffelConfidenceLevelModifiedEvent"}},
+			{Type: LinkType_Subject, Required: true, Multiple: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent", "EiffelSourceChangeCreatedEvent", "EiffelSourceChangeSubmittedEvent"}},
+		},
+	},
+	"EiffelEnvironmentDefinedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+		},
+	},
+	"EiffelFlowContextDefinedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelIssueDefinedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelIssueVerifiedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"4.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"4.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"4.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+		"4.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FailedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_InconclusiveIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_SuccessfulIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_VerificationBasis, Required: true, Multiple: true, TargetTypes: []string{"EiffelTestCaseFinishedEvent", "EiffelTestSuiteFinishedEvent"}},
+		},
+	},
+	"EiffelSourceChangeCreatedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"4.0.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"4.1.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+		"4.2.0": {
+			{Type: LinkType_Base, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_DeresolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PartiallyResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_ResolvedIssue, Multiple: true, TargetTypes: []string{"EiffelIssueDefinedEvent"}},
+		},
+	},
+	"EiffelSourceChangeSubmittedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Change, TargetTypes: []string{"EiffelSourceChangeCreatedEvent"}},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_PreviousVersion, Multiple: true, TargetTypes: []string{"EiffelSourceChangeSubmittedEvent"}},
+		},
+	},
+	"EiffelTestCaseCanceledEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+	},
+	"EiffelTestCaseFinishedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"1.0.1": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+	},
+	"EiffelTestCaseStartedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestCaseExecution, Required: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+		},
+	},
+	"EiffelTestCaseTriggeredEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.4.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.5.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_Environment, TargetTypes: []string{"EiffelEnvironmentDefinedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Iut, Required: true, TargetTypes: []string{"EiffelArtifactCreatedEvent", "EiffelCompositionDefinedEvent"}},
+			{Type: LinkType_Precursor, Multiple: true, TargetTypes: []string{"EiffelTestCaseTriggeredEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+	},
+	"EiffelTestExecutionRecipeCollectionCreatedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"2.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.1.1": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+		"4.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+		},
+	},
+	"EiffelTestSuiteFinishedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_TestSuiteExecution, Required: true, TargetTypes: []string{"EiffelTestSuiteStartedEvent"}},
+		},
+	},
+	"EiffelTestSuiteStartedEvent": {
+		"1.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"1.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"2.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.0.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.1.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.2.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.3.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+		"3.4.0": {
+			{Type: LinkType_Cause, Multiple: true, AnyTargetType: true},
+			{Type: LinkType_Context, TargetTypes: []string{"EiffelActivityTriggeredEvent", "EiffelTestSuiteStartedEvent"}},
+			{Type: LinkType_FlowContext, Multiple: true, TargetTypes: []string{"EiffelFlowContextDefinedEvent"}},
+			{Type: LinkType_Terc, TargetTypes: []string{"EiffelTestExecutionRecipeCollectionCreatedEvent"}},
+		},
+	},
+}
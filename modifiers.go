@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path"
 	"runtime/debug"
+	"slices"
 
 	"github.com/Showmax/go-fqdn"
 	"github.com/package-url/packageurl-go"
@@ -37,6 +38,15 @@ import (
 // be passed to other parts of the application to reduce duplication.
 type Modifier func(fieldSetter FieldSetter) error
 
+// WithTags sets the meta.tags field of a newly created event. Each event
+// gets its own copy of the tags, so the modifier can be reused for many
+// events without modifications of one event's tags affecting another.
+func WithTags(tags ...string) Modifier {
+	return func(fieldSetter FieldSetter) error {
+		return fieldSetter.SetField("meta.tags", slices.Clone(tags))
+	}
+}
+
 // WithAutoSourceHost attempts to figure out the FQDN of the current host and
 // stores it in the meta.source.host field.
 // WithVersion sets the meta.version field of a newly created event.
@@ -96,6 +96,16 @@ func TestWithSourceURI(t *testing.T) {
 	assert.Equal(t, "http://www.example.com", event.Meta.Source.URI)
 }
 
+func TestWithTags(t *testing.T) {
+	modifier := WithTags("a", "b")
+	event1, err := NewCompositionDefinedV3(modifier)
+	require.NoError(t, err)
+	event1.Meta.Tags[0] = "c"
+	event2, err := NewCompositionDefinedV3(modifier)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, event2.Meta.Tags)
+}
+
 func TestWithVersion(t *testing.T) {
 	newestEventVersion, err := NewCompositionDefinedV3()
 	require.NoError(t, err)
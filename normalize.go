@@ -0,0 +1,68 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver"
+)
+
+// Normalize unmarshals a JSON event of any supported version and, if its major
+// version differs from the one given for its event type in the versions map,
+// converts it to that major version with Convert. The versions map is keyed by
+// event type (e.g. EiffelActivityTriggeredEvent) and typically describes
+// the event versions of an Eiffel edition. The edition packages have their own
+// Normalize functions that should be used instead of calling this function
+// directly.
+//
+// Events that don't need to be converted are returned as-is, i.e. without
+// adjusting their meta.version. Converted events get the most recent version
+// within the target major version. An ErrUnsupportedEvent error is returned
+// if the versions map doesn't contain the event type, and an
+// ErrLossyConversion error is returned if the conversion is a downgrade
+// that would drop information.
+func Normalize(input []byte, versions map[string]string) (interface{}, error) {
+	event, err := UnmarshalAny(input)
+	if err != nil {
+		return nil, err
+	}
+	mt := event.(MetaTeller) // nolint:forcetypeassert
+	targetVersionString, ok := versions[mt.Type()]
+	if !ok {
+		return nil, fmt.Errorf("%w: no target version given for %s", ErrUnsupportedEvent, mt.Type())
+	}
+	targetVersion, err := semver.NewVersion(targetVersionString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse target version %q of %s: %w", targetVersionString, mt.Type(), err)
+	}
+	version, err := semver.NewVersion(mt.Version())
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to parse meta.version: %s", ErrMalformedInput, err)
+	}
+
+	// Major version 0 events have one struct per version
+	// so they need to be converted unless the versions match.
+	if version.Major() == targetVersion.Major() && (version.Major() != 0 || version.Equal(targetVersion)) {
+		return event, nil
+	}
+	converted, _, err := Convert(event, targetVersionString)
+	if err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
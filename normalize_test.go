@@ -0,0 +1,80 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	versions := map[string]string{
+		"EiffelActivityTriggeredEvent": "4.0.0",
+		"EiffelArtifactDeployedEvent":  "0.1.0",
+	}
+	testcases := []struct {
+		name            string
+		input           string
+		expectedType    interface{}
+		expectedVersion string
+		errorIs         error
+	}{
+		{
+			name:            "Same major version is returned as-is",
+			input:           `{"meta": {"type": "EiffelActivityTriggeredEvent", "version": "4.1.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "time": 1234567890}, "data": {"name": "foo"}, "links": []}`,
+			expectedType:    &ActivityTriggeredV4{},
+			expectedVersion: "4.1.0",
+		},
+		{
+			name:            "Older major version is upgraded",
+			input:           `{"meta": {"type": "EiffelActivityTriggeredEvent", "version": "1.1.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "time": 1234567890}, "data": {"name": "foo"}, "links": []}`,
+			expectedType:    &ActivityTriggeredV4{},
+			expectedVersion: eventTypeTable["EiffelActivityTriggeredEvent"][4].latestVersion,
+		},
+		{
+			name:            "Major version 0 event",
+			input:           `{"meta": {"type": "EiffelArtifactDeployedEvent", "version": "0.1.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "time": 1234567890}, "data": {}, "links": []}`,
+			expectedType:    &ArtifactDeployedV0_1_0{},
+			expectedVersion: "0.1.0",
+		},
+		{
+			name:    "Event type not in versions map",
+			input:   `{"meta": {"type": "EiffelCompositionDefinedEvent", "version": "3.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "time": 1234567890}, "data": {"name": "foo"}, "links": []}`,
+			errorIs: ErrUnsupportedEvent,
+		},
+		{
+			name:    "Malformed input",
+			input:   `{"meta": {}`,
+			errorIs: ErrMalformedInput,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := Normalize([]byte(tc.input), versions)
+			if tc.errorIs != nil {
+				require.ErrorIs(t, err, tc.errorIs)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tc.expectedType, event)
+			assert.Equal(t, tc.expectedVersion, event.(MetaTeller).Version())
+			assert.Equal(t, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", event.(MetaTeller).ID())
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/semver"
+)
+
+// SigningSubject is a representation of an event that potentially could be signed.
+type SigningSubject interface {
+	json.Marshaler
+	CapabilityTeller
+	MetaTeller
+}
+
+// EventSigner signs events and returns their JSON representation,
+// including the signature. It's implemented by signature.Signer.
+type EventSigner interface {
+	Sign(event SigningSubject) ([]byte, error)
+}
+
+// ProducerConfig contains the configuration of a Producer. All fields
+// are optional and empty fields don't result in any changes to the events.
+type ProducerConfig struct {
+	// SourceDomainID, SourceHost, SourceName, SourceSerializer, and
+	// SourceURI populate the corresponding meta.source fields of all events.
+	SourceDomainID   string
+	SourceHost       string
+	SourceName       string
+	SourceSerializer string
+	SourceURI        string
+
+	// Tags populates the meta.tags field of all events.
+	Tags []string
+
+	// Versions maps event types (e.g. EiffelActivityTriggeredEvent) to
+	// the event versions that should be produced, typically the versions
+	// of an Eiffel edition. Event types that aren't included in the map
+	// are produced in the most recent version known by this SDK. The edition
+	// packages have their own NewProducer functions that populate this field.
+	Versions map[string]string
+
+	// Modifiers are applied to all events after the modifiers
+	// that correspond to the fields above.
+	Modifiers []Modifier
+
+	// Generator provides the meta.id and meta.time values of all events.
+	// The zero value gives random UUIDs and the current time.
+	Generator Generator
+
+	// Signer, if not nil, is used by Producer.Marshal to sign the events.
+	Signer EventSigner
+}
+
+// Producer creates events of any type with a common configuration applied,
+// and optionally signs them when they're marshaled. It's meant to be created
+// once by an application and passed to the parts of the application that
+// emit events. A Producer is safe for concurrent use as long as the modifiers
+// and the signer in its configuration are.
+type Producer struct {
+	factory  *Factory
+	versions map[string]string
+	signer   EventSigner
+}
+
+// NewProducer returns a new Producer with the given configuration.
+func NewProducer(cfg ProducerConfig) *Producer {
+	options := []FactoryOption{WithGenerator(cfg.Generator), withPreferredVersions(cfg.Versions)}
+	for _, field := range []struct {
+		value    string
+		modifier func(string) Modifier
+	}{
+		{cfg.SourceDomainID, WithSourceDomainID},
+		{cfg.SourceHost, WithSourceHost},
+		{cfg.SourceName, WithSourceName},
+		{cfg.SourceSerializer, WithSourceSerializer},
+		{cfg.SourceURI, WithSourceURI},
+	} {
+		if field.value != "" {
+			options = append(options, field.modifier(field.value))
+		}
+	}
+	if len(cfg.Tags) > 0 {
+		options = append(options, WithTags(append([]string(nil), cfg.Tags...)...))
+	}
+	for _, modifier := range cfg.Modifiers {
+		options = append(options, modifier)
+	}
+
+	return &Producer{
+		factory:  NewFactory(options...),
+		versions: cfg.Versions,
+		signer:   cfg.Signer,
+	}
+}
+
+// Factory returns a Factory that applies the producer's configuration
+// to the events it creates. Use it to create events of a particular
+// Go type, e.g. p.Factory().NewArtifactCreatedV3(). If the producer's
+// configuration includes a version for the event type with the same major
+// version as the created event, meta.version is set to that version.
+func (p *Producer) Factory() *Factory {
+	return p.factory
+}
+
+// New creates a new event of the given type (e.g. EiffelArtifactCreatedEvent)
+// with the producer's configuration applied, followed by the given modifiers.
+// The event version is picked from the producer's configuration, or if the
+// event type isn't included there the most recent version known by this SDK
+// is used. See the package-level New function for details.
+func (p *Producer) New(eventType string, modifiers ...Modifier) (Event, error) {
+	return p.factory.New(eventType, p.versions[eventType], modifiers...)
+}
+
+// Marshal returns the JSON representation of the event. If the producer
+// has been configured with a signer the event is signed.
+func (p *Producer) Marshal(event SigningSubject) ([]byte, error) {
+	if p.signer == nil {
+		return event.MarshalJSON()
+	}
+	return p.signer.Sign(event)
+}
+
+// withPreferredVersions returns a modifier that sets meta.version to the
+// version found in the versions map for the event's type, provided that
+// it has the same major version as the event.
+func withPreferredVersions(versions map[string]string) Modifier {
+	return func(fieldSetter FieldSetter) error {
+		mt, ok := fieldSetter.(MetaTeller)
+		if !ok {
+			return nil
+		}
+		preferredString, ok := versions[mt.Type()]
+		if !ok {
+			return nil
+		}
+		preferred, err := semver.NewVersion(preferredString)
+		if err != nil {
+			return fmt.Errorf("unable to parse version %q of %s: %w", preferredString, mt.Type(), err)
+		}
+		current, err := semver.NewVersion(mt.Version())
+		if err != nil {
+			return fmt.Errorf("%w: unable to parse meta.version: %s", ErrMalformedInput, err)
+		}
+		if preferred.Major() != current.Major() {
+			return nil
+		}
+		return fieldSetter.SetField("meta.version", preferredString)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSigner struct {
+	err error
+}
+
+func (s *fakeSigner) Sign(event SigningSubject) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []byte(`"signed ` + event.Type() + `"`), nil
+}
+
+func TestProducerNew(t *testing.T) {
+	producer := NewProducer(ProducerConfig{
+		SourceDomainID: "example.com",
+		SourceHost:     "host.example.com",
+		SourceName:     "my-producer",
+		SourceURI:      "https://example.com/producer",
+		Tags:           []string{"a", "b"},
+		Versions: map[string]string{
+			"EiffelActivityTriggeredEvent": "3.0.0",
+		},
+	})
+
+	testcases := []struct {
+		name            string
+		eventType       string
+		expectedType    interface{}
+		expectedVersion string
+	}{
+		{
+			name:            "Configured version",
+			eventType:       "EiffelActivityTriggeredEvent",
+			expectedType:    &ActivityTriggeredV3{},
+			expectedVersion: "3.0.0",
+		},
+		{
+			name:            "Most recent version",
+			eventType:       "EiffelArtifactCreatedEvent",
+			expectedType:    &ArtifactCreatedV3{},
+			expectedVersion: eventTypeTable["EiffelArtifactCreatedEvent"][3].latestVersion,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := producer.New(tc.eventType, WithSourceName("overridden"))
+			require.NoError(t, err)
+			require.IsType(t, tc.expectedType, event)
+			assert.Equal(t, tc.eventType, event.Type())
+			assert.Equal(t, tc.expectedVersion, event.Version())
+			assert.NotEmpty(t, event.ID())
+			assert.NotZero(t, event.Time())
+			assert.Equal(t, EventSource{
+				DomainID: "example.com",
+				Host:     "host.example.com",
+				Name:     "overridden",
+				URI:      "https://example.com/producer",
+			}, event.Source())
+			assert.Equal(t, []string{"a", "b"}, event.Tags())
+		})
+	}
+}
+
+func TestProducerTagsAreNotShared(t *testing.T) {
+	tags := []string{"a", "b"}
+	producer := NewProducer(ProducerConfig{Tags: tags})
+
+	e1, err := producer.Factory().NewActivityTriggeredV4()
+	require.NoError(t, err)
+	e1.Meta.Tags[0] = "MUTATED"
+	tags[1] = "MUTATED"
+
+	e2, err := producer.Factory().NewActivityTriggeredV4()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, e2.Meta.Tags)
+}
+
+func TestProducerNewUnsupported(t *testing.T) {
+	producer := NewProducer(ProducerConfig{
+		Versions: map[string]string{
+			"EiffelActivityTriggeredEvent": "99.0.0",
+		},
+	})
+
+	_, err := producer.New("EiffelActivityTriggeredEvent")
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+	_, err = producer.New("EiffelNoSuchEvent")
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+}
+
+func TestProducerFactory(t *testing.T) {
+	producer := NewProducer(ProducerConfig{
+		Versions: map[string]string{
+			"EiffelActivityTriggeredEvent": "4.0.0",
+		},
+	})
+
+	event4, err := producer.Factory().NewActivityTriggeredV4()
+	require.NoError(t, err)
+	assert.Equal(t, "4.0.0", event4.Meta.Version)
+
+	// A different major version than the configured one
+	// gets the most recent version.
+	event3, err := producer.Factory().NewActivityTriggeredV3()
+	require.NoError(t, err)
+	assert.Equal(t, eventTypeTable["EiffelActivityTriggeredEvent"][3].latestVersion, event3.Meta.Version)
+}
+
+func TestProducerGenerator(t *testing.T) {
+	producer := NewProducer(ProducerConfig{
+		Generator: Generator{
+			IDSource: NewSequentialIDSource(testNamespace),
+			Clock:    ClockFunc(func() time.Time { return time.UnixMilli(1234567890) }),
+		},
+	})
+
+	event1, err := producer.New("EiffelCompositionDefinedEvent")
+	require.NoError(t, err)
+	event2, err := producer.Factory().NewCompositionDefinedV3()
+	require.NoError(t, err)
+
+	ids := NewSequentialIDSource(testNamespace)
+	assert.Equal(t, ids.NewID(), event1.ID())
+	assert.Equal(t, ids.NewID(), event2.ID())
+	assert.Equal(t, int64(1234567890), event1.Time())
+	assert.Equal(t, int64(1234567890), event2.Time())
+}
+
+func TestProducerMarshal(t *testing.T) {
+	event, err := NewCompositionDefinedV3()
+	require.NoError(t, err)
+
+	output, err := NewProducer(ProducerConfig{}).Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, event.String(), string(output))
+
+	output, err = NewProducer(ProducerConfig{Signer: &fakeSigner{}}).Marshal(event)
+	require.NoError(t, err)
+	assert.Equal(t, `"signed EiffelCompositionDefinedEvent"`, string(output))
+
+	signErr := errors.New("signing error")
+	_, err = NewProducer(ProducerConfig{Signer: &fakeSigner{err: signErr}}).Marshal(event)
+	assert.ErrorIs(t, err, signErr)
+}
@@ -0,0 +1,145 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/Masterminds/semver"
+	"github.com/tidwall/gjson"
+)
+
+// RawEvent is the JSON representation of an Eiffel event of any type. It
+// provides access to the event's metadata and links by extracting them from
+// the JSON payload on demand, which for applications that only need to
+// inspect a few fields (e.g. for routing or filtering) is much cheaper than
+// unmarshaling the whole event. Call Decode to obtain the full event struct.
+//
+// Since RawEvent is a byte slice it can be passed as-is to functions
+// expecting a byte slice with an event payload, like signature.Verifier.
+// Like json.RawMessage it can be embedded in other types to delay the
+// unmarshaling of events.
+//
+// The accessor methods don't validate the payload, and return zero values
+// for fields that are missing or can't be extracted.
+type RawEvent []byte
+
+var (
+	_ CapabilityTeller = RawEvent{}
+	_ LinkFinder       = RawEvent{}
+	_ MetaTeller       = RawEvent{}
+)
+
+// ID returns the value of the meta.id field.
+func (e RawEvent) ID() string {
+	return gjson.GetBytes(e, "meta.id").String()
+}
+
+// Type returns the value of the meta.type field.
+func (e RawEvent) Type() string {
+	return gjson.GetBytes(e, "meta.type").String()
+}
+
+// Version returns the value of the meta.version field.
+func (e RawEvent) Version() string {
+	return gjson.GetBytes(e, "meta.version").String()
+}
+
+// Time returns the value of the meta.time field.
+func (e RawEvent) Time() int64 {
+	return gjson.GetBytes(e, "meta.time").Int()
+}
+
+// DomainID returns the value of the meta.source.domainId field.
+func (e RawEvent) DomainID() string {
+	return gjson.GetBytes(e, "meta.source.domainId").String()
+}
+
+// FindAll returns the IDs of all links of the specified type,
+// or an empty slice if no such links are found.
+func (e RawEvent) FindAll(linkType LinkType) []string {
+	result := []string{}
+	gjson.GetBytes(e, "links").ForEach(func(_ gjson.Result, link gjson.Result) bool {
+		if link.Get("type").String() == string(linkType) {
+			result = append(result, link.Get("target").String())
+		}
+		return true
+	})
+	return result
+}
+
+// FindFirst returns the ID of the first encountered link of the
+// specified type, or an empty string if no such link is found.
+func (e RawEvent) FindFirst(linkType LinkType) string {
+	var result string
+	gjson.GetBytes(e, "links").ForEach(func(_ gjson.Result, link gjson.Result) bool {
+		if link.Get("type").String() == string(linkType) {
+			result = link.Get("target").String()
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// SupportsSigning returns true if the event supports signatures according
+// to V3 of the meta field, i.e. events where the signature is found under
+// meta.security.integrityProtection. Events of unsupported types and
+// versions are reported as not supporting signing.
+func (e RawEvent) SupportsSigning() bool {
+	meta := gjson.GetManyBytes(e, "meta.type", "meta.version")
+	version, err := semver.NewVersion(meta[1].String())
+	if err != nil {
+		return false
+	}
+	majorVersion, ok := eventTypeTable[meta[0].String()][version.Major()]
+	if !ok {
+		return false
+	}
+	capabilityTeller, ok := reflect.Zero(majorVersion.structType).Interface().(CapabilityTeller)
+	return ok && capabilityTeller.SupportsSigning()
+}
+
+// Decode unmarshals the event into the struct type that corresponds to its
+// type and version. See UnmarshalAny for details about the options and
+// the errors that may be returned.
+func (e RawEvent) Decode(opts ...UnmarshalOption) (interface{}, error) {
+	return UnmarshalAny(e, opts...)
+}
+
+// MarshalJSON returns the event payload unchanged.
+func (e RawEvent) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("null"), nil
+	}
+	return e, nil
+}
+
+// UnmarshalJSON sets the event payload to a copy of the input data.
+func (e *RawEvent) UnmarshalJSON(data []byte) error {
+	if e == nil {
+		return errors.New("eiffelevents.RawEvent: UnmarshalJSON on nil pointer")
+	}
+	*e = append((*e)[0:0], data...)
+	return nil
+}
+
+// String returns the event payload as a string.
+func (e RawEvent) String() string {
+	return string(e)
+}
@@ -0,0 +1,113 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rawEventTestEvent = `{
+	"meta": {
+		"type": "EiffelTestCaseFinishedEvent",
+		"version": "3.3.0",
+		"time": 1234567890,
+		"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0",
+		"source": {"domainId": "example.com"}
+	},
+	"data": {
+		"outcome": {"conclusion": "SUCCESSFUL", "verdict": "PASSED"}
+	},
+	"links": [
+		{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"},
+		{"type": "TEST_CASE_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"},
+		{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee3"}
+	]
+}`
+
+func TestRawEventMetaTeller(t *testing.T) {
+	event := RawEvent(rawEventTestEvent)
+	assert.Equal(t, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", event.ID())
+	assert.Equal(t, "EiffelTestCaseFinishedEvent", event.Type())
+	assert.Equal(t, "3.3.0", event.Version())
+	assert.Equal(t, int64(1234567890), event.Time())
+	assert.Equal(t, "example.com", event.DomainID())
+
+	// The values should match those of the decoded event.
+	decoded, err := event.Decode()
+	require.NoError(t, err)
+	require.IsType(t, &TestCaseFinishedV3{}, decoded)
+	tcf := decoded.(*TestCaseFinishedV3) // nolint:forcetypeassert
+	assert.Equal(t, tcf.ID(), event.ID())
+	assert.Equal(t, tcf.Time(), event.Time())
+	assert.Equal(t, tcf.DomainID(), event.DomainID())
+}
+
+func TestRawEventLinkFinder(t *testing.T) {
+	event := RawEvent(rawEventTestEvent)
+	testcases := []struct {
+		linkType      LinkType
+		expectedAll   []string
+		expectedFirst string
+	}{
+		{"CAUSE", []string{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee3"}, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"},
+		{"TEST_CASE_EXECUTION", []string{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"}, "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"},
+		{"CONTEXT", []string{}, ""},
+	}
+	for _, tc := range testcases {
+		t.Run(string(tc.linkType), func(t *testing.T) {
+			assert.Equal(t, tc.expectedAll, event.FindAll(tc.linkType))
+			assert.Equal(t, tc.expectedFirst, event.FindFirst(tc.linkType))
+		})
+	}
+}
+
+func TestRawEventSupportsSigning(t *testing.T) {
+	testcases := []struct {
+		name     string
+		event    string
+		expected bool
+	}{
+		{"Event with meta v3", `{"meta":{"type":"EiffelTestCaseFinishedEvent","version":"3.0.0"}}`, true},
+		{"Event with older meta", `{"meta":{"type":"EiffelTestCaseFinishedEvent","version":"1.1.0"}}`, false},
+		{"Unknown event type", `{"meta":{"type":"EiffelFooEvent","version":"3.0.0"}}`, false},
+		{"Unknown major version", `{"meta":{"type":"EiffelTestCaseFinishedEvent","version":"99.0.0"}}`, false},
+		{"Garbage", `not json`, false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, RawEvent(tc.event).SupportsSigning())
+		})
+	}
+}
+
+func TestRawEventJSON(t *testing.T) {
+	var envelope struct {
+		Events []RawEvent `json:"events"`
+	}
+	input := `{"events":[` + rawEventTestEvent + `]}`
+	require.NoError(t, json.Unmarshal([]byte(input), &envelope))
+	require.Len(t, envelope.Events, 1)
+	assert.Equal(t, "EiffelTestCaseFinishedEvent", envelope.Events[0].Type())
+
+	output, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	assert.JSONEq(t, input, string(output))
+}
@@ -0,0 +1,149 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Masterminds/semver"
+)
+
+// EventTypes returns the names of all event types supported by this SDK,
+// e.g. EiffelActivityTriggeredEvent, in alphabetical order.
+func EventTypes() []string {
+	types := make([]string, 0, len(eventTypeTable))
+	for eventType := range eventTypeTable {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// EventVersions returns all versions of the given event type that are known
+// by this SDK, in ascending order. Of the experimental 0.x.y versions only
+// the most recent one is supported and included. Returns nil if the event
+// type isn't supported.
+func EventVersions(eventType string) []string {
+	var versions []string
+	for _, major := range sortedMajorVersions(eventType) {
+		versions = append(versions, eventTypeTable[eventType][major].knownVersions...)
+	}
+	return versions
+}
+
+// LatestVersions returns the most recent known version within each
+// supported major version of the given event type, in ascending order.
+// These are the versions that the NewXxxVn factory functions produce.
+// Returns nil if the event type isn't supported.
+func LatestVersions(eventType string) []string {
+	var versions []string
+	for _, major := range sortedMajorVersions(eventType) {
+		versions = append(versions, eventTypeTable[eventType][major].latestVersion)
+	}
+	return versions
+}
+
+// IsKnownVersion returns true if the given version of the event type
+// is known by this SDK, i.e. if it's one of the versions returned by
+// EventVersions. Versions that can't be parsed are reported as unknown.
+func IsKnownVersion(eventType string, version string) bool {
+	_, _, err := lookupVersion(eventType, version)
+	return err == nil
+}
+
+// New creates a new event of the given type (e.g. EiffelTestCaseStartedEvent)
+// and version (e.g. 3.1.0), populates all required meta members, and applies
+// the modifiers to it. If the version is empty the most recent version known
+// by this SDK is used. The returned value is a pointer to the struct type that
+// represents the event's major version, e.g. *TestCaseStartedV3.
+//
+// This function is meant for tools that create events dynamically, e.g.
+// based on configuration files. Code that knows which event type to create
+// should use the typed factory functions like NewTestCaseStartedV3 instead.
+//
+// An ErrUnsupportedEvent error is returned if the event type isn't supported
+// or if the version isn't known (see IsKnownVersion).
+func New(eventType string, version string, modifiers ...Modifier) (Event, error) {
+	return newEvent(Generator{}, eventType, version, modifiers)
+}
+
+// New creates a new event of the given type and version like the
+// package-level New, but with meta.id and meta.time from the factory's
+// Generator and with the factory's modifiers applied before the given
+// modifiers.
+func (f *Factory) New(eventType string, version string, modifiers ...Modifier) (Event, error) {
+	return newEvent(f.generator, eventType, version, f.withModifiers(modifiers))
+}
+
+func newEvent(generator Generator, eventType string, version string, modifiers []Modifier) (Event, error) {
+	if version == "" {
+		latest := LatestVersions(eventType)
+		if len(latest) == 0 {
+			return nil, fmt.Errorf("%w: type: %s", ErrUnsupportedEvent, eventType)
+		}
+		version = latest[len(latest)-1]
+	}
+	majorVersion, version, err := lookupVersion(eventType, version)
+	if err != nil {
+		return nil, err
+	}
+	return majorVersion.create(generator, version, modifiers)
+}
+
+// asEvent converts the result of one of the functions that create events
+// of a particular type to an Event, making sure that a nil pointer isn't
+// returned as a non-nil Event.
+func asEvent[T Event](event T, err error) (Event, error) {
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// lookupVersion returns the eventTypeTable entry for the given version of
+// an event type along with the normalized version string, or an
+// ErrUnsupportedEvent error if the event type isn't supported or the
+// version isn't known.
+func lookupVersion(eventType string, version string) (majorEventVersion, string, error) {
+	majorVersions, ok := eventTypeTable[eventType]
+	if !ok {
+		return majorEventVersion{}, "", fmt.Errorf("%w: type: %s", ErrUnsupportedEvent, eventType)
+	}
+	parsedVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return majorEventVersion{}, "", fmt.Errorf("%w: unable to parse version %q of %s: %s", ErrUnsupportedEvent, version, eventType, err)
+	}
+	majorVersion, ok := majorVersions[parsedVersion.Major()]
+	if !ok || !slices.Contains(majorVersion.knownVersions, parsedVersion.String()) {
+		return majorEventVersion{}, "", fmt.Errorf("%w: version %s of %s unsupported; known versions: %v",
+			ErrUnsupportedEvent, version, eventType, EventVersions(eventType))
+	}
+	return majorVersion, parsedVersion.String(), nil
+}
+
+// sortedMajorVersions returns the supported major versions
+// of the given event type in ascending order.
+func sortedMajorVersions(eventType string) []int64 {
+	var majors []int64
+	for major := range eventTypeTable[eventType] {
+		majors = append(majors, major)
+	}
+	slices.Sort(majors)
+	return majors
+}
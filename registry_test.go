@@ -0,0 +1,160 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTypes(t *testing.T) {
+	types := EventTypes()
+	assert.Len(t, types, len(eventTypeTable))
+	assert.IsIncreasing(t, types)
+	assert.Contains(t, types, "EiffelTestCaseStartedEvent")
+}
+
+func TestEventVersions(t *testing.T) {
+	versions := EventVersions("EiffelActivityCanceledEvent")
+	assert.Equal(t, []string{"1.0.0", "1.1.0", "2.0.0", "3.0.0", "3.1.0", "3.2.0"}, versions[:6])
+	assert.Nil(t, EventVersions("EiffelNoSuchEvent"))
+
+	// Only the latest experimental version is supported.
+	assert.Equal(t, "0.1.0", EventVersions("EiffelArtifactDeployedEvent")[0])
+}
+
+func TestLatestVersions(t *testing.T) {
+	latest := LatestVersions("EiffelActivityCanceledEvent")
+	require.Len(t, latest, len(eventTypeTable["EiffelActivityCanceledEvent"]))
+	assert.Equal(t, []string{"1.1.0", "2.0.0"}, latest[:2])
+	assert.Equal(t, eventTypeTable["EiffelActivityCanceledEvent"][3].latestVersion, latest[2])
+	assert.Nil(t, LatestVersions("EiffelNoSuchEvent"))
+}
+
+func TestIsKnownVersion(t *testing.T) {
+	testcases := []struct {
+		eventType string
+		version   string
+		expected  bool
+	}{
+		{"EiffelActivityCanceledEvent", "3.1.0", true},
+		{"EiffelActivityCanceledEvent", "3.1", true},
+		{"EiffelActivityCanceledEvent", "3.99.0", false},
+		{"EiffelActivityCanceledEvent", "99.0.0", false},
+		{"EiffelActivityCanceledEvent", "not-a-version", false},
+		{"EiffelNoSuchEvent", "1.0.0", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.eventType+"/"+tc.version, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsKnownVersion(tc.eventType, tc.version))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testcases := []struct {
+		name            string
+		eventType       string
+		version         string
+		expectedType    interface{}
+		expectedVersion string
+		expectedError   error
+	}{
+		{
+			name:            "Specific version",
+			eventType:       "EiffelTestCaseStartedEvent",
+			version:         "3.1.0",
+			expectedType:    &TestCaseStartedV3{},
+			expectedVersion: "3.1.0",
+		},
+		{
+			name:            "Normalized version",
+			eventType:       "EiffelTestCaseStartedEvent",
+			version:         "2.0",
+			expectedType:    &TestCaseStartedV2{},
+			expectedVersion: "2.0.0",
+		},
+		{
+			name:            "Experimental version",
+			eventType:       "EiffelArtifactDeployedEvent",
+			version:         "0.1.0",
+			expectedType:    &ArtifactDeployedV0_1_0{},
+			expectedVersion: "0.1.0",
+		},
+		{
+			name:            "Latest version",
+			eventType:       "EiffelTestCaseStartedEvent",
+			version:         "",
+			expectedType:    &TestCaseStartedV3{},
+			expectedVersion: eventTypeTable["EiffelTestCaseStartedEvent"][3].latestVersion,
+		},
+		{
+			name:          "Unknown minor version",
+			eventType:     "EiffelTestCaseStartedEvent",
+			version:       "3.99.0",
+			expectedError: ErrUnsupportedEvent,
+		},
+		{
+			name:          "Unknown event type",
+			eventType:     "EiffelNoSuchEvent",
+			version:       "",
+			expectedError: ErrUnsupportedEvent,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := New(tc.eventType, tc.version, WithSourceName("my-tool"))
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.expectedType, event)
+			assert.Equal(t, tc.eventType, event.Type())
+			assert.Equal(t, tc.expectedVersion, event.Version())
+			assert.NotEmpty(t, event.ID())
+			assert.NotZero(t, event.Time())
+			assert.Equal(t, "my-tool", event.Source().Name)
+		})
+	}
+}
+
+func TestFactoryNew(t *testing.T) {
+	factory := NewFactory(
+		WithIDSource(NewSequentialIDSource(testNamespace)),
+		WithClock(ClockFunc(func() time.Time { return time.UnixMilli(1234567890) })),
+		WithSourceName("factory"),
+	)
+	event, err := factory.New("EiffelTestCaseStartedEvent", "3.1.0")
+	require.NoError(t, err)
+	require.IsType(t, &TestCaseStartedV3{}, event)
+	assert.Equal(t, NewSequentialIDSource(testNamespace).NewID(), event.ID())
+	assert.Equal(t, int64(1234567890), event.Time())
+	assert.Equal(t, "factory", event.Source().Name)
+
+	// A failing modifier doesn't result in a non-nil Event.
+	modifierErr := errors.New("modifier failed")
+	event, err = factory.New("EiffelTestCaseStartedEvent", "", func(fieldSetter FieldSetter) error {
+		return modifierErr
+	})
+	assert.ErrorIs(t, err, modifierErr)
+	assert.Nil(t, event)
+}
@@ -75,6 +75,7 @@ func TestSepia(t *testing.T) {
 			event, err := eiffelevents.NewCompositionDefined(rooteiffelevents.WithSourceDomainID(tc.domainID))
 			require.NoError(t, err)
 			assert.Equal(t, tc.expected, Sepia(event, tc.family, tc.tag))
+			assert.Equal(t, tc.expected, Sepia(rooteiffelevents.RawEvent(event.String()), tc.family, tc.tag))
 		})
 	}
 }
@@ -0,0 +1,142 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+)
+
+// ContextSigner is an optional interface that a crypto.Signer passed to
+// NewCryptoSigner can implement to receive the context passed to
+// Signer.SignContext, e.g. to cancel or trace calls to a key store.
+type ContextSigner interface {
+	crypto.Signer
+
+	// SignContext works like crypto.Signer's Sign method but also accepts a context.
+	SignContext(ctx context.Context, rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error)
+}
+
+// RemoteSigner computes signatures on behalf of a Signer created with
+// NewRemoteSigner, e.g. by calling a signing service or a cloud KMS.
+type RemoteSigner interface {
+	// Sign returns the signature of the signing input using the given algorithm.
+	// For EdDSA and the HMAC algorithms the input is the message itself,
+	// i.e. the canonical JSON representation of the event. For all other
+	// algorithms it's the digest of the message, computed with the algorithm's
+	// hash function. The signature must be encoded like the crypto package
+	// encodes it, e.g. ECDSA signatures must be ASN.1 DER-encoded.
+	Sign(ctx context.Context, alg Algorithm, input []byte) ([]byte, error)
+}
+
+// NewCryptoSigner initializes a Signer that delegates the signing to
+// a crypto.Signer, e.g. a key in an HSM, a TPM, or a cloud KMS. The Signer
+// checks that the type of the crypto.Signer's public key matches the
+// algorithm and returns ErrKeyTypeMismatch otherwise. The HMAC algorithms
+// aren't supported; use NewHMACSigner for those.
+//
+// If the crypto.Signer implements ContextSigner its SignContext method
+// is used so that the context passed to Signer.SignContext is propagated.
+func NewCryptoSigner(identity string, alg Algorithm, signer crypto.Signer, opts ...SignerOption) (*Signer, error) {
+	hashFunc, hash, err := hashForAlgorithm(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	var signerOpts crypto.SignerOpts = hash
+	var keyTypeOK bool
+	switch pub := signer.Public(); alg {
+	case RS256, RS384, RS512:
+		_, keyTypeOK = pub.(*rsa.PublicKey)
+	case PS256, PS384, PS512:
+		_, keyTypeOK = pub.(*rsa.PublicKey)
+		signerOpts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}
+	case ES256, ES384, ES512:
+		_, keyTypeOK = pub.(*ecdsa.PublicKey)
+	case edDSA:
+		_, keyTypeOK = pub.(ed25519.PublicKey)
+	default:
+		return nil, fmt.Errorf("%w: %s isn't supported with a crypto.Signer", ErrUnsupportedAlgorithm, alg)
+	}
+	if !keyTypeOK {
+		return nil, fmt.Errorf("%w; %s can't be used with a %T public key", ErrKeyTypeMismatch, alg, signer.Public())
+	}
+
+	s := &Signer{
+		identity:   identity,
+		alg:        alg,
+		pk:         signer,
+		hashFunc:   hashFunc,
+		signerOpts: hash,
+		signFunc: func(ctx context.Context, _ crypto.PrivateKey, _ crypto.Hash, digest []byte) ([]byte, error) {
+			if cs, ok := signer.(ContextSigner); ok {
+				return cs.SignContext(ctx, rand.Reader, digest, signerOpts)
+			}
+			return signer.Sign(rand.Reader, digest, signerOpts)
+		},
+	}
+	return s.applyOptions(opts)
+}
+
+// NewRemoteSigner initializes a Signer that delegates the computation
+// of the signatures to a RemoteSigner. All algorithms are supported
+// as long as the RemoteSigner supports them.
+func NewRemoteSigner(identity string, alg Algorithm, remote RemoteSigner, opts ...SignerOption) (*Signer, error) {
+	hashFunc, hash, err := hashForAlgorithm(alg)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{
+		identity:   identity,
+		alg:        alg,
+		hashFunc:   hashFunc,
+		signerOpts: hash,
+		signFunc: func(ctx context.Context, _ crypto.PrivateKey, _ crypto.Hash, input []byte) ([]byte, error) {
+			return remote.Sign(ctx, alg, input)
+		},
+	}
+	return s.applyOptions(opts)
+}
+
+// hashForAlgorithm returns the function that computes the signing input
+// for the algorithm, along with the hash function used by the algorithm.
+// For algorithms that sign the message itself the returned hash is zero.
+func hashForAlgorithm(alg Algorithm) (func([]byte) []byte, crypto.Hash, error) {
+	switch alg {
+	case RS256, ES256, PS256:
+		return hashSHA256, crypto.SHA256, nil
+	case RS384, ES384, PS384:
+		return hashSHA384, crypto.SHA384, nil
+	case RS512, ES512, PS512:
+		return hashSHA512, crypto.SHA512, nil
+	case HS256:
+		return hashNone, crypto.SHA256, nil
+	case HS384:
+		return hashNone, crypto.SHA384, nil
+	case HS512:
+		return hashNone, crypto.SHA512, nil
+	case edDSA:
+		return hashNone, crypto.Hash(0), nil
+	}
+	return nil, 0, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, alg)
+}
@@ -0,0 +1,300 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go/editions/lyon"
+)
+
+type contextKey struct{}
+
+// fakeCryptoSigner is a crypto.Signer that delegates to an in-process key,
+// standing in for e.g. an HSM.
+type fakeCryptoSigner struct {
+	key   crypto.Signer
+	err   error
+	calls int
+}
+
+func (s *fakeCryptoSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *fakeCryptoSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.key.Sign(rand, digest, opts)
+}
+
+// fakeContextSigner is a fakeCryptoSigner that also implements ContextSigner
+// and records the value of contextKey in each context it gets.
+type fakeContextSigner struct {
+	fakeCryptoSigner
+	contextVals []interface{}
+}
+
+func (s *fakeContextSigner) SignContext(ctx context.Context, rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.contextVals = append(s.contextVals, ctx.Value(contextKey{}))
+	return s.Sign(rand, digest, opts)
+}
+
+func TestCryptoSigner(t *testing.T) {
+	rsaKey := generateRSAKey(t)
+	ecdsaKey := generateECDSAKey(t, elliptic.P384())
+	ed25519Key := generateEd25519Key(t)
+
+	testcases := []struct {
+		name         string
+		alg          Algorithm
+		key          crypto.Signer
+		eventVersion string
+	}{
+		{name: "RS256", alg: RS256, key: rsaKey},
+		{name: "PS512", alg: PS512, key: rsaKey},
+		{name: "ES384", alg: ES384, key: ecdsaKey},
+		// Version 3 of the meta field can't express EdDSA so pretend
+		// that the event is of a future version that can.
+		{name: "EdDSA", alg: edDSA, key: ed25519Key, eventVersion: "3.99.0"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+			if tc.eventVersion != "" {
+				event.Meta.Version = tc.eventVersion
+			}
+
+			fake := &fakeCryptoSigner{key: tc.key}
+			signer, err := NewCryptoSigner("CN=test", tc.alg, fake)
+			require.NoError(t, err)
+			b, err := signer.Sign(event)
+			require.NoError(t, err)
+			assert.Equal(t, 1, fake.calls)
+
+			verifier := NewVerifier(&constantPublicKeyLocator{[]crypto.PublicKey{tc.key.Public()}, nil})
+			require.NoError(t, verifier.Verify(t.Context(), b))
+		})
+	}
+}
+
+func TestCryptoSignerPropagatesContext(t *testing.T) {
+	fake := &fakeContextSigner{fakeCryptoSigner: fakeCryptoSigner{key: generateECDSAKey(t, elliptic.P256())}}
+	signer, err := NewCryptoSigner("CN=test", ES256, fake)
+	require.NoError(t, err)
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+
+	ctx := context.WithValue(t.Context(), contextKey{}, "value")
+	_, err = signer.SignContext(ctx, event)
+	require.NoError(t, err)
+	_, err = signer.Sign(event)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"value", nil}, fake.contextVals)
+}
+
+func TestNewCryptoSignerErrors(t *testing.T) {
+	rsaKey := generateRSAKey(t)
+	testcases := []struct {
+		name          string
+		alg           Algorithm
+		key           crypto.Signer
+		expectedError error
+	}{
+		{
+			name:          "Key type mismatch",
+			alg:           ES256,
+			key:           rsaKey,
+			expectedError: ErrKeyTypeMismatch,
+		},
+		{
+			name:          "HMAC algorithm",
+			alg:           HS256,
+			key:           rsaKey,
+			expectedError: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:          "Unknown algorithm",
+			alg:           Algorithm("XS256"),
+			key:           rsaKey,
+			expectedError: ErrUnsupportedAlgorithm,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewCryptoSigner("CN=test", tc.alg, &fakeCryptoSigner{key: tc.key})
+			require.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestCryptoSignerFailure(t *testing.T) {
+	signErr := errors.New("key store unavailable")
+	signer, err := NewCryptoSigner("CN=test", RS256, &fakeCryptoSigner{key: generateRSAKey(t), err: signErr})
+	require.NoError(t, err)
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+
+	_, err = signer.Sign(event)
+	require.ErrorIs(t, err, ErrSigningFailed)
+	require.ErrorIs(t, err, signErr)
+}
+
+// signingRequest and signingResponse are the messages exchanged with
+// the HTTP signing service used in the tests.
+type signingRequest struct {
+	Alg   Algorithm `json:"alg"`
+	Input []byte    `json:"input"`
+}
+
+type signingResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// newSigningService starts an HTTP server that signs the inputs it receives
+// with the given key, standing in for a remote signing service.
+func newSigningService(t *testing.T, key crypto.Signer, secret []byte) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req signingRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var sig []byte
+		var err error
+		switch req.Alg {
+		case ES256:
+			sig, err = key.Sign(rand.Reader, req.Input, crypto.SHA256)
+		case HS256:
+			sig = computeHMAC(secret, crypto.SHA256, req.Input)
+		default:
+			err = fmt.Errorf("unsupported algorithm %s", req.Alg)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(signingResponse{Signature: sig})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// httpRemoteSigner is a RemoteSigner that calls a signing service over HTTP.
+type httpRemoteSigner struct {
+	url string
+}
+
+func (s *httpRemoteSigner) Sign(ctx context.Context, alg Algorithm, input []byte) ([]byte, error) {
+	body, err := json.Marshal(signingRequest{Alg: alg, Input: input})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing service returned %s", resp.Status)
+	}
+	var signingResp signingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&signingResp); err != nil {
+		return nil, err
+	}
+	return signingResp.Signature, nil
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ecdsaKey := generateECDSAKey(t, elliptic.P256())
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	server := newSigningService(t, ecdsaKey, secret)
+
+	testcases := []struct {
+		name          string
+		alg           Algorithm
+		verifier      *Verifier
+		expectedError error
+	}{
+		{
+			name:     "ES256",
+			alg:      ES256,
+			verifier: NewVerifier(&constantPublicKeyLocator{[]crypto.PublicKey{ecdsaKey.Public()}, nil}),
+		},
+		{
+			name:     "HS256",
+			alg:      HS256,
+			verifier: NewVerifier(nil, WithSecretLocator(&constantSecretLocator{[][]byte{secret}, nil})),
+		},
+		{
+			name:          "Algorithm rejected by the service",
+			alg:           PS256,
+			expectedError: ErrSigningFailed,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+
+			signer, err := NewRemoteSigner("CN=test", tc.alg, &httpRemoteSigner{server.URL})
+			require.NoError(t, err)
+			b, err := signer.SignContext(t.Context(), event)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, tc.verifier.Verify(t.Context(), b))
+		})
+	}
+}
+
+func TestRemoteSignerCanceledContext(t *testing.T) {
+	server := newSigningService(t, generateECDSAKey(t, elliptic.P256()), nil)
+	signer, err := NewRemoteSigner("CN=test", ES256, &httpRemoteSigner{server.URL})
+	require.NoError(t, err)
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = signer.SignContext(ctx, event)
+	require.ErrorIs(t, err, ErrSigningFailed)
+	require.ErrorIs(t, err, context.Canceled)
+}
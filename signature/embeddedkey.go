@@ -0,0 +1,246 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EmbedPublicKey makes the Signer include the given public key in
+// the meta.security.integrityProtection.publicKey field of the events
+// it signs. The key should correspond to the Signer's private key.
+// Verifiers only use embedded keys that have been pinned for the
+// signing identity; see WithPinnedKeys.
+func EmbedPublicKey(key crypto.PublicKey) SignerOption {
+	return func(s *Signer) error {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return fmt.Errorf("error marshaling public key: %w", err)
+		}
+		s.publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		return nil
+	}
+}
+
+// EmbedCertificates makes the Signer include the given certificates
+// in the meta.security.integrityProtection.publicKey field of the events
+// it signs. The first certificate is the one whose public key corresponds
+// to the Signer's private key and whose subject is the Signer's identity.
+// Any remaining certificates are intermediates needed to build a chain
+// to one of the roots trusted by the verifiers; see WithTrustedRoots.
+func EmbedCertificates(certs ...*x509.Certificate) SignerOption {
+	return func(s *Signer) error {
+		if len(certs) == 0 {
+			return errors.New("at least one certificate must be embedded")
+		}
+		var sb strings.Builder
+		for _, cert := range certs {
+			sb.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+		}
+		s.publicKey = sb.String()
+		return nil
+	}
+}
+
+// WithTrustedRoots makes the Verifier use public keys embedded in events,
+// provided that they're embedded as a certificate that chains to one of
+// the given root certificates and whose subject equals the event's
+// author identity. The certificates' validity periods are checked
+// against the event's meta.time, and the certificates must permit code
+// signing (see WithCertificateKeyUsages). The certificates are only checked
+// for revocation if a RevocationChecker is configured with
+// WithRevocationChecker. Events whose embedded public key can't be trusted
+// are verified with the keys found by the PublicKeyLocator.
+func WithTrustedRoots(roots *x509.CertPool) VerifierOption {
+	return func(v *Verifier) {
+		v.trustedRoots = roots
+	}
+}
+
+// RevocationChecker checks whether any of the certificates in a chain have
+// been revoked. It's implemented by X509PublicKeyLocator.
+type RevocationChecker interface {
+	// CheckRevocation returns an error wrapping ErrCertificateRevoked if
+	// the chain, which starts with the leaf certificate and ends with the
+	// root, can't be trusted at time t because of revocation.
+	CheckRevocation(ctx context.Context, chain []*x509.Certificate, t time.Time) error
+}
+
+// WithRevocationChecker makes the Verifier check the chains of certificates
+// embedded in events (see WithTrustedRoots) with the given RevocationChecker,
+// e.g. the X509PublicKeyLocator whose CRLs the verifier already uses for
+// looked up keys. Without a RevocationChecker embedded certificates are
+// never checked for revocation.
+func WithRevocationChecker(checker RevocationChecker) VerifierOption {
+	return func(v *Verifier) {
+		v.revocationChecker = checker
+	}
+}
+
+// defaultCertificateKeyUsages are the extended key usages that embedded
+// certificates must permit unless WithCertificateKeyUsages is given.
+var defaultCertificateKeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+
+// WithCertificateKeyUsages sets the extended key usages that embedded
+// certificates must permit to be trusted, replacing the default of
+// x509.ExtKeyUsageCodeSigning. A certificate is accepted if it permits any
+// of the usages. Like with x509.Certificate.Verify, certificates without
+// the extended key usage extension are accepted for any usage. Passing
+// no usages restores the default.
+func WithCertificateKeyUsages(usages ...x509.ExtKeyUsage) VerifierOption {
+	return func(v *Verifier) {
+		if len(usages) == 0 {
+			usages = defaultCertificateKeyUsages
+		}
+		v.certificateKeyUsages = usages
+	}
+}
+
+// WithPinnedKeys makes the Verifier use public keys embedded in events
+// signed by the given identity, provided that the embedded key (or the
+// key of an embedded certificate) equals one of the given keys. This
+// option can be given multiple times to pin keys for several identities.
+// Events whose embedded public key can't be trusted are verified with
+// the keys found by the PublicKeyLocator.
+func WithPinnedKeys(identity *AuthorIdentity, keys ...crypto.PublicKey) VerifierOption {
+	return func(v *Verifier) {
+		v.pinnedKeys = append(v.pinnedKeys, pinnedKeys{identity: identity, keys: keys})
+	}
+}
+
+// pinnedKeys holds the public keys pinned for an identity.
+type pinnedKeys struct {
+	identity *AuthorIdentity
+	keys     []crypto.PublicKey
+}
+
+// trustsEmbeddedKeys returns true if the Verifier has been configured
+// to use public keys embedded in events, under any circumstances.
+func (v *Verifier) trustsEmbeddedKeys() bool {
+	return v.trustedRoots != nil || len(v.pinnedKeys) > 0
+}
+
+// trustedEmbeddedKey parses the contents of an event's publicKey field
+// and returns the public key if it can be trusted for the given identity,
+// either because it's pinned or because it's embedded as a certificate
+// that chains to a trusted root. Otherwise an error wrapping
+// ErrUntrustedPublicKey is returned.
+func (v *Verifier) trustedEmbeddedKey(ctx context.Context, embedded string, identity *AuthorIdentity, eventTime time.Time) (crypto.PublicKey, error) {
+	key, certs, err := parseEmbeddedPublicKey(embedded)
+	if err != nil {
+		return nil, errors.Join(ErrUntrustedPublicKey, err)
+	}
+
+	for _, pinned := range v.pinnedKeys {
+		if !pinned.identity.Equal(identity) {
+			continue
+		}
+		for _, pinnedKey := range pinned.keys {
+			if k, ok := pinnedKey.(interface{ Equal(crypto.PublicKey) bool }); ok && k.Equal(key) {
+				return key, nil
+			}
+		}
+	}
+
+	if v.trustedRoots == nil || len(certs) == 0 {
+		return nil, fmt.Errorf("%w: the key isn't pinned for %s", ErrUntrustedPublicKey, identity)
+	}
+	subject, err := NewAuthorIdentity(certs[0].Subject.String())
+	if err != nil {
+		return nil, errors.Join(ErrUntrustedPublicKey, err)
+	}
+	if !subject.Equal(identity) {
+		return nil, fmt.Errorf("%w: the certificate subject %s doesn't match the author identity %s",
+			ErrUntrustedPublicKey, subject, identity)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	chains, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         v.trustedRoots,
+		Intermediates: intermediates,
+		CurrentTime:   eventTime,
+		KeyUsages:     v.certificateKeyUsages,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrUntrustedPublicKey, err)
+	}
+	if v.revocationChecker == nil {
+		return key, nil
+	}
+	var revocationErrs []error
+	for _, chain := range chains {
+		err := v.revocationChecker.CheckRevocation(ctx, chain, eventTime)
+		if err == nil {
+			return key, nil
+		}
+		revocationErrs = append(revocationErrs, err)
+	}
+	return nil, errors.Join(append([]error{ErrUntrustedPublicKey}, revocationErrs...)...)
+}
+
+// parseEmbeddedPublicKey parses PEM data from an event's publicKey field,
+// containing either a single public key or one or more certificates with
+// the leaf certificate first. The returned public key is that of the leaf
+// certificate if there are certificates.
+func parseEmbeddedPublicKey(pemData string) (crypto.PublicKey, []*x509.Certificate, error) {
+	var key crypto.PublicKey
+	var certs []*x509.Certificate
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		switch block.Type {
+		case "PUBLIC KEY":
+			if key != nil || len(certs) > 0 {
+				return nil, nil, errors.New("the embedded public key must be a single key or a certificate chain")
+			}
+			var err error
+			if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				return nil, nil, fmt.Errorf("error parsing embedded public key: %w", err)
+			}
+		case "CERTIFICATE":
+			if key != nil && len(certs) == 0 {
+				return nil, nil, errors.New("the embedded public key must be a single key or a certificate chain")
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error parsing embedded certificate: %w", err)
+			}
+			certs = append(certs, cert)
+			if len(certs) == 1 {
+				key = cert.PublicKey
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported PEM block type %q in embedded public key", block.Type)
+		}
+	}
+	if key == nil {
+		return nil, nil, errors.New("no PEM-encoded public key or certificate found")
+	}
+	return key, certs, nil
+}
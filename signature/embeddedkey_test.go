@@ -0,0 +1,258 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go/editions/lyon"
+)
+
+// generateCertificate returns a certificate for the key with the given
+// common name, valid from an hour ago until an hour from now. If issuer
+// is nil the certificate is self-signed. Leaf certificates permit code
+// signing.
+func generateCertificate(t *testing.T, cn string, key crypto.Signer, isCA bool, issuer *x509.Certificate, issuerKey crypto.Signer) *x509.Certificate {
+	var extKeyUsages []x509.ExtKeyUsage
+	if !isCA {
+		extKeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+	}
+	return generateCertificateWithUsages(t, cn, key, isCA, extKeyUsages, issuer, issuerKey)
+}
+
+// generateCertificateWithUsages works like generateCertificate but
+// the certificate permits the given extended key usages.
+func generateCertificateWithUsages(t *testing.T, cn string, key crypto.Signer, isCA bool, extKeyUsages []x509.ExtKeyUsage, issuer *x509.Certificate, issuerKey crypto.Signer) *x509.Certificate {
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           extKeyUsages,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+	if issuer == nil {
+		issuer, issuerKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), issuerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestVerifyEmbeddedPublicKey(t *testing.T) {
+	rootKey := generateECDSAKey(t, elliptic.P256())
+	root := generateCertificate(t, "Root CA", rootKey, true, nil, nil)
+	intermediateKey := generateECDSAKey(t, elliptic.P256())
+	intermediate := generateCertificate(t, "Intermediate CA", intermediateKey, true, root, rootKey)
+	otherRootKey := generateECDSAKey(t, elliptic.P256())
+	otherRoot := generateCertificate(t, "Other CA", otherRootKey, true, nil, nil)
+
+	signingKey := generateECDSAKey(t, elliptic.P256())
+	leaf := generateCertificate(t, "test", signingKey, false, intermediate, intermediateKey)
+	otherLeaf := generateCertificate(t, "other", signingKey, false, intermediate, intermediateKey)
+	serverLeaf := generateCertificateWithUsages(t, "test", signingKey, false, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, intermediate, intermediateKey)
+	otherKey := generateECDSAKey(t, elliptic.P256())
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	testcases := []struct {
+		name          string
+		signerOpts    []SignerOption
+		verifierOpts  []VerifierOption
+		keyLocator    PublicKeyLocator
+		eventTime     time.Time
+		expectedError error
+	}{
+		{
+			name:         "Certificate chaining to trusted root",
+			signerOpts:   []SignerOption{EmbedCertificates(leaf, intermediate)},
+			verifierOpts: []VerifierOption{WithTrustedRoots(roots)},
+		},
+		{
+			name:          "Certificate without intermediate",
+			signerOpts:    []SignerOption{EmbedCertificates(leaf)},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Certificate chaining to untrusted root",
+			signerOpts:    []SignerOption{EmbedCertificates(generateCertificate(t, "test", signingKey, false, otherRoot, otherRootKey))},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Certificate subject not matching author identity",
+			signerOpts:    []SignerOption{EmbedCertificates(otherLeaf, intermediate)},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Certificate not valid at event time",
+			signerOpts:    []SignerOption{EmbedCertificates(leaf, intermediate)},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			eventTime:     time.Now().Add(-24 * time.Hour),
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Certificate not permitting code signing",
+			signerOpts:    []SignerOption{EmbedCertificates(serverLeaf, intermediate)},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:         "Certificate permitting configured key usage",
+			signerOpts:   []SignerOption{EmbedCertificates(serverLeaf, intermediate)},
+			verifierOpts: []VerifierOption{WithTrustedRoots(roots), WithCertificateKeyUsages(x509.ExtKeyUsageCodeSigning, x509.ExtKeyUsageServerAuth)},
+		},
+		{
+			name:          "Default key usages restored",
+			signerOpts:    []SignerOption{EmbedCertificates(serverLeaf, intermediate)},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots), WithCertificateKeyUsages(x509.ExtKeyUsageServerAuth), WithCertificateKeyUsages()},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:         "Pinned key",
+			signerOpts:   []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts: []VerifierOption{WithPinnedKeys(mustParseAuthorIdentity(t, "CN=test"), otherKey.Public(), signingKey.Public())},
+		},
+		{
+			name:         "Pinned key of certificate",
+			signerOpts:   []SignerOption{EmbedCertificates(otherLeaf)},
+			verifierOpts: []VerifierOption{WithPinnedKeys(mustParseAuthorIdentity(t, "CN=test"), signingKey.Public())},
+		},
+		{
+			name:          "Key pinned for another identity",
+			signerOpts:    []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts:  []VerifierOption{WithPinnedKeys(mustParseAuthorIdentity(t, "CN=other"), signingKey.Public())},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Key not pinned",
+			signerOpts:    []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts:  []VerifierOption{WithPinnedKeys(mustParseAuthorIdentity(t, "CN=test"), otherKey.Public())},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:          "Bare key with trusted roots",
+			signerOpts:    []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:         "Untrusted key with fallback to locator",
+			signerOpts:   []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts: []VerifierOption{WithTrustedRoots(roots)},
+			keyLocator:   &constantPublicKeyLocator{[]crypto.PublicKey{signingKey.Public()}, nil},
+		},
+		{
+			name:          "Untrusted key with failing fallback to locator",
+			signerOpts:    []SignerOption{EmbedPublicKey(signingKey.Public())},
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			keyLocator:    &constantPublicKeyLocator{nil, nil},
+			expectedError: ErrUntrustedPublicKey,
+		},
+		{
+			name:       "Embedded key ignored without trust configuration",
+			signerOpts: []SignerOption{EmbedPublicKey(otherKey.Public())},
+			keyLocator: &constantPublicKeyLocator{[]crypto.PublicKey{signingKey.Public()}, nil},
+		},
+		{
+			name:          "No embedded key",
+			verifierOpts:  []VerifierOption{WithTrustedRoots(roots)},
+			expectedError: ErrPublicKeyNotFound,
+		},
+		{
+			name:          "Trusted key not matching signature",
+			signerOpts:    []SignerOption{EmbedPublicKey(otherKey.Public())},
+			verifierOpts:  []VerifierOption{WithPinnedKeys(mustParseAuthorIdentity(t, "CN=test"), otherKey.Public())},
+			expectedError: ErrSignatureMismatch,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+			if !tc.eventTime.IsZero() {
+				event.Meta.Time = tc.eventTime.UnixMilli()
+			}
+
+			signer, err := NewKeySigner("CN=test", ES256, signingKey, tc.signerOpts...)
+			require.NoError(t, err)
+			b, err := signer.Sign(event)
+			require.NoError(t, err)
+
+			err = NewVerifier(tc.keyLocator, tc.verifierOpts...).Verify(t.Context(), b)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestEmbeddedPublicKeyIsSigned(t *testing.T) {
+	signingKey := generateECDSAKey(t, elliptic.P256())
+	otherKey := generateECDSAKey(t, elliptic.P256())
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+
+	signer, err := NewKeySigner("CN=test", ES256, signingKey, EmbedPublicKey(signingKey.Public()))
+	require.NoError(t, err)
+	b, err := signer.Sign(event)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(gjson.GetBytes(b, publicKeyField).String(), "-----BEGIN PUBLIC KEY-----"))
+
+	// Replacing the embedded key with another pinned key must not
+	// make the event verifiable with that key.
+	otherSigner, err := NewKeySigner("CN=test", ES256, otherKey, EmbedPublicKey(otherKey.Public()))
+	require.NoError(t, err)
+	b2, err := otherSigner.Sign(event)
+	require.NoError(t, err)
+	b, err = sjson.SetBytes(b, publicKeyField, gjson.GetBytes(b2, publicKeyField).String())
+	require.NoError(t, err)
+
+	verifier := NewVerifier(nil, WithPinnedKeys(mustParseAuthorIdentity(t, "CN=test"), otherKey.Public()))
+	require.ErrorIs(t, verifier.Verify(t.Context(), b), ErrSignatureMismatch)
+}
+
+func TestEmbedCertificatesWithoutCertificates(t *testing.T) {
+	_, err := NewKeySigner("CN=test", ES256, generateECDSAKey(t, elliptic.P256()), EmbedCertificates())
+	require.Error(t, err)
+}
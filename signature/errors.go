@@ -19,14 +19,18 @@ package signature
 import "errors"
 
 var (
+	ErrCertificateRevoked   = errors.New("the certificate has been revoked or isn't covered by a current CRL")
 	ErrKeyTypeMismatch      = errors.New("key is of the wrong type")
 	ErrMarshaling           = errors.New("the marshaling of the event was unsuccessful")
 	ErrPublicKeyLookup      = errors.New("an error occurred looking up the public key for this identity")
 	ErrPublicKeyNotFound    = errors.New("no public key for verifying events signed by this identify was found")
+	ErrSecretLookup         = errors.New("an error occurred looking up the shared secret for this identity")
+	ErrSecretNotFound       = errors.New("no shared secret for verifying events signed by this identity was found")
 	ErrSignatureMismatch    = errors.New("the signature couldn't be verified")
 	ErrSigningFailed        = errors.New("signing of the event failed")
 	ErrSigningUnavailable   = errors.New("signing of this event type and version isn't supported")
 	ErrUnsupportedAlgorithm = errors.New("unsupported algorithm")
+	ErrUntrustedPublicKey   = errors.New("the public key embedded in the event can't be trusted")
 	ErrUnverifiableEvent    = errors.New("event cannot be verified because an essential field is unset or empty")
 	ErrVerificationFailed   = errors.New("the signature couldn't be verified by any of the available public keys")
 )
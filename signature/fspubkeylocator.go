@@ -26,6 +26,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -47,6 +48,10 @@ const tracerName = "github.com/eiffel-community/eiffelevents-sdk-go/signature"
 // the DN are used. For example, "CN=joe,O=Acme.pem" and "cn=joe, o=Acme.pem" contain
 // equivalent DNs and the keys in both files are returned if a lookup is made for
 // any of those DN (or some other equivalent form of that DN).
+//
+// RSA, ECDSA, and Ed25519 public keys are supported. They must be encoded
+// as PKIX (SubjectPublicKeyInfo), which is what e.g. "openssl pkey -pubout"
+// produces.
 type FSPublicKeyLocator struct {
 	cfg       FSPublicKeyLocatorConfig
 	keyLoader func(pemData []byte) ([]crypto.PublicKey, error) // Allows mocking in tests.
@@ -123,44 +128,59 @@ func (pkl *FSPublicKeyLocator) MaybeScan(ctx context.Context) (err error) {
 		span.End()
 	}()
 
-	files, err := os.ReadDir(pkl.cfg.KeyDirectory)
-	if err != nil {
-		return fmt.Errorf("error scanning public key directory: %w", err)
-	}
-
 	// Clear the cache before starting to repopulate it. If there's an error
 	// scanning for keys we'll just continuously return errors, i.e. we won't
 	// attempt to be clever and use stale data until the problem has been
 	// corrected. This isn't so much for philosophical reasons but rather to
 	// keep the code simple. It's up to the caller to throttle retries.
 	pkl.keyCache = pkl.keyCache[:0]
-	for _, f := range files {
-		if filepath.Ext(f.Name()) != ".pem" {
-			continue
-		}
-		pemData, err := os.ReadFile(filepath.Join(pkl.cfg.KeyDirectory, f.Name()))
-		if errors.Is(err, fs.ErrNotExist) {
-			// It's okay if the file doesn't exist. It probably indicates that someone
-			// changed the contents of the key directory after we read its contents,
-			// and we're supposed to support hot reloads of the keys.
-			continue
-		} else if err != nil {
-			return fmt.Errorf("error reading PEM file: %w", err)
-		}
+	err = readDirectoryFiles(pkl.cfg.KeyDirectory, []string{".pem"}, func(name string, pemData []byte) error {
 		keys, err := pkl.keyLoader(pemData)
 		if err != nil {
-			return fmt.Errorf("error extracting public keys from %q: %w", f.Name(), err)
+			return fmt.Errorf("error extracting public keys from %q: %w", name, err)
 		}
-		identity, err := NewAuthorIdentity(strings.TrimSuffix(f.Name(), ".pem"))
+		identity, err := NewAuthorIdentity(strings.TrimSuffix(name, ".pem"))
 		if err != nil {
-			return fmt.Errorf("error parsing %q as a DN: %w", f.Name(), err)
+			return fmt.Errorf("error parsing %q as a DN: %w", name, err)
 		}
 		pkl.keyCache = append(pkl.keyCache, keyCacheEntry{identity: identity, keys: keys})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error scanning public key directory: %w", err)
 	}
 	pkl.lastScan = time.Now().UTC()
 	return nil
 }
 
+// readDirectoryFiles calls the provided function with the name and contents
+// of each file in a directory whose extension is among the given ones.
+// Files that disappear between the listing of the directory and
+// the reading of the file are ignored since they probably indicate that
+// someone changed the contents of the directory while we were scanning it,
+// and the locators are supposed to support hot reloads.
+func readDirectoryFiles(dir string, exts []string, f func(name string, data []byte) error) error {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !slices.Contains(exts, filepath.Ext(file.Name())) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("error reading %q: %w", file.Name(), err)
+		}
+		if err := f(file.Name(), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func publicKeysFromPEMData(pemData []byte) ([]crypto.PublicKey, error) {
 	var result []crypto.PublicKey
 	for block, remaining := pem.Decode(pemData); block != nil; block, remaining = pem.Decode(remaining) {
@@ -19,6 +19,7 @@ package signature
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"os"
 	"path/filepath"
@@ -154,6 +155,13 @@ func TestPublicKeysInPEMFile(t *testing.T) {
 				&ecdsa.PublicKey{},
 			},
 		},
+		{
+			name:    "Ed25519 key",
+			pemFile: "ed25519.pem",
+			expectedKeyTypes: []crypto.PublicKey{
+				ed25519.PublicKey{},
+			},
+		},
 		{
 			name:    "RSA and ECDSA keys",
 			pemFile: "rsa_ecdsa.pem",
@@ -0,0 +1,152 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FSSecretLocator is a SecretLocator implementation that locates shared
+// secrets in a file system directory. The files should be named after
+// the distinguished name (DN) of the identity that signs with the secrets,
+// plus a .secret extension, e.g. "CN=joe,O=Acme.secret". Each file contains
+// one or more base64-encoded secrets, one per line. Empty lines are ignored.
+//
+// Just like with FSPublicKeyLocator there may be multiple files for
+// equivalent representations of a DN, and the secrets in all of them are
+// returned. Make sure that the directory and the files are only readable
+// by the user running the program that verifies the events.
+type FSSecretLocator struct {
+	cfg FSSecretLocatorConfig
+
+	// Fields protected by the mutex.
+	mu          sync.RWMutex
+	secretCache []secretCacheEntry
+	lastScan    time.Time
+}
+
+type FSSecretLocatorConfig struct {
+	// SecretDirectory is path to the file system directory containing
+	// the files from which secrets should be loaded.
+	SecretDirectory string `json:"secret_directory" yaml:"secret_directory"`
+
+	// CacheTTL is how old the secret cache is allowed to get before it's
+	// rescanned from disk. Zero means that the cache is disabled.
+	CacheTTL time.Duration `json:"cache_ttl" yaml:"cache_ttl"`
+}
+
+type secretCacheEntry struct {
+	identity *AuthorIdentity
+	secrets  [][]byte
+}
+
+func NewFSSecretLocator(cfg FSSecretLocatorConfig) *FSSecretLocator {
+	return &FSSecretLocator{
+		cfg:         cfg,
+		secretCache: make([]secretCacheEntry, 0, 50),
+	}
+}
+
+// Locate looks up the given identity and returns a set of matching secrets.
+// If no secrets match an empty or nil slice is returned.
+func (sl *FSSecretLocator) Locate(ctx context.Context, identity *AuthorIdentity) ([][]byte, error) {
+	if err := sl.MaybeScan(ctx); err != nil {
+		return nil, fmt.Errorf("error refreshing secret cache: %w", err)
+	}
+
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+	var result [][]byte
+	for _, entry := range sl.secretCache {
+		if entry.identity.Equal(identity) {
+			result = append(result, entry.secrets...)
+		}
+	}
+	return result, nil
+}
+
+// MaybeScan (re)scans the directory of secrets if the cache's TTL has expired
+// or the TTL is disabled.
+func (sl *FSSecretLocator) MaybeScan(ctx context.Context) (err error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.cfg.CacheTTL != 0 && time.Since(sl.lastScan) <= sl.cfg.CacheTTL {
+		return nil
+	}
+
+	_, span := otel.GetTracerProvider().Tracer(tracerName).
+		Start(ctx, "Rescan secrets", trace.WithSpanKind(trace.SpanKindInternal))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	// As with FSPublicKeyLocator we don't attempt to use stale data
+	// if the scan fails.
+	sl.secretCache = sl.secretCache[:0]
+	err = readDirectoryFiles(sl.cfg.SecretDirectory, []string{".secret"}, func(name string, data []byte) error {
+		secrets, err := secretsFromData(data)
+		if err != nil {
+			return fmt.Errorf("error extracting secrets from %q: %w", name, err)
+		}
+		identity, err := NewAuthorIdentity(strings.TrimSuffix(name, ".secret"))
+		if err != nil {
+			return fmt.Errorf("error parsing %q as a DN: %w", name, err)
+		}
+		sl.secretCache = append(sl.secretCache, secretCacheEntry{identity: identity, secrets: secrets})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error scanning secret directory: %w", err)
+	}
+	sl.lastScan = time.Now().UTC()
+	return nil
+}
+
+// secretsFromData decodes the base64-encoded secrets found
+// on the non-empty lines of the data.
+func secretsFromData(data []byte) ([][]byte, error) {
+	var result [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		secret, err := base64.StdEncoding.DecodeString(string(line))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding secret on line %d: %w", lineNum, err)
+		}
+		result = append(result, secret)
+	}
+	return result, scanner.Err()
+}
@@ -0,0 +1,108 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSSecretLocator_Locate(t *testing.T) {
+	testcases := []struct {
+		name          string
+		files         map[string]string // filename => contents
+		lookup        string
+		expected      [][]byte
+		expectedError bool
+	}{
+		{
+			name: "Single file with matching identity",
+			files: map[string]string{
+				"CN=test.secret": "c2VjcmV0",
+			},
+			lookup:   "CN=test",
+			expected: [][]byte{[]byte("secret")},
+		},
+		{
+			name: "Single file with equivalent identity",
+			files: map[string]string{
+				"CN=test.secret": "c2VjcmV0\n",
+			},
+			lookup:   "cn=test",
+			expected: [][]byte{[]byte("secret")},
+		},
+		{
+			name: "Two files with equivalent identities and multiple secrets",
+			files: map[string]string{
+				"CN=test.secret": "QQ==\n\nQg==\n",
+				"cn=test.secret": "Qw==",
+			},
+			lookup:   "CN=test",
+			expected: [][]byte{[]byte("A"), []byte("B"), []byte("C")},
+		},
+		{
+			name: "Two files with just one matching",
+			files: map[string]string{
+				"CN=test.secret":  "QQ==",
+				"CN=test2.secret": "Qg==",
+			},
+			lookup:   "CN=test",
+			expected: [][]byte{[]byte("A")},
+		},
+		{
+			name: "Ignores files that don't end with .secret",
+			files: map[string]string{
+				"CN=test":     "QQ==",
+				"CN=test.pem": "Qg==",
+			},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name: "Invalid base64",
+			files: map[string]string{
+				"CN=test.secret": "not base64!",
+			},
+			lookup:        "CN=test",
+			expectedError: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			sl := NewFSSecretLocator(FSSecretLocatorConfig{
+				SecretDirectory: tempDir,
+			})
+
+			for filename, contents := range tc.files {
+				require.NoError(t, os.WriteFile(filepath.Join(tempDir, filename), []byte(contents), 0600))
+			}
+
+			result, err := sl.Locate(t.Context(), mustParseAuthorIdentity(t, tc.lookup))
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, result)
+		})
+	}
+}
@@ -17,16 +17,16 @@
 package signature
 
 import (
+	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 
-	"github.com/gowebpki/jcs"
 	"github.com/tidwall/sjson"
 
 	"github.com/eiffel-community/eiffelevents-sdk-go"
@@ -38,6 +38,9 @@ import (
 type Algorithm string
 
 const (
+	HS256 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS256) // HMAC using SHA-256
+	HS384 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS384) // HMAC using SHA-384
+	HS512 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS512) // HMAC using SHA-512
 	RS256 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_RS256) // RSASSA-PKCS1-v1_5 using SHA-256
 	RS384 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_RS384) // RSASSA-PKCS1-v1_5 using SHA-384
 	RS512 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_RS512) // RSASSA-PKCS1-v1_5 using SHA-512
@@ -49,18 +52,23 @@ const (
 	PS512 = Algorithm(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_PS512) // RSASSA-PSS using SHA-512 and MGF1 with SHA-512
 )
 
+// edDSA is the JOSE identifier for EdDSA signatures (RFC 8037), which this
+// package supports with Ed25519 keys. No version of the meta field includes
+// it among the allowed algorithms yet so it isn't exported; until the schema
+// allows it events can't be signed with EdDSA, but EdDSA signatures are
+// verified like any others.
+const edDSA = Algorithm("EdDSA")
+
 const (
 	authorIdentityField = "meta.security.authorIdentity"
 	algorithmField      = "meta.security.integrityProtection.alg"
 	signatureField      = "meta.security.integrityProtection.signature"
+	publicKeyField      = "meta.security.integrityProtection.publicKey"
 )
 
 // SigningSubject is a representation of an event that potentially could be signed.
-type SigningSubject interface {
-	json.Marshaler
-	eiffelevents.CapabilityTeller
-	eiffelevents.MetaTeller
-}
+// It's an alias of eiffelevents.SigningSubject.
+type SigningSubject = eiffelevents.SigningSubject
 
 // Signer signs Eiffel event payloads in the standard way.
 type Signer struct {
@@ -68,18 +76,28 @@ type Signer struct {
 	alg        Algorithm
 	pk         crypto.PrivateKey
 	hashFunc   func([]byte) []byte
-	signFunc   func(crypto.PrivateKey, crypto.Hash, []byte) ([]byte, error)
+	signFunc   func(context.Context, crypto.PrivateKey, crypto.Hash, []byte) ([]byte, error)
 	signerOpts crypto.SignerOpts
+	publicKey  string // PEM data to store in the publicKey field, if any.
 }
 
+var _ eiffelevents.EventSigner = &Signer{}
+
+// SignerOption is a function that configures a Signer.
+type SignerOption func(*Signer) error
+
 // NewKeySigner initializes a Signer with a private key and an identity.
-func NewKeySigner(identity string, alg Algorithm, pk crypto.PrivateKey) (*Signer, error) {
+// The HMAC algorithms (HS256 etc) aren't supported; use NewHMACSigner
+// for those.
+func NewKeySigner(identity string, alg Algorithm, pk crypto.PrivateKey, opts ...SignerOption) (*Signer, error) {
 	s := &Signer{
 		identity: identity,
 		alg:      alg,
 		pk:       pk,
 	}
 	switch s.alg {
+	case HS256, HS384, HS512:
+		return nil, fmt.Errorf("%w: %s requires a shared secret, use NewHMACSigner", ErrUnsupportedAlgorithm, s.alg)
 	case RS256:
 		s.hashFunc = hashSHA256
 		s.signerOpts = crypto.SHA256
@@ -116,16 +134,61 @@ func NewKeySigner(identity string, alg Algorithm, pk crypto.PrivateKey) (*Signer
 		s.hashFunc = hashSHA512
 		s.signerOpts = crypto.SHA512
 		s.signFunc = signPSS
+	case edDSA:
+		s.hashFunc = hashNone
+		s.signerOpts = crypto.Hash(0)
+		s.signFunc = signEd25519
 	default:
 		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, s.alg)
 	}
+	return s.applyOptions(opts)
+}
+
+// NewHMACSigner initializes a Signer with a secret shared with the receivers
+// of the events and an identity. The algorithm must be HS256, HS384, or HS512.
+// Receivers verify the events with a Verifier that has a SecretLocator
+// (see WithSecretLocator). The secret shouldn't be shorter than the output
+// of the algorithm's hash function, e.g. 32 bytes for HS256.
+func NewHMACSigner(identity string, alg Algorithm, secret []byte, opts ...SignerOption) (*Signer, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("the HMAC secret must not be empty")
+	}
+	s := &Signer{
+		identity: identity,
+		alg:      alg,
+		pk:       secret,
+		hashFunc: hashNone,
+		signFunc: signHMAC,
+	}
+	switch s.alg {
+	case HS256:
+		s.signerOpts = crypto.SHA256
+	case HS384:
+		s.signerOpts = crypto.SHA384
+	case HS512:
+		s.signerOpts = crypto.SHA512
+	default:
+		return nil, fmt.Errorf("%w: %s isn't an HMAC algorithm", ErrUnsupportedAlgorithm, s.alg)
+	}
+	return s.applyOptions(opts)
+}
+
+// applyOptions applies the options to the Signer and returns it,
+// for use in the return statements of the constructors.
+func (s *Signer) applyOptions(opts []SignerOption) (*Signer, error) {
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
 	return s, nil
 }
 
 // Sign signs the provided event and returns it as a byte slice that includes
 // the signature itself and the details needed to verify the signature.
 //
-//   - If the event isn't recent enough to support signing,
+//   - If the event isn't recent enough to support signing, or if its
+//     meta field can't express the signer's algorithm (e.g. EdDSA),
 //     ErrSigningUnavailable is returned.
 //   - If something goes wrong while modifying the event in preparation of
 //     the signing, ErrMarshaling is returned.
@@ -134,9 +197,20 @@ func NewKeySigner(identity string, alg Algorithm, pk crypto.PrivateKey) (*Signer
 // Errors will be returned in wrapped form so make sure you use errors.Is
 // rather than direct comparisons.
 func (s *Signer) Sign(event SigningSubject) ([]byte, error) {
+	return s.SignContext(context.Background(), event)
+}
+
+// SignContext works like Sign but passes the context to the crypto.Signer
+// or RemoteSigner that the Signer delegates the signing to, if any,
+// e.g. so that calls to a remote key store can be canceled or traced.
+// See NewCryptoSigner and NewRemoteSigner.
+func (s *Signer) SignContext(ctx context.Context, event SigningSubject) ([]byte, error) {
 	if !event.SupportsSigning() {
 		return nil, fmt.Errorf("%w: %s %s", ErrSigningUnavailable, event.Type(), event.Version())
 	}
+	if err := checkAlgorithmSupported(event, s.alg); err != nil {
+		return nil, err
+	}
 
 	eventBytes, err := event.MarshalJSON()
 	if err != nil {
@@ -154,11 +228,16 @@ func (s *Signer) Sign(event SigningSubject) ([]byte, error) {
 	if eventBytes, err = sjson.SetBytes(eventBytes, signatureField, ""); err != nil {
 		return nil, errors.Join(ErrMarshaling, err)
 	}
-	if eventBytes, err = jcs.Transform(eventBytes); err != nil {
+	if s.publicKey != "" {
+		if eventBytes, err = sjson.SetBytes(eventBytes, publicKeyField, s.publicKey); err != nil {
+			return nil, errors.Join(ErrMarshaling, err)
+		}
+	}
+	if eventBytes, err = eiffelevents.Canonicalize(eiffelevents.RawEvent(eventBytes)); err != nil {
 		return nil, errors.Join(ErrMarshaling, err)
 	}
 
-	sig, err := s.signFunc(s.pk, s.signerOpts.HashFunc(), s.hashFunc(eventBytes))
+	sig, err := s.signFunc(ctx, s.pk, s.signerOpts.HashFunc(), s.hashFunc(eventBytes))
 	if err != nil {
 		return nil, errors.Join(ErrSigningFailed, err)
 	}
@@ -168,7 +247,23 @@ func (s *Signer) Sign(event SigningSubject) ([]byte, error) {
 	return eventBytes, nil
 }
 
-func signECDSA(priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
+// checkAlgorithmSupported returns an ErrSigningUnavailable error if the
+// algorithm isn't one of the values allowed in the event's alg field.
+// All event versions known to this SDK that support signing have version 3
+// of the meta field. Events of versions unknown to this SDK aren't checked
+// since a newer version of the meta field might allow the algorithm.
+func checkAlgorithmSupported(event SigningSubject, alg Algorithm) error {
+	if !eiffelevents.IsKnownVersion(event.Type(), event.Version()) {
+		return nil
+	}
+	if !eiffelevents.MetaV3SecurityIntegrityProtectionAlg(alg).IsValid() {
+		return fmt.Errorf("%w: the %s algorithm can't be expressed in %s %s",
+			ErrSigningUnavailable, alg, event.Type(), event.Version())
+	}
+	return nil
+}
+
+func signECDSA(_ context.Context, priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
 	privECDSA, ok := priv.(*ecdsa.PrivateKey)
 	if !ok {
 		return nil, fmt.Errorf("%w; expected *ecdsa.PrivateKey, got %T", ErrKeyTypeMismatch, priv)
@@ -176,7 +271,7 @@ func signECDSA(priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte,
 	return ecdsa.SignASN1(rand.Reader, privECDSA, digest)
 }
 
-func signPKCS1v15(priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
+func signPKCS1v15(_ context.Context, priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
 	privRSA, ok := priv.(*rsa.PrivateKey)
 	if !ok {
 		return nil, fmt.Errorf("%w; expected *rsa.PrivateKey, got %T", ErrKeyTypeMismatch, priv)
@@ -184,10 +279,26 @@ func signPKCS1v15(priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]by
 	return rsa.SignPKCS1v15(rand.Reader, privRSA, hash, digest)
 }
 
-func signPSS(priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
+func signPSS(_ context.Context, priv crypto.PrivateKey, hash crypto.Hash, digest []byte) ([]byte, error) {
 	privRSA, ok := priv.(*rsa.PrivateKey)
 	if !ok {
 		return nil, fmt.Errorf("%w; expected *rsa.PrivateKey, got %T", ErrKeyTypeMismatch, priv)
 	}
 	return rsa.SignPSS(rand.Reader, privRSA, hash, digest, nil)
 }
+
+func signEd25519(_ context.Context, priv crypto.PrivateKey, hash crypto.Hash, message []byte) ([]byte, error) {
+	privEd25519, ok := priv.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w; expected ed25519.PrivateKey, got %T", ErrKeyTypeMismatch, priv)
+	}
+	return ed25519.Sign(privEd25519, message), nil
+}
+
+func signHMAC(_ context.Context, secret crypto.PrivateKey, hash crypto.Hash, message []byte) ([]byte, error) {
+	secretBytes, ok := secret.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w; expected []byte, got %T", ErrKeyTypeMismatch, secret)
+	}
+	return computeHMAC(secretBytes, hash, message), nil
+}
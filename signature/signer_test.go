@@ -19,6 +19,7 @@ package signature
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -43,12 +44,19 @@ func generateECDSAKey(t *testing.T, c elliptic.Curve) crypto.Signer {
 	return s
 }
 
+func generateEd25519Key(t *testing.T) crypto.Signer {
+	_, s, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return s
+}
+
 func TestSigner(t *testing.T) {
 	const identity = "CN=test"
 	rsaKey := generateRSAKey(t)
 	ecdsa256Key := generateECDSAKey(t, elliptic.P256())
 	ecdsa384Key := generateECDSAKey(t, elliptic.P384())
 	ecdsa521Key := generateECDSAKey(t, elliptic.P521())
+	ed25519Key := generateEd25519Key(t)
 
 	testcases := []struct {
 		name          string
@@ -111,6 +119,27 @@ func TestSigner(t *testing.T) {
 			key:          rsaKey,
 			eventFactory: func() (SigningSubject, error) { return rooteiffelevents.NewCompositionDefinedV3() },
 		},
+		{
+			name:          "EdDSA can't be expressed in MetaV3",
+			alg:           edDSA,
+			key:           ed25519Key,
+			eventFactory:  func() (SigningSubject, error) { return rooteiffelevents.NewCompositionDefinedV3() },
+			expectedError: ErrSigningUnavailable,
+		},
+		{
+			// No event version known to this SDK can express EdDSA,
+			// so pretend that the event is of a future version that can.
+			name: "Happy path with EdDSA in an unknown event version",
+			alg:  edDSA,
+			key:  ed25519Key,
+			eventFactory: func() (SigningSubject, error) {
+				event, err := rooteiffelevents.NewCompositionDefinedV3()
+				if err == nil {
+					event.Meta.Version = "3.99.0"
+				}
+				return event, err
+			},
+		},
 		{
 			name:          "Algorithm and key mismatch",
 			alg:           RS256,
@@ -147,3 +176,63 @@ func TestSigner(t *testing.T) {
 		})
 	}
 }
+
+func TestSignerWithProducer(t *testing.T) {
+	const identity = "CN=test"
+	signer, err := NewKeySigner(identity, ES256, generateECDSAKey(t, elliptic.P256()))
+	require.NoError(t, err)
+	producer := rooteiffelevents.NewProducer(rooteiffelevents.ProducerConfig{
+		SourceName: "my-producer",
+		Signer:     signer,
+	})
+
+	event, err := producer.Factory().NewCompositionDefinedV3()
+	require.NoError(t, err)
+	b, err := producer.Marshal(event)
+	require.NoError(t, err)
+	assert.Equal(t, "my-producer", gjson.GetBytes(b, "meta.source.name").String())
+	assert.Equal(t, identity, gjson.GetBytes(b, authorIdentityField).String())
+	assert.NotEmpty(t, gjson.GetBytes(b, signatureField).String())
+}
+
+func TestNewHMACSigner(t *testing.T) {
+	testcases := []struct {
+		name          string
+		alg           Algorithm
+		secret        []byte
+		expectedError error
+	}{
+		{
+			name:   "HS256",
+			alg:    HS256,
+			secret: []byte("secret"),
+		},
+		{
+			name:   "HS512",
+			alg:    HS512,
+			secret: []byte("secret"),
+		},
+		{
+			name:          "Not an HMAC algorithm",
+			alg:           RS256,
+			secret:        []byte("secret"),
+			expectedError: ErrUnsupportedAlgorithm,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewHMACSigner("CN=test", tc.alg, tc.secret)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+
+	_, err := NewHMACSigner("CN=test", HS256, nil)
+	require.Error(t, err)
+
+	_, err = NewKeySigner("CN=test", HS256, []byte("secret"))
+	require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+}
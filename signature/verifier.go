@@ -20,15 +20,18 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/sha512"
+	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/gowebpki/jcs"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
@@ -44,31 +47,89 @@ type PublicKeyLocator interface {
 	Locate(ctx context.Context, identity *AuthorIdentity) ([]crypto.PublicKey, error)
 }
 
+// TimedPublicKeyLocator is an optional interface that a PublicKeyLocator
+// can implement if the validity of its keys depends on time, e.g. because
+// they come from certificates with a limited validity period. The Verifier
+// then calls LocateAt with the time of the event (meta.time) instead of
+// calling Locate.
+type TimedPublicKeyLocator interface {
+	PublicKeyLocator
+
+	// LocateAt works like Locate but only returns keys that were valid
+	// for the provided identity at the given time.
+	LocateAt(ctx context.Context, identity *AuthorIdentity, t time.Time) ([]crypto.PublicKey, error)
+}
+
+// SecretLocator locates one or more secrets that can be used to verify
+// HMAC signatures (HS256 etc) made by a given identity.
+type SecretLocator interface {
+	// Locate returns one or more secrets corresponding to the provided identity,
+	// or an empty or nil slice if no secrets were found. An error return indicates
+	// that the lookup itself failed.
+	Locate(ctx context.Context, identity *AuthorIdentity) ([][]byte, error)
+}
+
 // Verifier can verify whether the signature of a given Eiffel event matches
-// any of the keys known by the associated PublicKeyLocator.
+// any of the keys known by the associated PublicKeyLocator, or for HMAC
+// signatures any of the secrets known by the associated SecretLocator.
+// It can also be configured to use public keys embedded in the events,
+// as long as they can be trusted; see WithTrustedRoots and WithPinnedKeys.
 type Verifier struct {
-	keyLocator      PublicKeyLocator
-	identityCache   map[string]*AuthorIdentity
-	identityCacheMu sync.Mutex
+	keyLocator           PublicKeyLocator
+	secretLocator        SecretLocator
+	trustedRoots         *x509.CertPool
+	pinnedKeys           []pinnedKeys
+	certificateKeyUsages []x509.ExtKeyUsage
+	revocationChecker    RevocationChecker
+	identityCache        map[string]*AuthorIdentity
+	identityCacheMu      sync.Mutex
 }
 
-func NewVerifier(keyLocator PublicKeyLocator) *Verifier {
-	return &Verifier{
-		keyLocator:    keyLocator,
-		identityCache: make(map[string]*AuthorIdentity),
+// VerifierOption is a function that configures a Verifier.
+type VerifierOption func(*Verifier)
+
+// WithSecretLocator enables verification of events signed with the HMAC
+// algorithms (HS256 etc), using the given SecretLocator to look up the
+// shared secrets of the signing identities.
+func WithSecretLocator(secretLocator SecretLocator) VerifierOption {
+	return func(v *Verifier) {
+		v.secretLocator = secretLocator
+	}
+}
+
+// NewVerifier returns a new Verifier that looks up public keys with
+// the given PublicKeyLocator. The PublicKeyLocator may be nil if only
+// HMAC signatures or signatures made with trusted embedded public keys
+// should be verified.
+func NewVerifier(keyLocator PublicKeyLocator, opts ...VerifierOption) *Verifier {
+	v := &Verifier{
+		keyLocator:           keyLocator,
+		certificateKeyUsages: defaultCertificateKeyUsages,
+		identityCache:        make(map[string]*AuthorIdentity),
+	}
+	for _, opt := range opts {
+		opt(v)
 	}
+	return v
 }
 
 // Verify attempts to verify the signature of the provided event payload,
 // using the Verifier's PublicKeyLocator to obtain a public key suitable
-// for verifying the event.
+// for verifying the event. If the event has an embedded public key that
+// the Verifier has been configured to trust, that key is used instead.
 //
 //   - If the event can't be verified because of its contents, e.g. because
 //     it doesn't include a signature, ErrUnverifiableEvent is returned.
-//   - If the event is signed with an unsupported algorithm,
+//   - If the event is signed with an unsupported algorithm, including
+//     HMAC algorithms when the Verifier has no SecretLocator and other
+//     algorithms when it has no PublicKeyLocator,
 //     ErrUnsupportedAlgorithm is returned.
 //   - If no public key that matches the event sender's identity was found,
-//     ErrPublicKeyNotFound is returned.
+//     ErrPublicKeyNotFound is returned. For HMAC signatures ErrSecretNotFound
+//     is returned if no secret was found. If the event's embedded public
+//     key couldn't be trusted the error also wraps ErrUntrustedPublicKey.
+//   - If the event's embedded public key couldn't be trusted and there's
+//     no PublicKeyLocator to fall back to, ErrUntrustedPublicKey is returned.
 //   - If the public key that was found doesn't match the algorithm in
 //     the event payload, ErrKeyTypeMismatch is returned.
 //   - If something goes wrong while modifying the event in preparation of
@@ -82,10 +143,12 @@ func NewVerifier(keyLocator PublicKeyLocator) *Verifier {
 func (v *Verifier) Verify(ctx context.Context, event []byte) error {
 	// Extract the signature itself and the other fields we need for
 	// the verification and return an error if either of them are missing.
-	values := gjson.GetManyBytes(event, algorithmField, authorIdentityField, signatureField)
+	values := gjson.GetManyBytes(event, algorithmField, authorIdentityField, signatureField, publicKeyField, "meta.time")
 	alg := values[0].String()
 	identity := values[1].String()
 	sig := values[2].String()
+	embeddedKey := values[3].String()
+	eventTime := time.UnixMilli(values[4].Int())
 
 	if alg == "" {
 		return fmt.Errorf("%w: %s", ErrUnverifiableEvent, algorithmField)
@@ -104,6 +167,18 @@ func (v *Verifier) Verify(ctx context.Context, event []byte) error {
 	)
 
 	switch alg {
+	case string(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS256):
+		hash = crypto.SHA256
+		hashFunc = hashNone
+		verifyFunc = verifyHMAC
+	case string(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS384):
+		hash = crypto.SHA384
+		hashFunc = hashNone
+		verifyFunc = verifyHMAC
+	case string(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_HS512):
+		hash = crypto.SHA512
+		hashFunc = hashNone
+		verifyFunc = verifyHMAC
 	case string(eiffelevents.MetaV3SecurityIntegrityProtectionAlg_RS256):
 		hash = crypto.SHA256
 		hashFunc = hashSHA256
@@ -140,16 +215,26 @@ func (v *Verifier) Verify(ctx context.Context, event []byte) error {
 		hash = crypto.SHA512
 		hashFunc = hashSHA512
 		verifyFunc = verifyPSS
+	case string(edDSA):
+		hashFunc = hashNone
+		verifyFunc = verifyEd25519
 	default:
 		return fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, alg)
 	}
+	isHMAC := isHMACAlgorithm(alg)
+	if isHMAC && v.secretLocator == nil {
+		return fmt.Errorf("%w: %s (no secret locator configured)", ErrUnsupportedAlgorithm, alg)
+	}
+	if !isHMAC && v.keyLocator == nil && !v.trustsEmbeddedKeys() {
+		return fmt.Errorf("%w: %s (no public key locator configured)", ErrUnsupportedAlgorithm, alg)
+	}
 
 	// Clear the signature field and transform the event to canonical JSON.
 	var err error
 	if event, err = sjson.SetBytes(event, signatureField, ""); err != nil {
 		return errors.Join(ErrMarshaling, err)
 	}
-	if event, err = jcs.Transform(event); err != nil {
+	if event, err = eiffelevents.Canonicalize(eiffelevents.RawEvent(event)); err != nil {
 		return errors.Join(ErrMarshaling, err)
 	}
 
@@ -169,12 +254,49 @@ func (v *Verifier) Verify(ctx context.Context, event []byte) error {
 		return errors.Join(ErrMarshaling, err)
 	}
 
-	keys, err := v.keyLocator.Locate(ctx, dn)
-	if err != nil {
-		return errors.Join(fmt.Errorf("%w: %s", ErrPublicKeyLookup, identity), err)
-	}
-	if len(keys) == 0 {
-		return errors.Join(fmt.Errorf("%w: %s", ErrPublicKeyNotFound, identity), err)
+	var keys []crypto.PublicKey
+	if isHMAC {
+		secrets, err := v.secretLocator.Locate(ctx, dn)
+		if err != nil {
+			return errors.Join(fmt.Errorf("%w: %s", ErrSecretLookup, identity), err)
+		}
+		if len(secrets) == 0 {
+			return fmt.Errorf("%w: %s", ErrSecretNotFound, identity)
+		}
+		for _, secret := range secrets {
+			keys = append(keys, secret)
+		}
+	} else {
+		// Prefer a trusted embedded key, but remember why an embedded key
+		// wasn't trusted so that it can be reported if the lookup fails too.
+		var untrustedErr error
+		if embeddedKey != "" && v.trustsEmbeddedKeys() {
+			key, err := v.trustedEmbeddedKey(ctx, embeddedKey, dn, eventTime)
+			if err == nil {
+				keys = []crypto.PublicKey{key}
+			}
+			untrustedErr = err
+		}
+		if len(keys) == 0 {
+			if v.keyLocator == nil {
+				if untrustedErr != nil {
+					return untrustedErr
+				}
+				return fmt.Errorf("%w: %s (no embedded public key and no public key locator configured)",
+					ErrPublicKeyNotFound, identity)
+			}
+			if tl, ok := v.keyLocator.(TimedPublicKeyLocator); ok {
+				keys, err = tl.LocateAt(ctx, dn, eventTime)
+			} else {
+				keys, err = v.keyLocator.Locate(ctx, dn)
+			}
+			if err != nil {
+				return errors.Join(fmt.Errorf("%w: %s", ErrPublicKeyLookup, identity), err, untrustedErr)
+			}
+			if len(keys) == 0 {
+				return errors.Join(fmt.Errorf("%w: %s", ErrPublicKeyNotFound, identity), untrustedErr)
+			}
+		}
 	}
 
 	// Collect the error for each public key we try, and start with
@@ -206,6 +328,12 @@ func (v *Verifier) lookupIdentity(identity string) (*AuthorIdentity, error) {
 	return dn, nil
 }
 
+// hashNone returns the data as-is, for algorithms like Ed25519
+// that sign the message itself rather than a digest of it.
+func hashNone(data []byte) []byte {
+	return data
+}
+
 func hashSHA256(data []byte) []byte {
 	h := sha256.Sum256(data)
 	return h[:]
@@ -233,6 +361,43 @@ func verifyECDSA(pub crypto.PublicKey, hash crypto.Hash, digest []byte, sig []by
 	}
 }
 
+// isHMACAlgorithm returns true if the algorithm is one of the HMAC
+// algorithms, whose signatures are verified with shared secrets.
+func isHMACAlgorithm(alg string) bool {
+	return alg == string(HS256) || alg == string(HS384) || alg == string(HS512)
+}
+
+// computeHMAC returns the HMAC of the message, using the given hash function.
+func computeHMAC(secret []byte, hash crypto.Hash, message []byte) []byte {
+	mac := hmac.New(hash.New, secret)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+// verifyHMAC checks an HMAC signature. The key is the shared secret
+// as a byte slice. The comparison is done in constant time.
+func verifyHMAC(key crypto.PublicKey, hash crypto.Hash, message []byte, sig []byte) error {
+	secret, ok := key.([]byte)
+	if !ok {
+		return fmt.Errorf("%w; expected []byte, got %T", ErrKeyTypeMismatch, key)
+	}
+	if hmac.Equal(computeHMAC(secret, hash, message), sig) {
+		return nil
+	}
+	return ErrSignatureMismatch
+}
+
+func verifyEd25519(pub crypto.PublicKey, hash crypto.Hash, message []byte, sig []byte) error {
+	pubEd25519, ok := pub.(ed25519.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w; expected ed25519.PublicKey, got %T", ErrKeyTypeMismatch, pub)
+	}
+	if ed25519.Verify(pubEd25519, message, sig) {
+		return nil
+	}
+	return ErrSignatureMismatch
+}
+
 func verifyPKCS1v15(pub crypto.PublicKey, hash crypto.Hash, digest []byte, sig []byte) error {
 	pubRSA, ok := pub.(*rsa.PublicKey)
 	if !ok {
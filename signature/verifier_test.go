@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	rooteiffelevents "github.com/eiffel-community/eiffelevents-sdk-go"
 	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go/editions/lyon"
 )
 
@@ -139,6 +140,174 @@ func TestSignAndVerify(t *testing.T) {
 	}
 }
 
+func TestSignAndVerifyRawEvent(t *testing.T) {
+	rsaKey := generateRSAKey(t)
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+
+	signer, err := NewKeySigner("CN=test", RS256, rsaKey)
+	require.NoError(t, err)
+	b, err := signer.Sign(rooteiffelevents.RawEvent(event.String()))
+	require.NoError(t, err)
+
+	verifier := NewVerifier(&constantPublicKeyLocator{[]crypto.PublicKey{rsaKey.Public()}, nil})
+	require.NoError(t, verifier.Verify(t.Context(), rooteiffelevents.RawEvent(b)))
+}
+
+// TestVerifyEd25519 tests the verification of EdDSA signatures on its own
+// since no event version can express the EdDSA algorithm yet, i.e. there
+// aren't any events that can be signed with it.
+func TestVerifyEd25519(t *testing.T) {
+	ed25519Key := generateEd25519Key(t)
+	otherEd25519Key := generateEd25519Key(t)
+	ecdsaKey := generateECDSAKey(t, elliptic.P256())
+	message := []byte(`{"meta":{"id":"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"}}`)
+
+	testcases := []struct {
+		name          string
+		publicKey     crypto.PublicKey
+		message       []byte
+		expectedError error
+	}{
+		{
+			name:      "Happy path",
+			publicKey: ed25519Key.Public(),
+			message:   message,
+		},
+		{
+			name:          "Wrong key",
+			publicKey:     otherEd25519Key.Public(),
+			message:       message,
+			expectedError: ErrSignatureMismatch,
+		},
+		{
+			name:          "Wrong key type",
+			publicKey:     ecdsaKey.Public(),
+			message:       message,
+			expectedError: ErrKeyTypeMismatch,
+		},
+		{
+			name:          "Modified message",
+			publicKey:     ed25519Key.Public(),
+			message:       []byte(`{"meta":{"id":"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}}`),
+			expectedError: ErrSignatureMismatch,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			sig, err := signEd25519(t.Context(), ed25519Key, crypto.Hash(0), message)
+			require.NoError(t, err)
+
+			err = verifyEd25519(tc.publicKey, crypto.Hash(0), tc.message, sig)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestSignAndVerifyHMAC(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	otherSecret := []byte("fedcba9876543210fedcba9876543210")
+
+	testcases := []struct {
+		name          string
+		alg           Algorithm
+		secretLocator SecretLocator
+		expectedError error
+	}{
+		{
+			name:          "Happy path with HS256",
+			alg:           HS256,
+			secretLocator: &constantSecretLocator{[][]byte{secret}, nil},
+		},
+		{
+			name:          "Happy path with HS384",
+			alg:           HS384,
+			secretLocator: &constantSecretLocator{[][]byte{secret}, nil},
+		},
+		{
+			name:          "Happy path with HS512",
+			alg:           HS512,
+			secretLocator: &constantSecretLocator{[][]byte{secret}, nil},
+		},
+		{
+			name:          "Multiple matching secrets",
+			alg:           HS256,
+			secretLocator: &constantSecretLocator{[][]byte{otherSecret, secret}, nil},
+		},
+		{
+			name:          "Wrong secret",
+			alg:           HS256,
+			secretLocator: &constantSecretLocator{[][]byte{otherSecret}, nil},
+			expectedError: ErrSignatureMismatch,
+		},
+		{
+			name:          "No matching secrets",
+			alg:           HS256,
+			secretLocator: &constantSecretLocator{nil, nil},
+			expectedError: ErrSecretNotFound,
+		},
+		{
+			name:          "Secret lookup error",
+			alg:           HS256,
+			secretLocator: &constantSecretLocator{nil, errors.New("random error")},
+			expectedError: ErrSecretLookup,
+		},
+		{
+			name:          "No secret locator",
+			alg:           HS256,
+			expectedError: ErrUnsupportedAlgorithm,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s: %s", tc.alg, tc.name), func(t *testing.T) {
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+
+			signer, err := NewHMACSigner("CN=test", tc.alg, secret)
+			require.NoError(t, err)
+			b, err := signer.Sign(event)
+			require.NoError(t, err)
+
+			var opts []VerifierOption
+			if tc.secretLocator != nil {
+				opts = append(opts, WithSecretLocator(tc.secretLocator))
+			}
+			err = NewVerifier(nil, opts...).Verify(t.Context(), b)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestVerifyWithoutPublicKeyLocator(t *testing.T) {
+	rsaKey := generateRSAKey(t)
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+	signer, err := NewKeySigner("CN=test", RS256, rsaKey)
+	require.NoError(t, err)
+	b, err := signer.Sign(event)
+	require.NoError(t, err)
+
+	err = NewVerifier(nil, WithSecretLocator(&constantSecretLocator{})).Verify(t.Context(), b)
+	require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+}
+
+type constantSecretLocator struct {
+	secrets [][]byte
+	err     error
+}
+
+func (csl *constantSecretLocator) Locate(ctx context.Context, identity *AuthorIdentity) ([][]byte, error) {
+	return csl.secrets, csl.err
+}
+
 type constantPublicKeyLocator struct {
 	keys []crypto.PublicKey
 	err  error
@@ -0,0 +1,320 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// X509PublicKeyLocator is a PublicKeyLocator implementation that locates
+// public keys in X.509 certificates stored as PEM files in a file system
+// directory. Unlike FSPublicKeyLocator the names of the files don't matter;
+// a certificate's key is returned for an identity if the certificate's
+// subject DN is equal to the identity, the certificate permits code signing,
+// and it chains to one of the configured root certificates. The file names
+// must still have a .pem extension.
+//
+// Non-CA certificates in the directory are candidates for lookups while
+// all certificates in the directory are available as intermediates when
+// building chains, i.e. intermediate CA certificates can either be stored
+// in their own files or be appended to the certificates they've issued.
+//
+// X509PublicKeyLocator implements TimedPublicKeyLocator, so when used with
+// a Verifier the certificates' validity periods are checked against the
+// event's meta.time rather than the current time. If CRLs are configured,
+// keys whose certificate or any of its issuing certificates have been
+// revoked are never returned, regardless of the revocation time.
+//
+// By default a certificate whose issuer hasn't published a current CRL
+// in the CRL directory is accepted. A CRL is current if its NextUpdate is
+// unset or isn't before the time of the lookup. Stale CRLs are still used
+// to reject revoked certificates but they don't vouch for the remaining
+// ones. Set RequireCRL to instead reject certificates that aren't covered
+// by a current CRL.
+//
+// X509PublicKeyLocator also implements RevocationChecker, so the same
+// CRLs and policy can be applied to certificates embedded in events by
+// passing the locator to WithRevocationChecker.
+type X509PublicKeyLocator struct {
+	cfg X509PublicKeyLocatorConfig
+
+	// Fields protected by the mutex.
+	mu            sync.RWMutex
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	certCache     []certCacheEntry
+	crls          []*x509.RevocationList
+	lastScan      time.Time
+}
+
+type X509PublicKeyLocatorConfig struct {
+	// CertificateDirectory is the path to the file system directory containing
+	// the PEM files from which certificates should be loaded.
+	CertificateDirectory string `json:"certificate_directory" yaml:"certificate_directory"`
+
+	// RootCertificateFile is the path to a PEM file with the root certificates
+	// that the certificates must chain to.
+	RootCertificateFile string `json:"root_certificate_file" yaml:"root_certificate_file"`
+
+	// CRLDirectory is the path to an optional file system directory containing
+	// certificate revocation lists, either PEM-encoded (with a .pem extension)
+	// or DER-encoded (with a .crl extension).
+	CRLDirectory string `json:"crl_directory" yaml:"crl_directory"`
+
+	// RequireCRL makes the locator reject certificates, including
+	// intermediate CA certificates, whose issuer doesn't have a current CRL
+	// in CRLDirectory.
+	RequireCRL bool `json:"require_crl" yaml:"require_crl"`
+
+	// CacheTTL is how old the certificate cache is allowed to get before it's
+	// rescanned from disk. Zero means that the cache is disabled.
+	CacheTTL time.Duration `json:"cache_ttl" yaml:"cache_ttl"`
+}
+
+type certCacheEntry struct {
+	identity *AuthorIdentity
+	cert     *x509.Certificate
+}
+
+var (
+	_ RevocationChecker     = &X509PublicKeyLocator{}
+	_ TimedPublicKeyLocator = &X509PublicKeyLocator{}
+)
+
+func NewX509PublicKeyLocator(cfg X509PublicKeyLocatorConfig) *X509PublicKeyLocator {
+	return &X509PublicKeyLocator{
+		cfg:       cfg,
+		certCache: make([]certCacheEntry, 0, 50),
+	}
+}
+
+// Locate looks up the given identity and returns the public keys of
+// the matching certificates that are currently valid.
+// If no keys match an empty or nil slice is returned.
+func (pkl *X509PublicKeyLocator) Locate(ctx context.Context, identity *AuthorIdentity) ([]crypto.PublicKey, error) {
+	return pkl.LocateAt(ctx, identity, time.Now())
+}
+
+// LocateAt looks up the given identity and returns the public keys of
+// the matching certificates that were valid at the given time, i.e. whose
+// chains to a root certificate were within their validity periods and
+// none of whose certificates have been revoked.
+// If no keys match an empty or nil slice is returned.
+func (pkl *X509PublicKeyLocator) LocateAt(ctx context.Context, identity *AuthorIdentity, t time.Time) ([]crypto.PublicKey, error) {
+	if err := pkl.MaybeScan(ctx); err != nil {
+		return nil, fmt.Errorf("error refreshing certificate cache: %w", err)
+	}
+
+	pkl.mu.RLock()
+	defer pkl.mu.RUnlock()
+	var result []crypto.PublicKey
+	for _, entry := range pkl.certCache {
+		if !entry.identity.Equal(identity) {
+			continue
+		}
+		chains, err := entry.cert.Verify(x509.VerifyOptions{
+			Roots:         pkl.roots,
+			Intermediates: pkl.intermediates,
+			CurrentTime:   t,
+			KeyUsages:     defaultCertificateKeyUsages,
+		})
+		if err != nil {
+			continue
+		}
+		if slices.ContainsFunc(chains, func(chain []*x509.Certificate) bool { return pkl.checkChain(chain, t) == nil }) {
+			result = append(result, entry.cert.PublicKey)
+		}
+	}
+	return result, nil
+}
+
+// CheckRevocation checks a certificate chain, e.g. one built from
+// certificates embedded in an event, against the locator's CRLs in the
+// same way as the chains of the certificates that the locator returns.
+func (pkl *X509PublicKeyLocator) CheckRevocation(ctx context.Context, chain []*x509.Certificate, t time.Time) error {
+	if err := pkl.MaybeScan(ctx); err != nil {
+		return fmt.Errorf("error refreshing certificate cache: %w", err)
+	}
+
+	pkl.mu.RLock()
+	defer pkl.mu.RUnlock()
+	return pkl.checkChain(chain, t)
+}
+
+// checkChain returns an error wrapping ErrCertificateRevoked if any of the
+// certificates in the chain have been revoked by a CRL issued by the next
+// certificate in the chain. If RequireCRL is set, each certificate must
+// also be covered by a CRL that's current at time t.
+func (pkl *X509PublicKeyLocator) checkChain(chain []*x509.Certificate, t time.Time) error {
+	for i := 0; i < len(chain)-1; i++ {
+		cert, issuer := chain[i], chain[i+1]
+		covered := false
+		for _, crl := range pkl.crls {
+			if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) || crl.CheckSignatureFrom(issuer) != nil {
+				continue
+			}
+			for _, revoked := range crl.RevokedCertificateEntries {
+				if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+					return fmt.Errorf("%w: %s has been revoked", ErrCertificateRevoked, cert.Subject)
+				}
+			}
+			if crl.NextUpdate.IsZero() || !crl.NextUpdate.Before(t) {
+				covered = true
+			}
+		}
+		if pkl.cfg.RequireCRL && !covered {
+			return fmt.Errorf("%w: no current CRL covers %s", ErrCertificateRevoked, cert.Subject)
+		}
+	}
+	return nil
+}
+
+// MaybeScan (re)loads the certificates and CRLs if the cache's TTL
+// has expired or the TTL is disabled.
+func (pkl *X509PublicKeyLocator) MaybeScan(ctx context.Context) (err error) {
+	pkl.mu.Lock()
+	defer pkl.mu.Unlock()
+
+	if pkl.cfg.CacheTTL != 0 && time.Since(pkl.lastScan) <= pkl.cfg.CacheTTL {
+		return nil
+	}
+
+	_, span := otel.GetTracerProvider().Tracer(tracerName).
+		Start(ctx, "Rescan certificates", trace.WithSpanKind(trace.SpanKindInternal))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	rootData, err := os.ReadFile(pkl.cfg.RootCertificateFile)
+	if err != nil {
+		return fmt.Errorf("error reading root certificate file: %w", err)
+	}
+	rootCerts, err := certificatesFromPEMData(rootData)
+	if err != nil {
+		return fmt.Errorf("error extracting root certificates from %q: %w", pkl.cfg.RootCertificateFile, err)
+	}
+	if len(rootCerts) == 0 {
+		return fmt.Errorf("no root certificates found in %q", pkl.cfg.RootCertificateFile)
+	}
+
+	// As with FSPublicKeyLocator, clear the cache before repopulating it
+	// and keep returning errors until any problems have been corrected.
+	pkl.roots = x509.NewCertPool()
+	for _, cert := range rootCerts {
+		pkl.roots.AddCert(cert)
+	}
+	pkl.intermediates = x509.NewCertPool()
+	pkl.certCache = pkl.certCache[:0]
+	pkl.crls = nil
+	pkl.lastScan = time.Time{}
+
+	err = readDirectoryFiles(pkl.cfg.CertificateDirectory, []string{".pem"}, func(name string, data []byte) error {
+		certs, err := certificatesFromPEMData(data)
+		if err != nil {
+			return fmt.Errorf("error extracting certificates from %q: %w", name, err)
+		}
+		for _, cert := range certs {
+			pkl.intermediates.AddCert(cert)
+			if cert.IsCA {
+				continue
+			}
+			identity, err := NewAuthorIdentity(cert.Subject.String())
+			if err != nil {
+				return fmt.Errorf("error parsing subject of certificate in %q as a DN: %w", name, err)
+			}
+			pkl.certCache = append(pkl.certCache, certCacheEntry{identity: identity, cert: cert})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error scanning certificate directory: %w", err)
+	}
+
+	if pkl.cfg.CRLDirectory != "" {
+		err = readDirectoryFiles(pkl.cfg.CRLDirectory, []string{".pem", ".crl"}, func(name string, data []byte) error {
+			crls, err := revocationListsFromData(data)
+			if err != nil {
+				return fmt.Errorf("error extracting CRLs from %q: %w", name, err)
+			}
+			pkl.crls = append(pkl.crls, crls...)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error scanning CRL directory: %w", err)
+		}
+	}
+	pkl.lastScan = time.Now().UTC()
+	return nil
+}
+
+func certificatesFromPEMData(pemData []byte) ([]*x509.Certificate, error) {
+	var result []*x509.Certificate
+	for block, remaining := pem.Decode(pemData); block != nil; block, remaining = pem.Decode(remaining) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %q PEM block: %w", block.Type, err)
+		}
+		result = append(result, cert)
+	}
+	return result, nil
+}
+
+// revocationListsFromData parses either PEM data with one or more
+// "X509 CRL" blocks or a single DER-encoded CRL.
+func revocationListsFromData(data []byte) ([]*x509.RevocationList, error) {
+	block, remaining := pem.Decode(data)
+	if block == nil {
+		crl, err := x509.ParseRevocationList(data)
+		if err != nil {
+			return nil, err
+		}
+		return []*x509.RevocationList{crl}, nil
+	}
+
+	var result []*x509.RevocationList
+	for ; block != nil; block, remaining = pem.Decode(remaining) {
+		if block.Type != "X509 CRL" {
+			continue
+		}
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %q PEM block: %w", block.Type, err)
+		}
+		result = append(result, crl)
+	}
+	return result, nil
+}
@@ -0,0 +1,475 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go/editions/lyon"
+)
+
+// writePEMFile writes the DER blobs as PEM blocks of the given type to a file.
+func writePEMFile(t *testing.T, path string, blockType string, ders ...[]byte) {
+	var data []byte
+	for _, der := range ders {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+// generateCRL returns a DER-encoded CRL issued by the given CA
+// that revokes the given certificates.
+func generateCRL(t *testing.T, issuer *x509.Certificate, issuerKey crypto.Signer, revoked ...*x509.Certificate) []byte {
+	return generateCRLWithNextUpdate(t, issuer, issuerKey, time.Now().Add(time.Hour), revoked...)
+}
+
+// generateCRLWithNextUpdate works like generateCRL but the CRL's
+// NextUpdate is set to the given time.
+func generateCRLWithNextUpdate(t *testing.T, issuer *x509.Certificate, issuerKey crypto.Signer, nextUpdate time.Time, revoked ...*x509.Certificate) []byte {
+	template := &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: nextUpdate.Add(-2 * time.Hour),
+		NextUpdate: nextUpdate,
+	}
+	for _, cert := range revoked {
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: time.Now(),
+		})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, template, issuer, issuerKey)
+	require.NoError(t, err)
+	return der
+}
+
+func TestX509PublicKeyLocator_LocateAt(t *testing.T) {
+	rootKey := generateECDSAKey(t, elliptic.P256())
+	root := generateCertificate(t, "Root CA", rootKey, true, nil, nil)
+	intermediateKey := generateECDSAKey(t, elliptic.P256())
+	intermediate := generateCertificate(t, "Intermediate CA", intermediateKey, true, root, rootKey)
+	otherRootKey := generateECDSAKey(t, elliptic.P256())
+	otherRoot := generateCertificate(t, "Other CA", otherRootKey, true, nil, nil)
+
+	leafKey := generateECDSAKey(t, elliptic.P256())
+	leaf := generateCertificate(t, "test", leafKey, false, intermediate, intermediateKey)
+	otherLeafKey := generateECDSAKey(t, elliptic.P256())
+	otherLeaf := generateCertificate(t, "test", otherLeafKey, false, intermediate, intermediateKey)
+	untrustedLeaf := generateCertificate(t, "test", otherLeafKey, false, otherRoot, otherRootKey)
+	serverLeaf := generateCertificateWithUsages(t, "test", otherLeafKey, false, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, intermediate, intermediateKey)
+	noUsageLeaf := generateCertificateWithUsages(t, "test", otherLeafKey, false, nil, intermediate, intermediateKey)
+	staleTime := time.Now().Add(-time.Minute)
+
+	testcases := []struct {
+		name       string
+		certs      map[string][]*x509.Certificate // filename => certificates
+		crls       map[string][]byte              // filename => DER-encoded CRL
+		requireCRL bool
+		lookup     string
+		time       time.Time
+		expected   []crypto.PublicKey
+	}{
+		{
+			name:     "Certificate with intermediate in same file",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:     "Certificate with intermediate in separate file",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf}, "ca.pem": {intermediate}},
+			lookup:   "cn=test",
+			expected: []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:     "Multiple certificates for identity",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, otherLeaf}, "ca.pem": {intermediate}},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{leaf.PublicKey, otherLeaf.PublicKey},
+		},
+		{
+			name:     "Subject not matching identity",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=other",
+			expected: nil,
+		},
+		{
+			name:     "CA certificates aren't returned",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=Intermediate CA",
+			expected: nil,
+		},
+		{
+			name:     "Missing intermediate",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf}},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name:     "Untrusted root",
+			certs:    map[string][]*x509.Certificate{"a.pem": {untrustedLeaf, otherRoot}},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name:     "Ignores files that don't end with .pem",
+			certs:    map[string][]*x509.Certificate{"a.crt": {leaf, intermediate}},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name:     "Before NotBefore",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=test",
+			time:     time.Now().Add(-2 * time.Hour),
+			expected: nil,
+		},
+		{
+			name:     "After NotAfter",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=test",
+			time:     time.Now().Add(2 * time.Hour),
+			expected: nil,
+		},
+		{
+			name:     "Revoked certificate",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, otherLeaf, intermediate}},
+			crls:     map[string][]byte{"intermediate.crl": generateCRL(t, intermediate, intermediateKey, leaf)},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{otherLeaf.PublicKey},
+		},
+		{
+			name:     "Revoked certificate in PEM-encoded CRL",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, otherLeaf, intermediate}},
+			crls:     map[string][]byte{"intermediate.pem": generateCRL(t, intermediate, intermediateKey, leaf)},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{otherLeaf.PublicKey},
+		},
+		{
+			name:     "Revoked intermediate",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls:     map[string][]byte{"root.crl": generateCRL(t, root, rootKey, intermediate)},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name:     "CRL from another issuer",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls:     map[string][]byte{"other.crl": generateCRL(t, otherRoot, otherRootKey, leaf)},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:     "Certificate not permitting code signing",
+			certs:    map[string][]*x509.Certificate{"a.pem": {serverLeaf, intermediate}},
+			lookup:   "CN=test",
+			expected: nil,
+		},
+		{
+			name:     "Certificate without extended key usages",
+			certs:    map[string][]*x509.Certificate{"a.pem": {noUsageLeaf, intermediate}},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{noUsageLeaf.PublicKey},
+		},
+		{
+			name:     "Missing CRL accepted by default",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:       "Missing CRL rejected when required",
+			certs:      map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls:       map[string][]byte{"root.crl": generateCRL(t, root, rootKey)},
+			requireCRL: true,
+			lookup:     "CN=test",
+			expected:   nil,
+		},
+		{
+			name:  "CRLs present when required",
+			certs: map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls: map[string][]byte{
+				"root.crl":         generateCRL(t, root, rootKey),
+				"intermediate.crl": generateCRL(t, intermediate, intermediateKey),
+			},
+			requireCRL: true,
+			lookup:     "CN=test",
+			expected:   []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:  "Stale CRL rejected when required",
+			certs: map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls: map[string][]byte{
+				"root.crl":         generateCRL(t, root, rootKey),
+				"intermediate.crl": generateCRLWithNextUpdate(t, intermediate, intermediateKey, staleTime),
+			},
+			requireCRL: true,
+			lookup:     "CN=test",
+			expected:   nil,
+		},
+		{
+			name:  "Stale CRL current at lookup time",
+			certs: map[string][]*x509.Certificate{"a.pem": {leaf, intermediate}},
+			crls: map[string][]byte{
+				"root.crl":         generateCRL(t, root, rootKey),
+				"intermediate.crl": generateCRLWithNextUpdate(t, intermediate, intermediateKey, staleTime),
+			},
+			requireCRL: true,
+			lookup:     "CN=test",
+			time:       staleTime.Add(-time.Minute),
+			expected:   []crypto.PublicKey{leaf.PublicKey},
+		},
+		{
+			name:     "Revocation in stale CRL",
+			certs:    map[string][]*x509.Certificate{"a.pem": {leaf, otherLeaf, intermediate}},
+			crls:     map[string][]byte{"intermediate.crl": generateCRLWithNextUpdate(t, intermediate, intermediateKey, staleTime, leaf)},
+			lookup:   "CN=test",
+			expected: []crypto.PublicKey{otherLeaf.PublicKey},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rootFile := filepath.Join(t.TempDir(), "roots.pem")
+			writePEMFile(t, rootFile, "CERTIFICATE", root.Raw)
+			certDir := t.TempDir()
+			for name, certs := range tc.certs {
+				var ders [][]byte
+				for _, cert := range certs {
+					ders = append(ders, cert.Raw)
+				}
+				writePEMFile(t, filepath.Join(certDir, name), "CERTIFICATE", ders...)
+			}
+			crlDir := t.TempDir()
+			for name, der := range tc.crls {
+				if filepath.Ext(name) == ".pem" {
+					writePEMFile(t, filepath.Join(crlDir, name), "X509 CRL", der)
+				} else {
+					require.NoError(t, os.WriteFile(filepath.Join(crlDir, name), der, 0600))
+				}
+			}
+
+			locator := NewX509PublicKeyLocator(X509PublicKeyLocatorConfig{
+				CertificateDirectory: certDir,
+				RootCertificateFile:  rootFile,
+				CRLDirectory:         crlDir,
+				RequireCRL:           tc.requireCRL,
+			})
+			lookupTime := tc.time
+			if lookupTime.IsZero() {
+				lookupTime = time.Now()
+			}
+			keys, err := locator.LocateAt(t.Context(), mustParseAuthorIdentity(t, tc.lookup), lookupTime)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, keys)
+		})
+	}
+}
+
+func TestX509PublicKeyLocator_Errors(t *testing.T) {
+	rootKey := generateECDSAKey(t, elliptic.P256())
+	root := generateCertificate(t, "Root CA", rootKey, true, nil, nil)
+	rootFile := filepath.Join(t.TempDir(), "roots.pem")
+	writePEMFile(t, rootFile, "CERTIFICATE", root.Raw)
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0600))
+	badCRLDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(badCRLDir, "bad.crl"), []byte("garbage"), 0600))
+
+	testcases := []struct {
+		name string
+		cfg  X509PublicKeyLocatorConfig
+	}{
+		{
+			name: "Missing root certificate file",
+			cfg:  X509PublicKeyLocatorConfig{CertificateDirectory: t.TempDir(), RootCertificateFile: filepath.Join(t.TempDir(), "missing.pem")},
+		},
+		{
+			name: "No root certificates",
+			cfg:  X509PublicKeyLocatorConfig{CertificateDirectory: t.TempDir(), RootCertificateFile: emptyFile},
+		},
+		{
+			name: "Missing certificate directory",
+			cfg:  X509PublicKeyLocatorConfig{CertificateDirectory: filepath.Join(t.TempDir(), "missing"), RootCertificateFile: rootFile},
+		},
+		{
+			name: "Malformed CRL",
+			cfg:  X509PublicKeyLocatorConfig{CertificateDirectory: t.TempDir(), RootCertificateFile: rootFile, CRLDirectory: badCRLDir},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewX509PublicKeyLocator(tc.cfg).Locate(t.Context(), mustParseAuthorIdentity(t, "CN=test"))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVerifyWithX509PublicKeyLocator(t *testing.T) {
+	rootKey := generateECDSAKey(t, elliptic.P256())
+	root := generateCertificate(t, "Root CA", rootKey, true, nil, nil)
+	leafKey := generateECDSAKey(t, elliptic.P256())
+	leaf := generateCertificate(t, "test", leafKey, false, root, rootKey)
+
+	rootFile := filepath.Join(t.TempDir(), "roots.pem")
+	writePEMFile(t, rootFile, "CERTIFICATE", root.Raw)
+	certDir := t.TempDir()
+	writePEMFile(t, filepath.Join(certDir, "test.pem"), "CERTIFICATE", leaf.Raw)
+	verifier := NewVerifier(NewX509PublicKeyLocator(X509PublicKeyLocatorConfig{
+		CertificateDirectory: certDir,
+		RootCertificateFile:  rootFile,
+	}))
+
+	testcases := []struct {
+		name          string
+		eventTime     time.Time
+		expectedError error
+	}{
+		{
+			name:      "Event within validity period",
+			eventTime: time.Now(),
+		},
+		{
+			name:          "Event before validity period",
+			eventTime:     time.Now().Add(-24 * time.Hour),
+			expectedError: ErrPublicKeyNotFound,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+			event.Meta.Time = tc.eventTime.UnixMilli()
+
+			signer, err := NewKeySigner("CN=test", ES256, leafKey)
+			require.NoError(t, err)
+			b, err := signer.Sign(event)
+			require.NoError(t, err)
+
+			err = verifier.Verify(t.Context(), b)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestVerifyEmbeddedCertificateWithRevocationChecker(t *testing.T) {
+	rootKey := generateECDSAKey(t, elliptic.P256())
+	root := generateCertificate(t, "Root CA", rootKey, true, nil, nil)
+	intermediateKey := generateECDSAKey(t, elliptic.P256())
+	intermediate := generateCertificate(t, "Intermediate CA", intermediateKey, true, root, rootKey)
+	leafKey := generateECDSAKey(t, elliptic.P256())
+	leaf := generateCertificate(t, "test", leafKey, false, intermediate, intermediateKey)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	rootFile := filepath.Join(t.TempDir(), "roots.pem")
+	writePEMFile(t, rootFile, "CERTIFICATE", root.Raw)
+
+	testcases := []struct {
+		name               string
+		crls               map[string][]byte // filename => DER-encoded CRL
+		requireCRL         bool
+		noChecker          bool
+		expectedError      error
+		expectedRevocError bool
+	}{
+		{
+			name: "No CRLs",
+		},
+		{
+			name: "Unrevoked certificate",
+			crls: map[string][]byte{"intermediate.crl": generateCRL(t, intermediate, intermediateKey)},
+		},
+		{
+			name:               "Revoked certificate",
+			crls:               map[string][]byte{"intermediate.crl": generateCRL(t, intermediate, intermediateKey, leaf)},
+			expectedError:      ErrUntrustedPublicKey,
+			expectedRevocError: true,
+		},
+		{
+			name:      "Revoked certificate without checker",
+			crls:      map[string][]byte{"intermediate.crl": generateCRL(t, intermediate, intermediateKey, leaf)},
+			noChecker: true,
+		},
+		{
+			name:               "CRL required but missing",
+			crls:               map[string][]byte{"root.crl": generateCRL(t, root, rootKey)},
+			requireCRL:         true,
+			expectedError:      ErrUntrustedPublicKey,
+			expectedRevocError: true,
+		},
+		{
+			name: "CRL required and present",
+			crls: map[string][]byte{
+				"root.crl":         generateCRL(t, root, rootKey),
+				"intermediate.crl": generateCRL(t, intermediate, intermediateKey),
+			},
+			requireCRL: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			crlDir := t.TempDir()
+			for name, der := range tc.crls {
+				require.NoError(t, os.WriteFile(filepath.Join(crlDir, name), der, 0600))
+			}
+			// The locator has no certificates of its own so it can't
+			// provide the key when the embedded certificate is rejected.
+			locator := NewX509PublicKeyLocator(X509PublicKeyLocatorConfig{
+				CertificateDirectory: t.TempDir(),
+				RootCertificateFile:  rootFile,
+				CRLDirectory:         crlDir,
+				RequireCRL:           tc.requireCRL,
+			})
+			opts := []VerifierOption{WithTrustedRoots(roots)}
+			if !tc.noChecker {
+				opts = append(opts, WithRevocationChecker(locator))
+			}
+
+			event, err := eiffelevents.NewCompositionDefined()
+			require.NoError(t, err)
+			signer, err := NewKeySigner("CN=test", ES256, leafKey, EmbedCertificates(leaf, intermediate))
+			require.NoError(t, err)
+			b, err := signer.Sign(event)
+			require.NoError(t, err)
+
+			err = NewVerifier(locator, opts...).Verify(t.Context(), b)
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+			if tc.expectedRevocError {
+				require.ErrorIs(t, err, ErrCertificateRevoked)
+			}
+		})
+	}
+}
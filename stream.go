@@ -0,0 +1,286 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrEventTooLarge error = errors.New("event exceeds the maximum size")
+
+// DecodeError is returned by Decoder when an event in the stream couldn't
+// be decoded. The decoder remains usable after such an error, i.e. the next
+// call to Decode or DecodeRaw continues with the next event in the stream.
+type DecodeError struct {
+	// Offset is the byte offset in the input stream where the event starts.
+	Offset int64
+
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("error decoding event at offset %d: %s", e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Decoder reads a stream of JSON-encoded events and returns them one at
+// a time. The stream may contain events on separate lines (NDJSON),
+// concatenated JSON objects (with or without whitespace between them),
+// JSON arrays of events, or any mix of these.
+//
+// Malformed events are reported as DecodeError errors without aborting
+// the decoding, i.e. the caller may choose to log the error and continue
+// with the next event. Recovery requires that the malformed event is
+// a syntactically balanced JSON object or array; if e.g. a closing brace
+// is missing the rest of the stream is considered part of the same event.
+type Decoder struct {
+	r             *bufio.Reader
+	offset        int64 // The number of bytes read from r.
+	eventOffset   int64 // The offset of the most recently read event.
+	inArray       bool
+	maxEventSize  int
+	unmarshalOpts []UnmarshalOption
+}
+
+// DecoderOption is a function that configures a Decoder.
+type DecoderOption func(*Decoder)
+
+// WithMaxEventSize limits the size of each event read by a Decoder.
+// Larger events are skipped and reported as DecodeError errors wrapping
+// ErrEventTooLarge. By default there is no limit.
+func WithMaxEventSize(size int) DecoderOption {
+	return func(d *Decoder) {
+		d.maxEventSize = size
+	}
+}
+
+// WithUnmarshalOptions sets the options passed to UnmarshalAny
+// when a Decoder unmarshals events.
+func WithUnmarshalOptions(opts ...UnmarshalOption) DecoderOption {
+	return func(d *Decoder) {
+		d.unmarshalOpts = append(d.unmarshalOpts, opts...)
+	}
+}
+
+// NewDecoder returns a new Decoder that reads events from r.
+func NewDecoder(r io.Reader, opts ...DecoderOption) *Decoder {
+	d := &Decoder{
+		r: bufio.NewReader(r),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Decode reads the next event from the stream and unmarshals it with
+// UnmarshalAny. Returns io.EOF when the end of the stream has been reached.
+// Malformed events, including events that UnmarshalAny rejects, result in
+// DecodeError errors.
+func (d *Decoder) Decode() (interface{}, error) {
+	raw, err := d.DecodeRaw()
+	if err != nil {
+		return nil, err
+	}
+	event, err := UnmarshalAny(raw, d.unmarshalOpts...)
+	if err != nil {
+		return nil, &DecodeError{Offset: d.eventOffset, Err: err}
+	}
+	return event, nil
+}
+
+// DecodeRaw reads the next event from the stream and returns it without
+// unmarshaling it, e.g. so that the event can be routed based on its
+// metadata or the original bytes can be passed to a signature verifier.
+// Only the overall structure of the JSON value is checked. Returns io.EOF
+// when the end of the stream has been reached.
+func (d *Decoder) DecodeRaw() (RawEvent, error) {
+	for {
+		b, err := d.readByte()
+		if err == io.EOF {
+			if d.inArray {
+				d.inArray = false
+				return nil, &DecodeError{Offset: d.offset, Err: fmt.Errorf("%w: unterminated array", io.ErrUnexpectedEOF)}
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case isJSONWhitespace(b):
+			continue
+		case b == '[' && !d.inArray:
+			d.inArray = true
+			continue
+		case b == ',' && d.inArray:
+			continue
+		case b == ']' && d.inArray:
+			d.inArray = false
+			continue
+		case b == '{' || b == '[':
+			return d.readComposite(b)
+		default:
+			return nil, d.skipToken(b)
+		}
+	}
+}
+
+// readComposite reads a JSON object or array whose first byte
+// has already been read.
+func (d *Decoder) readComposite(first byte) (RawEvent, error) {
+	start := d.offset - 1
+	d.eventOffset = start
+	buf := []byte{first}
+	tooLarge := false
+	depth := 1
+	inString := false
+	escaped := false
+	for depth > 0 {
+		b, err := d.readByte()
+		if err == io.EOF {
+			return nil, &DecodeError{Offset: start, Err: io.ErrUnexpectedEOF}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !tooLarge {
+			buf = append(buf, b)
+			if d.maxEventSize > 0 && len(buf) > d.maxEventSize {
+				tooLarge = true
+				buf = nil
+			}
+		}
+
+		switch {
+		case escaped:
+			escaped = false
+		case inString && b == '\\':
+			escaped = true
+		case b == '"':
+			inString = !inString
+		case inString:
+		case b == '{' || b == '[':
+			depth++
+		case b == '}' || b == ']':
+			depth--
+		}
+	}
+	if tooLarge {
+		return nil, &DecodeError{Offset: start, Err: fmt.Errorf("%w of %d bytes", ErrEventTooLarge, d.maxEventSize)}
+	}
+	return buf, nil
+}
+
+// skipToken skips past an unexpected token (e.g. a bare string or number)
+// whose first byte has already been read and returns an error describing it.
+func (d *Decoder) skipToken(first byte) error {
+	start := d.offset - 1
+	token := []byte{first}
+	for {
+		b, err := d.r.ReadByte()
+		if err != nil {
+			break
+		}
+		if isJSONWhitespace(b) || b == ',' || b == '[' || b == ']' || b == '{' || b == '}' {
+			_ = d.r.UnreadByte()
+			break
+		}
+		d.offset++
+		if len(token) < 32 {
+			token = append(token, b)
+		}
+	}
+	return &DecodeError{Offset: start, Err: fmt.Errorf("%w: unexpected token %q", ErrMalformedInput, token)}
+}
+
+func (d *Decoder) readByte() (byte, error) {
+	b, err := d.r.ReadByte()
+	if err == nil {
+		d.offset++
+	}
+	return b, err
+}
+
+func isJSONWhitespace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// Encoder writes JSON-encoded events to a stream.
+type Encoder struct {
+	w      io.Writer
+	prefix string
+	indent string
+}
+
+// EncoderOption is a function that configures an Encoder.
+type EncoderOption func(*Encoder)
+
+// WithIndent makes an Encoder write pretty-printed events, with each JSON
+// element on a new line beginning with prefix followed by one or more copies
+// of indent according to the nesting depth. See json.Indent for details.
+// By default events are written as NDJSON, i.e. one compact event per line.
+func WithIndent(prefix string, indent string) EncoderOption {
+	return func(e *Encoder) {
+		e.prefix = prefix
+		e.indent = indent
+	}
+}
+
+// NewEncoder returns a new Encoder that writes events to w.
+func NewEncoder(w io.Writer, opts ...EncoderOption) *Encoder {
+	e := &Encoder{
+		w: w,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Encode writes the JSON encoding of an event to the stream,
+// followed by a newline character.
+func (e *Encoder) Encode(event json.Marshaler) error {
+	b, err := event.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("error marshaling event: %w", err)
+	}
+	var buf bytes.Buffer
+	if e.prefix != "" || e.indent != "" {
+		err = json.Indent(&buf, b, e.prefix, e.indent)
+	} else {
+		err = json.Compact(&buf, b)
+	}
+	if err != nil {
+		return fmt.Errorf("error formatting event: %w", err)
+	}
+	buf.WriteByte('\n')
+	if _, err := e.w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	streamTestEvent1 = `{"meta": {"type": "EiffelCompositionDefinedEvent", "version": "3.2.0", "time": 1234567890, "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"}, "data": {"name": "a{b}[c]\"d"}, "links": []}`
+	streamTestEvent2 = `{"meta": {"type": "EiffelArtifactCreatedEvent", "version": "3.1.0", "time": 1234567890, "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}, "data": {"identity": "pkg:generic/foo@1.2.3"}, "links": []}`
+)
+
+// decodeAll reads all events from a decoder and returns the IDs of the
+// successfully decoded events and the offsets of the failed ones.
+func decodeAll(t *testing.T, d *Decoder) ([]string, []int64) {
+	var ids []string
+	var errorOffsets []int64
+	for {
+		event, err := d.Decode()
+		if err == io.EOF {
+			return ids, errorOffsets
+		}
+		var de *DecodeError
+		if errors.As(err, &de) {
+			errorOffsets = append(errorOffsets, de.Offset)
+			continue
+		}
+		require.NoError(t, err)
+		ids = append(ids, event.(MetaTeller).ID())
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	bothIDs := []string{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}
+	testcases := []struct {
+		name                 string
+		input                string
+		opts                 []DecoderOption
+		expectedIDs          []string
+		expectedErrorOffsets []int64
+	}{
+		{
+			name:        "Empty input",
+			input:       "",
+			expectedIDs: nil,
+		},
+		{
+			name:        "NDJSON",
+			input:       streamTestEvent1 + "\n" + streamTestEvent2 + "\n",
+			expectedIDs: bothIDs,
+		},
+		{
+			name:        "Concatenated JSON",
+			input:       streamTestEvent1 + streamTestEvent2,
+			expectedIDs: bothIDs,
+		},
+		{
+			name:        "JSON array",
+			input:       "[\n  " + streamTestEvent1 + ",\n  " + streamTestEvent2 + "\n]\n",
+			expectedIDs: bothIDs,
+		},
+		{
+			name:        "Multiple JSON arrays",
+			input:       "[" + streamTestEvent1 + "]\n[" + streamTestEvent2 + "]",
+			expectedIDs: bothIDs,
+		},
+		{
+			name:                 "Unsupported event is skipped",
+			input:                streamTestEvent1 + "\n" + `{"meta": {"type": "EiffelBogusEvent", "version": "1.0.0"}}` + "\n" + streamTestEvent2,
+			expectedIDs:          bothIDs,
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 1)},
+		},
+		{
+			name:                 "Malformed event is skipped",
+			input:                streamTestEvent1 + "\n" + `{"meta": {"type": }}` + "\n" + streamTestEvent2,
+			expectedIDs:          bothIDs,
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 1)},
+		},
+		{
+			name:                 "Unexpected token is skipped",
+			input:                "[" + streamTestEvent1 + ", 42, " + streamTestEvent2 + "]",
+			expectedIDs:          bothIDs,
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 3)},
+		},
+		{
+			name:                 "Too large event is skipped",
+			input:                streamTestEvent1 + "\n" + streamTestEvent2,
+			opts:                 []DecoderOption{WithMaxEventSize(len(streamTestEvent1))},
+			expectedIDs:          bothIDs[:1],
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 1)},
+		},
+		{
+			name:                 "Truncated event",
+			input:                streamTestEvent1 + "\n" + streamTestEvent2[:20],
+			expectedIDs:          bothIDs[:1],
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 1)},
+		},
+		{
+			name:                 "Unterminated array",
+			input:                "[" + streamTestEvent1,
+			expectedIDs:          bothIDs[:1],
+			expectedErrorOffsets: []int64{int64(len(streamTestEvent1) + 1)},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ids, errorOffsets := decodeAll(t, NewDecoder(strings.NewReader(tc.input), tc.opts...))
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedErrorOffsets, errorOffsets)
+		})
+	}
+}
+
+func TestDecoderDecodeRaw(t *testing.T) {
+	d := NewDecoder(strings.NewReader("[" + streamTestEvent1 + "]"))
+	raw, err := d.DecodeRaw()
+	require.NoError(t, err)
+	assert.Equal(t, streamTestEvent1, string(raw))
+	_, err = d.DecodeRaw()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestEncoder(t *testing.T) {
+	event1, err := UnmarshalAny([]byte(streamTestEvent1))
+	require.NoError(t, err)
+	event2, err := UnmarshalAny([]byte(streamTestEvent2))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name  string
+		opts  []EncoderOption
+		lines int
+	}{
+		{
+			name:  "NDJSON",
+			lines: 2,
+		},
+		{
+			name:  "Pretty JSON",
+			opts:  []EncoderOption{WithIndent("", "  ")},
+			lines: 24,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			e := NewEncoder(&buf, tc.opts...)
+			require.NoError(t, e.Encode(event1.(Event)))
+			require.NoError(t, e.Encode(event2.(Event)))
+			assert.Equal(t, tc.lines, strings.Count(buf.String(), "\n"))
+
+			// Make sure the output can be read back.
+			ids, errorOffsets := decodeAll(t, NewDecoder(&buf))
+			assert.Equal(t, []string{event1.(Event).ID(), event2.(Event).ID()}, ids)
+			assert.Empty(t, errorOffsets)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// FieldError describes a problem with a particular value in an event's
+// JSON representation.
+type FieldError struct {
+	Pointer string // The location of the value as an RFC 6901 JSON pointer, e.g. "/data/outcome/verdict".
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// StrictError is returned by UnmarshalAny when the Strict option is in
+// effect and the input violates one or more of the rules enforced in
+// strict mode. It matches ErrMalformedInput when inspected with errors.Is.
+type StrictError struct {
+	problems []FieldError
+}
+
+// Problems returns a list of all problems found in the input.
+func (e *StrictError) Problems() []FieldError {
+	return e.problems
+}
+
+func (e *StrictError) Error() string {
+	lines := []string{"The event failed strict unmarshaling with the following error(s):"}
+	for _, p := range e.problems {
+		lines = append(lines, p.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (e *StrictError) Is(target error) bool {
+	return target == ErrMalformedInput
+}
+
+// findStrictProblems walks a JSON value and the Go value it has been
+// unmarshaled into in parallel and returns all object members that don't
+// have a matching struct field, mandatory fields that are missing or null,
+// and enum values that aren't valid.
+func findStrictProblems(value gjson.Result, v reflect.Value) []FieldError {
+	var result []FieldError
+	collectStrictProblems(value, v, "", &result)
+	return result
+}
+
+func collectStrictProblems(value gjson.Result, v reflect.Value, pointer string, result *[]FieldError) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if !value.IsObject() {
+			return
+		}
+		members := map[string]bool{}
+		value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+			members[key.String()] = true
+			memberPointer := pointer + "/" + escapeJSONPointerToken(key.String())
+			field, ok := fieldByJSONName(v.Type(), key.String())
+			if !ok {
+				*result = append(*result, FieldError{Pointer: memberPointer, Message: "unknown field"})
+				return true
+			}
+			if field.Type.Kind() != reflect.Interface {
+				if _, omitempty := jsonFieldName(field); !omitempty && member.Type == gjson.Null {
+					*result = append(*result, FieldError{Pointer: memberPointer, Message: "mandatory field is null"})
+					return true
+				}
+			}
+			collectStrictProblems(member, v.FieldByIndex(field.Index), memberPointer, result)
+			return true
+		})
+		for i := 0; i < v.NumField(); i++ {
+			name, omitempty := jsonFieldName(v.Type().Field(i))
+			if name == "" || omitempty || members[name] {
+				continue
+			}
+			*result = append(*result, FieldError{
+				Pointer: pointer + "/" + escapeJSONPointerToken(name),
+				Message: "mandatory field is missing",
+			})
+		}
+	case reflect.Slice, reflect.Array:
+		if !value.IsArray() {
+			return
+		}
+		for i, elem := range value.Array() {
+			if i >= v.Len() {
+				break
+			}
+			collectStrictProblems(elem, v.Index(i), pointer+"/"+strconv.Itoa(i), result)
+		}
+	case reflect.String:
+		if ev, ok := v.Interface().(enumValidator); ok && !ev.IsValid() {
+			*result = append(*result, FieldError{
+				Pointer: pointer,
+				Message: fmt.Sprintf("%q is not a valid %s value", v.String(), v.Type().Name()),
+			})
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/sjson"
+)
+
+const strictTestEvent = `{
+	"meta": {
+		"type": "EiffelTestCaseFinishedEvent",
+		"version": "3.3.0",
+		"time": 1234567890,
+		"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"
+	},
+	"data": {
+		"outcome": {
+			"conclusion": "SUCCESSFUL",
+			"verdict": "PASSED",
+			"metrics": [{"name": "duration", "value": null}]
+		}
+	},
+	"links": [
+		{"type": "TEST_CASE_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"}
+	]
+}`
+
+func TestStrictAcceptsValidEvent(t *testing.T) {
+	event, err := UnmarshalAny([]byte(strictTestEvent), Strict())
+	require.NoError(t, err)
+	assert.IsType(t, &TestCaseFinishedV3{}, event)
+}
+
+func TestStrictProblems(t *testing.T) {
+	testcases := []struct {
+		name     string
+		path     string
+		value    string // Raw JSON value to set at path, or empty to delete it.
+		expected []FieldError
+	}{
+		{
+			name:  "Unknown top-level field",
+			path:  "extra",
+			value: `true`,
+			expected: []FieldError{
+				{Pointer: "/extra", Message: "unknown field"},
+			},
+		},
+		{
+			name:  "Unknown field in array element",
+			path:  "links.0.weight",
+			value: `1`,
+			expected: []FieldError{
+				{Pointer: "/links/0/weight", Message: "unknown field"},
+			},
+		},
+		{
+			name:  "Unknown field with key needing escaping",
+			path:  "data.a/b~c",
+			value: `1`,
+			expected: []FieldError{
+				{Pointer: "/data/a~1b~0c", Message: "unknown field"},
+			},
+		},
+		{
+			name:  "Invalid enum value",
+			path:  "data.outcome.verdict",
+			value: `"MAYBE"`,
+			expected: []FieldError{
+				{Pointer: "/data/outcome/verdict", Message: `"MAYBE" is not a valid TCFV3DataOutcomeVerdict value`},
+			},
+		},
+		{
+			name: "Missing mandatory field",
+			path: "data.outcome.conclusion",
+			expected: []FieldError{
+				{Pointer: "/data/outcome/conclusion", Message: "mandatory field is missing"},
+			},
+		},
+		{
+			name:  "Null mandatory field",
+			path:  "links.0.target",
+			value: `null`,
+			expected: []FieldError{
+				{Pointer: "/links/0/target", Message: "mandatory field is null"},
+			},
+		},
+		{
+			name: "Missing mandatory object",
+			path: "data.outcome",
+			expected: []FieldError{
+				{Pointer: "/data/outcome", Message: "mandatory field is missing"},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input string
+			var err error
+			if tc.value == "" {
+				input, err = sjson.Delete(strictTestEvent, tc.path)
+			} else {
+				input, err = sjson.SetRaw(strictTestEvent, tc.path, tc.value)
+			}
+			require.NoError(t, err)
+
+			// Without Strict the input is accepted.
+			_, err = UnmarshalAny([]byte(input))
+			require.NoError(t, err)
+
+			_, err = UnmarshalAny([]byte(input), Strict())
+			require.ErrorIs(t, err, ErrMalformedInput)
+			var strictErr *StrictError
+			require.ErrorAs(t, err, &strictErr)
+			assert.Equal(t, tc.expected, strictErr.Problems())
+		})
+	}
+}
+
+func TestStrictReportsAllProblems(t *testing.T) {
+	input, err := sjson.SetRaw(strictTestEvent, "data.outcome.verdict", `"MAYBE"`)
+	require.NoError(t, err)
+	input, err = sjson.Delete(input, "meta.id")
+	require.NoError(t, err)
+
+	_, err = UnmarshalAny([]byte(input), Strict())
+	var strictErr *StrictError
+	require.ErrorAs(t, err, &strictErr)
+	assert.Len(t, strictErr.Problems(), 2)
+	assert.Contains(t, err.Error(), "/meta/id: mandatory field is missing")
+	assert.Contains(t, err.Error(), "/data/outcome/verdict: ")
+}
+
+func TestStrictRejectsNewerVersion(t *testing.T) {
+	input, err := sjson.Set(strictTestEvent, "meta.version", "3.4.0")
+	require.NoError(t, err)
+
+	_, err = UnmarshalAny([]byte(input))
+	require.NoError(t, err)
+
+	_, err = UnmarshalAny([]byte(input), Strict())
+	require.ErrorIs(t, err, ErrUnsupportedEvent)
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "/meta/version", fieldErr.Pointer)
+
+	// Older minor versions are fine.
+	input, err = sjson.Set(strictTestEvent, "meta.version", "3.0.0")
+	require.NoError(t, err)
+	_, err = UnmarshalAny([]byte(input), Strict())
+	require.NoError(t, err)
+}
+
+func TestUnmarshalTypeErrorPointer(t *testing.T) {
+	input, err := sjson.SetRaw(strictTestEvent, "links.0.target", `42`)
+	require.NoError(t, err)
+
+	_, err = UnmarshalAny([]byte(input))
+	require.ErrorIs(t, err, ErrMalformedInput)
+	var fieldErr *FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "/links/0/target", fieldErr.Pointer)
+	assert.Equal(t, "cannot unmarshal JSON number into value of type string", fieldErr.Message)
+}
+
+func TestFieldPathToJSONPointer(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"data", "/data"},
+		{"data.locations.1.uri", "/data/locations/1/uri"},
+		{"data.a/b~c", "/data/a~1b~0c"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, fieldPathToJSONPointer(tc.input))
+		})
+	}
+}
+
+// fieldPathToJSONPointer converts a dot-separated field path, like the ones
+// found in json.UnmarshalTypeError, into a JSON pointer. Dots in object keys
+// can't be distinguished from separators so such keys will be split.
+func fieldPathToJSONPointer(path string) string {
+	if path == "" {
+		return ""
+	}
+	var sb strings.Builder
+	for _, token := range strings.Split(path, ".") {
+		sb.WriteByte('/')
+		sb.WriteString(escapeJSONPointerToken(token))
+	}
+	return sb.String()
+}
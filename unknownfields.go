@@ -0,0 +1,371 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// pathStep is a step on the path from the root of an event to a value,
+// i.e. either an object member or an array element.
+type pathStep struct {
+	key   string // The object key.
+	index int    // The array index, or -1 if the step is an object member.
+}
+
+// unknownField is a JSON object member that didn't correspond
+// to any struct field when its object was unmarshaled.
+type unknownField struct {
+	path  []pathStep // The path to the object that the member belongs to.
+	key   string     // The object key.
+	value []byte     // The compacted raw JSON value.
+}
+
+// unknownFieldSet contains all unknown fields of an event, in the order
+// they were found. Only the event structs have a field for unknown fields
+// so that the nested structs remain comparable and can be declared with
+// unkeyed composite literals. To still let the unknown fields of an array
+// element follow the element if the array is modified, the set also keeps
+// the JSON encoding of each original element of every array that contains
+// unknown fields. A set is never modified once it has been created,
+// so copies of an event share the set with the original.
+type unknownFieldSet struct {
+	fields   []unknownField
+	elements map[string][][]byte // JSON pointer to array => encoded elements
+}
+
+// unknownFieldsHolder is implemented by all event structs.
+type unknownFieldsHolder interface {
+	appendJSON(b []byte) ([]byte, error)
+	setUnknownFields(fields *unknownFieldSet)
+}
+
+// unknownFieldCollector collects the unknown fields found by the generated
+// decodeJSON methods, which keep it updated with the path to the value being
+// decoded. All methods are no-ops for a nil collector, which is what the
+// decodeJSON methods get unless the PreserveUnknownFields option is used.
+type unknownFieldCollector struct {
+	path   []pathStep
+	fields []unknownField
+}
+
+// pushKey descends into the object member with the given key.
+func (c *unknownFieldCollector) pushKey(key string) {
+	if c != nil {
+		c.path = append(c.path, pathStep{key: key, index: -1})
+	}
+}
+
+// pushIndex descends into the array element with the given index.
+func (c *unknownFieldCollector) pushIndex(index int) {
+	if c != nil {
+		c.path = append(c.path, pathStep{index: index})
+	}
+}
+
+// renameKey replaces the key of the innermost object member, e.g. when
+// its key has been matched case-insensitively to a field whose JSON name
+// is what the member will be marshaled as.
+func (c *unknownFieldCollector) renameKey(key string) {
+	if c != nil {
+		c.path[len(c.path)-1].key = key
+	}
+}
+
+// pop ascends from the innermost object member or array element.
+func (c *unknownFieldCollector) pop() {
+	if c != nil {
+		c.path = c.path[:len(c.path)-1]
+	}
+}
+
+// add records an unknown member of the current object.
+func (c *unknownFieldCollector) add(key string, value gjson.Result) {
+	if c == nil {
+		return
+	}
+	field := unknownField{path: slices.Clone(c.path), key: key, value: []byte(value.Raw)}
+	var buf bytes.Buffer
+	// The input has already been validated so compacting it shouldn't fail,
+	// but if it does the raw value is still valid JSON.
+	if err := json.Compact(&buf, field.value); err == nil {
+		field.value = buf.Bytes()
+	}
+	c.fields = append(c.fields, field)
+}
+
+// finish returns the unknown fields collected while decoding an event,
+// or nil if there were none. The argument is the event's encoding without
+// unknown fields, from which the elements of the arrays are recorded.
+func (c *unknownFieldCollector) finish(known []byte) *unknownFieldSet {
+	if c == nil || len(c.fields) == 0 {
+		return nil
+	}
+	root := gjson.ParseBytes(known)
+	set := &unknownFieldSet{fields: c.fields, elements: map[string][][]byte{}}
+	for _, field := range c.fields {
+		for i, step := range field.path {
+			if step.index < 0 {
+				continue
+			}
+			pointer := jsonPointer(field.path[:i])
+			if _, ok := set.elements[pointer]; ok {
+				continue
+			}
+			var elements [][]byte
+			lookupPath(root, field.path[:i]).ForEach(func(_ gjson.Result, elem gjson.Result) bool {
+				elements = append(elements, []byte(elem.Raw))
+				return true
+			})
+			set.elements[pointer] = elements
+		}
+	}
+	return set
+}
+
+// apply returns the marshaled event with the unknown fields added. Each
+// unknown field is added to the object it was found in, i.e. the fields of
+// array elements follow the elements if the array has been reordered or
+// elements have been added or removed. To find the elements, the current
+// elements are compared to the original ones, and elements that don't
+// match any original element are assumed to be modified versions of the
+// remaining original elements, in the same order, if the number of such
+// elements is the same. Otherwise it's unclear which element is which and
+// the unknown fields of the unmatched elements are dropped.
+func (uf *unknownFieldSet) apply(eventJSON []byte) ([]byte, error) {
+	if uf == nil {
+		return eventJSON, nil
+	}
+	// Locate all fields before adding any of them since the additions
+	// would prevent the elements from being compared.
+	paths := uf.resolve(eventJSON)
+	var err error
+	for i, field := range uf.fields {
+		if paths[i] == "" {
+			continue
+		}
+		if eventJSON, err = sjson.SetRawBytes(eventJSON, paths[i], field.value); err != nil {
+			return nil, err
+		}
+	}
+	return eventJSON, nil
+}
+
+// resolve returns the sjson path to each unknown field in an event
+// marshaled without unknown fields, or an empty string for fields
+// that should be dropped.
+func (uf *unknownFieldSet) resolve(eventJSON []byte) []string {
+	root := gjson.ParseBytes(eventJSON)
+	matches := map[string][]int{}
+	paths := make([]string, len(uf.fields))
+	for i, field := range uf.fields {
+		components := make([]string, 0, len(field.path)+1)
+		value := root
+		for j, step := range field.path {
+			if step.index < 0 {
+				components = append(components, escapeSJSONKey(step.key))
+				value = lookupStep(value, step)
+				continue
+			}
+			pointer := jsonPointer(field.path[:j])
+			indexes, ok := matches[pointer]
+			if !ok {
+				indexes = matchElements(uf.elements[pointer], value)
+				matches[pointer] = indexes
+			}
+			if step.index >= len(indexes) || indexes[step.index] < 0 {
+				components = nil
+				break
+			}
+			components = append(components, strconv.Itoa(indexes[step.index]))
+			value = lookupStep(value, pathStep{index: indexes[step.index]})
+		}
+		if components != nil {
+			paths[i] = strings.Join(append(components, escapeSJSONKey(field.key)), ".")
+		}
+	}
+	return paths
+}
+
+// matchElements returns the index in the current array of each of the
+// original elements, or -1 for elements that can't be found.
+func matchElements(original [][]byte, array gjson.Result) []int {
+	var current [][]byte
+	if array.IsArray() {
+		array.ForEach(func(_ gjson.Result, elem gjson.Result) bool {
+			current = append(current, []byte(elem.Raw))
+			return true
+		})
+	}
+	indexes := make([]int, len(original))
+	claimed := make([]bool, len(current))
+	for i := range original {
+		indexes[i] = -1
+		if i < len(current) && bytes.Equal(original[i], current[i]) {
+			indexes[i] = i
+			claimed[i] = true
+		}
+	}
+	for i := range original {
+		for j := 0; indexes[i] < 0 && j < len(current); j++ {
+			if !claimed[j] && bytes.Equal(original[i], current[j]) {
+				indexes[i] = j
+				claimed[j] = true
+			}
+		}
+	}
+
+	// Pair the remaining elements if there's an equal number of them.
+	var unmatched, unclaimed []int
+	for i := range original {
+		if indexes[i] < 0 {
+			unmatched = append(unmatched, i)
+		}
+	}
+	for j := range current {
+		if !claimed[j] {
+			unclaimed = append(unclaimed, j)
+		}
+	}
+	if len(unmatched) == len(unclaimed) {
+		for k, i := range unmatched {
+			indexes[i] = unclaimed[k]
+		}
+	}
+	return indexes
+}
+
+// equalUnknownFields returns true if two events have the same unknown
+// fields in the same places.
+func equalUnknownFields(a unknownFieldsHolder, aFields *unknownFieldSet, b unknownFieldsHolder, bFields *unknownFieldSet) bool {
+	if aFields == nil && bFields == nil {
+		return true
+	}
+	aJSON, err := a.appendJSON(nil)
+	if err != nil {
+		return false
+	}
+	bJSON, err := b.appendJSON(nil)
+	if err != nil {
+		return false
+	}
+	return slices.Equal(aFields.placed(aJSON), bFields.placed(bJSON))
+}
+
+// placed returns the unknown fields that are kept when marshaling an event,
+// given its encoding without unknown fields, as sorted strings containing
+// the path and value of each field.
+func (uf *unknownFieldSet) placed(eventJSON []byte) []string {
+	if uf == nil {
+		return nil
+	}
+	var result []string
+	for i, path := range uf.resolve(eventJSON) {
+		if path != "" {
+			result = append(result, path+"="+string(uf.fields[i].value))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// lookupPath returns the value at the end of a path,
+// or a non-existent value if there's no such value.
+func lookupPath(value gjson.Result, path []pathStep) gjson.Result {
+	for _, step := range path {
+		value = lookupStep(value, step)
+	}
+	return value
+}
+
+// lookupStep returns the object member or array element given
+// by a path step, or a non-existent value if there's no such value.
+func lookupStep(value gjson.Result, step pathStep) gjson.Result {
+	if (step.index < 0 && !value.IsObject()) || (step.index >= 0 && !value.IsArray()) {
+		return gjson.Result{}
+	}
+	var result gjson.Result
+	i := 0
+	value.ForEach(func(key gjson.Result, member gjson.Result) bool {
+		if (step.index < 0 && key.Str == step.key) || i == step.index {
+			result = member
+			return false
+		}
+		i++
+		return true
+	})
+	return result
+}
+
+// jsonPointer returns the JSON pointer for a path.
+func jsonPointer(path []pathStep) string {
+	var sb strings.Builder
+	for _, step := range path {
+		sb.WriteByte('/')
+		if step.index < 0 {
+			sb.WriteString(escapeJSONPointerToken(step.key))
+		} else {
+			sb.WriteString(strconv.Itoa(step.index))
+		}
+	}
+	return sb.String()
+}
+
+// escapeJSONPointerToken escapes a reference token as described
+// in RFC 6901 section 3.
+func escapeJSONPointerToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// escapeSJSONKey escapes an object key so that it can be used
+// as a path component with sjson.
+func escapeSJSONKey(key string) string {
+	var sb strings.Builder
+	// Keys that look like array indexes must be prefixed with a colon
+	// to not be interpreted as such, and so must empty keys.
+	if _, err := strconv.Atoi(key); err == nil || key == "" {
+		sb.WriteByte(':')
+	}
+	for _, r := range key {
+		switch r {
+		case '.', '*', '?', '|', '#', '@', '\\', ':', '!', '=', '<', '>', '%':
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// fieldByJSONName returns the field of a struct type that has
+// the given JSON name in the "json" tag.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if fieldName, _ := jsonFieldName(t.Field(i)); fieldName == name {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,271 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+const unknownFieldsTestEvent = `{
+	"meta": {
+		"type": "EiffelArtifactPublishedEvent",
+		"version": "3.99.0",
+		"time": 1234567890,
+		"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0",
+		"newMetaField": {"a": [1, 2, 3]}
+	},
+	"data": {
+		"locations": [
+			{"type": "PLAIN", "uri": "https://example.com/a"},
+			{"type": "PLAIN", "uri": "https://example.com/b", "checksum": "abc", "dotted.key": true, "0": null}
+		],
+		"newDataField": "value"
+	},
+	"links": [
+		{"type": "ARTIFACT", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "weight": 1.5}
+	],
+	"newTopLevelField": []
+}`
+
+func TestPreserveUnknownFields(t *testing.T) {
+	event, err := UnmarshalAny([]byte(unknownFieldsTestEvent), PreserveUnknownFields())
+	require.NoError(t, err)
+	require.IsType(t, &ArtifactPublishedV3{}, event)
+
+	output, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, unknownFieldsTestEvent, string(output))
+
+	// Known fields can still be modified.
+	event.(*ArtifactPublishedV3).Data.Locations[1].Name = "b"
+	output, err = json.Marshal(event)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"name":"b"`)
+	assert.Contains(t, string(output), `"checksum":"abc"`)
+}
+
+func TestUnknownFieldsDroppedByDefault(t *testing.T) {
+	event, err := UnmarshalAny([]byte(unknownFieldsTestEvent))
+	require.NoError(t, err)
+
+	output, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.NotContains(t, string(output), "new")
+	assert.NotContains(t, string(output), "checksum")
+	assert.NotContains(t, string(output), "weight")
+}
+
+func TestDecoderPreservesUnknownFields(t *testing.T) {
+	input := strings.ReplaceAll(unknownFieldsTestEvent, "\n", "")
+	d := NewDecoder(strings.NewReader(input), WithUnmarshalOptions(PreserveUnknownFields()))
+	event, err := d.Decode()
+	require.NoError(t, err)
+
+	output, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, unknownFieldsTestEvent, string(output))
+}
+
+func TestUnknownFieldsFollowArrayElements(t *testing.T) {
+	input := `{
+		"meta": {
+			"type": "EiffelArtifactPublishedEvent",
+			"version": "3.99.0",
+			"time": 1234567890,
+			"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"
+		},
+		"data": {
+			"locations": [
+				{"type": "PLAIN", "uri": "https://example.com/a", "checksum": "a"},
+				{"type": "PLAIN", "uri": "https://example.com/b"},
+				{"type": "PLAIN", "uri": "https://example.com/c", "checksum": "c"}
+			]
+		},
+		"links": [
+			{"type": "ARTIFACT", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "weight": 1},
+			{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2", "weight": 2}
+		]
+	}`
+	event, err := UnmarshalAny([]byte(input), PreserveUnknownFields())
+	require.NoError(t, err)
+	ap := event.(*ArtifactPublishedV3) // nolint:forcetypeassert
+
+	// Delete the first location and swap the links.
+	ap.Data.Locations = ap.Data.Locations[1:]
+	ap.Links[0], ap.Links[1] = ap.Links[1], ap.Links[0]
+
+	output, err := json.Marshal(ap)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"meta": {
+			"type": "EiffelArtifactPublishedEvent",
+			"version": "3.99.0",
+			"time": 1234567890,
+			"id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"
+		},
+		"data": {
+			"locations": [
+				{"type": "PLAIN", "uri": "https://example.com/b"},
+				{"type": "PLAIN", "uri": "https://example.com/c", "checksum": "c"}
+			]
+		},
+		"links": [
+			{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2", "weight": 2},
+			{"type": "ARTIFACT", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "weight": 1}
+		]
+	}`, string(output))
+
+	// The output can be unmarshaled again with the same result.
+	roundtripped, err := UnmarshalAny(output, PreserveUnknownFields())
+	require.NoError(t, err)
+	assert.True(t, ap.Equal(roundtripped.(*ArtifactPublishedV3))) // nolint:forcetypeassert
+}
+
+func TestUnknownFieldsInCaseInsensitivelyMatchedObject(t *testing.T) {
+	input := `{
+		"meta": {"type": "EiffelArtifactPublishedEvent", "version": "3.99.0", "time": 1, "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"},
+		"data": {"locations": []},
+		"Links": [{"type": "ARTIFACT", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "weight": 1}]
+	}`
+	event, err := UnmarshalAny([]byte(input), PreserveUnknownFields())
+	require.NoError(t, err)
+
+	output, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, strings.Replace(input, "Links", "links", 1), string(output))
+}
+
+func TestUnknownFieldsOfModifiedElements(t *testing.T) {
+	input := `{
+		"meta": {"type": "EiffelArtifactPublishedEvent", "version": "3.99.0", "time": 1, "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"},
+		"data": {
+			"locations": [
+				{"type": "PLAIN", "uri": "https://example.com/a", "checksum": "a"},
+				{"type": "PLAIN", "uri": "https://example.com/b", "checksum": "b"}
+			]
+		},
+		"links": []
+	}`
+	testcases := []struct {
+		name     string
+		modifier func(locations *[]ArtPV3DataLocation)
+		expected []string // The checksum of each location, if any.
+	}{
+		{
+			name: "Modified element",
+			modifier: func(locations *[]ArtPV3DataLocation) {
+				(*locations)[0].Name = "a"
+			},
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "Modified and reordered elements",
+			modifier: func(locations *[]ArtPV3DataLocation) {
+				(*locations)[0].Name = "a"
+				(*locations)[0], (*locations)[1] = (*locations)[1], (*locations)[0]
+			},
+			expected: []string{"b", "a"},
+		},
+		{
+			name: "Modified element and removed element",
+			modifier: func(locations *[]ArtPV3DataLocation) {
+				(*locations)[1].Name = "b"
+				*locations = (*locations)[1:]
+			},
+			expected: []string{""},
+		},
+		{
+			name: "Modified element and added element",
+			modifier: func(locations *[]ArtPV3DataLocation) {
+				(*locations)[0].Name = "a"
+				*locations = append([]ArtPV3DataLocation{{Type: ArtPV3DataLocationType_Plain, URI: "https://example.com/c"}}, *locations...)
+			},
+			expected: []string{"", "", "b"},
+		},
+		{
+			name: "Added element",
+			modifier: func(locations *[]ArtPV3DataLocation) {
+				*locations = append([]ArtPV3DataLocation{{Type: ArtPV3DataLocationType_Plain, URI: "https://example.com/c"}}, *locations...)
+			},
+			expected: []string{"", "a", "b"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := UnmarshalAny([]byte(input), PreserveUnknownFields())
+			require.NoError(t, err)
+			ap := event.(*ArtifactPublishedV3) // nolint:forcetypeassert
+			tc.modifier(&ap.Data.Locations)
+
+			output, err := json.Marshal(ap)
+			require.NoError(t, err)
+			var checksums []string
+			gjson.GetBytes(output, "data.locations").ForEach(func(_ gjson.Result, location gjson.Result) bool {
+				checksums = append(checksums, location.Get("checksum").String())
+				return true
+			})
+			assert.Equal(t, tc.expected, checksums)
+		})
+	}
+}
+
+func TestEqualComparesUnknownFields(t *testing.T) {
+	preserved1, err := UnmarshalAny([]byte(unknownFieldsTestEvent), PreserveUnknownFields())
+	require.NoError(t, err)
+	preserved2, err := UnmarshalAny([]byte(unknownFieldsTestEvent), PreserveUnknownFields())
+	require.NoError(t, err)
+	dropped, err := UnmarshalAny([]byte(unknownFieldsTestEvent))
+	require.NoError(t, err)
+
+	assert.True(t, preserved1.(*ArtifactPublishedV3).Equal(preserved2.(*ArtifactPublishedV3))) // nolint:forcetypeassert
+	assert.False(t, preserved1.(*ArtifactPublishedV3).Equal(dropped.(*ArtifactPublishedV3)))   // nolint:forcetypeassert
+	assert.False(t, dropped.(*ArtifactPublishedV3).Equal(preserved1.(*ArtifactPublishedV3)))   // nolint:forcetypeassert
+}
+
+func TestNestedStructsAreComparable(t *testing.T) {
+	event, err := UnmarshalAny([]byte(unknownFieldsTestEvent), PreserveUnknownFields())
+	require.NoError(t, err)
+	ap := event.(*ArtifactPublishedV3) // nolint:forcetypeassert
+
+	// The unknown field of the link doesn't affect the comparison.
+	assert.True(t, ap.Links[0] == EventLinkV1{"aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1", "ARTIFACT", ""})
+	assert.True(t, ap.Meta.Source == MetaV3Source{})
+}
+
+func TestEscapeSJSONKey(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"plain", "plain"},
+		{"dotted.key", `dotted\.key`},
+		{"wild*card?", `wild\*card\?`},
+		{"42", ":42"},
+		{"", ":"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, escapeSJSONKey(tc.input))
+		})
+	}
+}
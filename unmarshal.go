@@ -17,7 +17,6 @@
 package eiffelevents
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -30,27 +29,85 @@ import (
 var ErrMalformedInput error = errors.New("malformed JSON input")
 var ErrUnsupportedEvent error = errors.New("event unsupported")
 
+// UnmarshalOption is a function that configures the behavior of UnmarshalAny.
+type UnmarshalOption func(*unmarshalConfig)
+
+type unmarshalConfig struct {
+	preserveUnknownFields bool
+	strict                bool
+}
+
+// PreserveUnknownFields makes UnmarshalAny retain any JSON object members
+// that don't correspond to a struct field, at any nesting level, and include
+// them when the event is marshaled. This is useful when relaying or signing
+// events of a newer minor version than what this SDK knows about, since
+// such events might contain fields that would otherwise be lost.
+//
+// Unknown fields are marshaled after the known fields of the object they
+// were found in. The unknown fields of an array element follow the element
+// if e.g. elements are removed from or reordered in an array of links,
+// as long as the element can be identified; if both the element and other
+// elements of the array have been modified in a way that makes it unclear
+// which element is which, the unknown fields of those elements are dropped.
+func PreserveUnknownFields() UnmarshalOption {
+	return func(cfg *unmarshalConfig) {
+		cfg.preserveUnknownFields = true
+	}
+}
+
+// Strict makes UnmarshalAny reject input that doesn't exactly match the
+// event's schema. The following problems are reported in a *StrictError,
+// with the location of each problem expressed as a JSON pointer:
+//
+//   - object members that don't correspond to a struct field,
+//   - mandatory fields that are missing or null,
+//   - enum values that aren't among the defined values.
+//
+// Additionally, events whose minor or patch version is newer than the
+// most recent version known to this SDK are rejected with an
+// ErrUnsupportedEvent error since they might contain fields this SDK
+// doesn't know how to validate.
+//
+// Strict can be combined with PreserveUnknownFields, but since unknown
+// fields are rejected the latter has no effect.
+func Strict() UnmarshalOption {
+	return func(cfg *unmarshalConfig) {
+		cfg.strict = true
+	}
+}
+
 // UnmarshalAny unmarshals a JSON string into one of the supported Eiffel
 // event type structs, dependent on the meta.type field in the event
 // payload. Callers are expected to use type assertions or type switches
-// to access concrete event types.
+// to access concrete event types. Unless the PreserveUnknownFields option
+// is given, JSON object members that don't correspond to any struct fields
+// are ignored. Like with encoding/json, object members are matched to
+// struct fields case-insensitively if there's no exact match.
 //
 // If the input isn't valid JSON, meta.type and meta.version values can't
 // be extracted from it, or some other JSON unmarshaling error occurs an
-// ErrMalformedInput error is returned. If the event type or version isn't
+// ErrMalformedInput error is returned. If the error can be attributed to
+// a particular value the returned error also wraps a *FieldError with
+// the location of that value. If the event type or version isn't
 // supported by this implementation an ErrUnsupportedType error is returned.
-func UnmarshalAny(input []byte) (interface{}, error) {
+func UnmarshalAny(input []byte, opts ...UnmarshalOption) (interface{}, error) {
+	var cfg unmarshalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	if !gjson.ValidBytes(input) {
 		return nil, fmt.Errorf("%w: not valid JSON", ErrMalformedInput)
 	}
 
 	// Extract the event type and version from the JSON payload.
-	schemaSelectors := gjson.GetManyBytes(input, "meta.type", "meta.version")
-	metaType := schemaSelectors[0].String()
+	parsedInput := gjson.ParseBytes(input)
+	meta := parsedInput.Get("meta")
+	metaType := meta.Get("type").String()
 	if metaType == "" {
 		return nil, fmt.Errorf("%w: unable to extract meta.type", ErrMalformedInput)
 	}
-	metaVersion := schemaSelectors[1].String()
+	metaVersion := meta.Get("version").String()
 	if metaVersion == "" {
 		return nil, fmt.Errorf("%w: unable to extract meta.version", ErrMalformedInput)
 	}
@@ -72,12 +129,35 @@ func UnmarshalAny(input []byte) (interface{}, error) {
 		return nil, fmt.Errorf("%w: version of %s unsupported; valid major versions: %v", ErrUnsupportedEvent, metaType, versions)
 	}
 
-	// Create an instance of the right struct and unmarshal the payload into it.
-	value := reflect.New(eventTypeTable[metaType][version.Major()].structType).Interface()
-	if err := json.Unmarshal(input, &value); err != nil {
-		// Ideally we should wrap both ErrMalformedInput and err
-		// but I couldn't figure out an elegant way of doing that.
-		return nil, fmt.Errorf("%w: %s", ErrMalformedInput, err)
+	latestVersion := eventTypeTable[metaType][version.Major()].latestVersion
+	if cfg.strict && version.GreaterThan(semver.MustParse(latestVersion)) {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedEvent, &FieldError{
+			Pointer: "/meta/version",
+			Message: fmt.Sprintf("version %s of %s is newer than the most recent known version %s", version, metaType, latestVersion),
+		})
+	}
+
+	// Create an instance of the right struct and decode the payload into it.
+	value := eventTypeTable[metaType][version.Major()].newEvent()
+	var decodeOpts decodeOptions
+	if cfg.preserveUnknownFields {
+		decodeOpts.unknownFields = &unknownFieldCollector{}
+	}
+	if err := value.decodeJSON(parsedInput, decodeOpts); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+	}
+	if cfg.strict {
+		if problems := findStrictProblems(parsedInput, reflect.ValueOf(value)); len(problems) > 0 {
+			return nil, &StrictError{problems: problems}
+		}
+	}
+	if decodeOpts.unknownFields != nil {
+		holder := value.(unknownFieldsHolder) // nolint:forcetypeassert
+		known, err := holder.appendJSON(nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+		}
+		holder.setUnknownFields(decodeOpts.unknownFields.finish(known))
 	}
 	return value, nil
 }
@@ -0,0 +1,160 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/package-url/packageurl-go"
+)
+
+// SchemaValidationError indicates that an event failed validation against
+// its JSON schema. It's returned both by the Validate methods of the event
+// structs and by the schema validator in the validator subpackage.
+type SchemaValidationError struct {
+	problems []FieldError
+}
+
+// NewSchemaValidationError returns a SchemaValidationError
+// with the given problems.
+func NewSchemaValidationError(problems []FieldError) *SchemaValidationError {
+	return &SchemaValidationError{problems: problems}
+}
+
+// Problems returns a list of all problems found during the validation.
+func (ve *SchemaValidationError) Problems() []FieldError {
+	return ve.problems
+}
+
+func (ve *SchemaValidationError) Error() string {
+	var s strings.Builder
+	s.WriteString("The event failed the schema validation with the following error(s):")
+	for _, p := range ve.problems {
+		s.WriteByte('\n')
+		s.WriteString(p.Error())
+	}
+	return s.String()
+}
+
+func (ve *SchemaValidationError) Is(target error) bool {
+	_, ok := target.(*SchemaValidationError)
+	return ok
+}
+
+// schemaValidatable is implemented by all generated struct types.
+type schemaValidatable interface {
+	validate(pointer string, problems *[]FieldError)
+}
+
+// validateStruct runs the generated validation code of a struct
+// and returns a *SchemaValidationError if any problems were found.
+func validateStruct(s schemaValidatable) error {
+	var problems []FieldError
+	s.validate("", &problems)
+	if len(problems) > 0 {
+		return NewSchemaValidationError(problems)
+	}
+	return nil
+}
+
+func addFieldProblem(problems *[]FieldError, pointer string, format string, args ...interface{}) {
+	*problems = append(*problems, FieldError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// indexPointer returns the JSON pointer to an element of the array
+// that pointer points to.
+func indexPointer(pointer string, i int) string {
+	return pointer + "/" + strconv.Itoa(i)
+}
+
+// compiledPatterns caches the compiled regexps used by checkPattern.
+var compiledPatterns sync.Map
+
+func checkPattern(problems *[]FieldError, pointer string, value string, pattern string) {
+	re, ok := compiledPatterns.Load(pattern)
+	if !ok {
+		// The patterns come from the schemas and have been compiled
+		// successfully by the code generator so this can't fail.
+		re, _ = compiledPatterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !re.(*regexp.Regexp).MatchString(value) { // nolint:forcetypeassert
+		addFieldProblem(problems, pointer, "%q doesn't match the pattern %q", value, pattern)
+	}
+}
+
+// checkFormat checks a string against one of the formats defined by
+// the JSON schema specification, plus "purl". Unknown formats are ignored.
+func checkFormat(problems *[]FieldError, pointer string, value string, format string) {
+	var valid bool
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		valid = err == nil
+	case "email":
+		_, err := mail.ParseAddress(value)
+		valid = err == nil
+	case "purl":
+		_, err := packageurl.FromString(value)
+		valid = err == nil
+	case "uri":
+		u, err := url.Parse(value)
+		valid = err == nil && u.IsAbs()
+	case "uri-reference":
+		_, err := url.Parse(value)
+		valid = err == nil
+	case "uuid":
+		_, err := uuid.Parse(value)
+		valid = err == nil && len(value) == 36
+	default:
+		valid = true
+	}
+	if !valid {
+		addFieldProblem(problems, pointer, "%q isn't a valid %s", value, format)
+	}
+}
+
+// checkLength checks the length of a string (in characters) against
+// the given limits. A negative limit isn't checked.
+func checkLength(problems *[]FieldError, pointer string, value string, minLength int, maxLength int) {
+	n := utf8.RuneCountInString(value)
+	if minLength >= 0 && n < minLength {
+		addFieldProblem(problems, pointer, "string length must be at least %d", minLength)
+	}
+	if maxLength >= 0 && n > maxLength {
+		addFieldProblem(problems, pointer, "string length must be at most %d", maxLength)
+	}
+}
+
+// checkItemCount checks the number of items in an array against
+// the given limits. A negative limit isn't checked.
+func checkItemCount(problems *[]FieldError, pointer string, n int, minItems int, maxItems int) {
+	if minItems >= 0 && n < minItems {
+		addFieldProblem(problems, pointer, "array must have at least %d item(s)", minItems)
+	}
+	if maxItems >= 0 && n > maxItems {
+		addFieldProblem(problems, pointer, "array must have at most %d item(s)", maxItems)
+	}
+}
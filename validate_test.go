@@ -0,0 +1,166 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eiffelevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	testcases := []struct {
+		name             string
+		modifier         func(event *ArtifactCreatedV3)
+		expectedProblems []FieldError
+	}{
+		{
+			name:     "Valid event",
+			modifier: func(event *ArtifactCreatedV3) {},
+		},
+		{
+			name: "Empty mandatory field",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Data.Identity = ""
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/data/identity", Message: "mandatory field is missing"},
+			},
+		},
+		{
+			name: "Pattern mismatch in predeclared type",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Meta.ID = "not-a-uuid"
+				event.Meta.Source.Serializer = "github.com/example/serializer"
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/meta/id", Message: `"not-a-uuid" doesn't match the pattern "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"`},
+				{Pointer: "/meta/source/serializer", Message: `"github.com/example/serializer" doesn't match the pattern "^pkg:"`},
+			},
+		},
+		{
+			name: "Pattern mismatch in array element",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Data.DependsOn = []string{"pkg:generic/a", "generic/b"}
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/data/dependsOn/1", Message: `"generic/b" doesn't match the pattern "^pkg:"`},
+			},
+		},
+		{
+			name: "Invalid enum value",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Data.RequiresImplementation = "SOME"
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/data/requiresImplementation", Message: `"SOME" is not a valid ArtCV3DataRequiresImplementation value`},
+			},
+		},
+		{
+			name: "Empty mandatory field in array element",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Data.FileInformation = []ArtCV3DataFileInformation{{Name: "a.txt"}, {}}
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/data/fileInformation/1/name", Message: "mandatory field is missing"},
+			},
+		},
+		{
+			name: "Missing mandatory value of any type",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Data.CustomData = []CustomDataV1{{Key: "k"}}
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/data/customData/0/value", Message: "mandatory field is missing"},
+			},
+		},
+		{
+			name: "Invalid link target",
+			modifier: func(event *ArtifactCreatedV3) {
+				event.Links.AddByID(LinkType_Cause, "bogus")
+			},
+			expectedProblems: []FieldError{
+				{Pointer: "/links/0/target", Message: `"bogus" doesn't match the pattern "^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"`},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := NewArtifactCreatedV3()
+			require.NoError(t, err)
+			event.Data.Identity = "pkg:generic/artifact@1.0"
+			tc.modifier(event)
+
+			err = event.Validate()
+			if tc.expectedProblems == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *SchemaValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tc.expectedProblems, validationErr.Problems())
+		})
+	}
+}
+
+func TestValidateNestedStruct(t *testing.T) {
+	var link EventLinkV1
+	link.Type = "CAUSE"
+	link.Target = "bogus"
+	var validationErr *SchemaValidationError
+	require.ErrorAs(t, link.Validate(), &validationErr)
+	assert.Equal(t, "/target", validationErr.Problems()[0].Pointer)
+}
+
+func TestCheckFormat(t *testing.T) {
+	testcases := []struct {
+		format string
+		value  string
+		valid  bool
+	}{
+		{"uri", "https://example.com/path", true},
+		{"uri", "/relative/path", false},
+		{"uri-reference", "/relative/path", true},
+		{"uuid", "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0", true},
+		{"uuid", "aaaaaaaabbbb5ccc8dddeeeeeeeeeee0", false},
+		{"purl", "pkg:generic/artifact@1.0", true},
+		{"purl", "generic/artifact", false},
+		{"date-time", "2006-01-02T15:04:05Z", true},
+		{"date-time", "yesterday", false},
+		{"unknown-format", "anything", true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.format+" "+tc.value, func(t *testing.T) {
+			var problems []FieldError
+			checkFormat(&problems, "/field", tc.value, tc.format)
+			assert.Equal(t, tc.valid, len(problems) == 0)
+		})
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	var problems []FieldError
+	checkLength(&problems, "/short", "åäö", 4, -1)
+	checkLength(&problems, "/ok", "åäö", 3, 3)
+	checkItemCount(&problems, "/long", 3, -1, 2)
+	checkItemCount(&problems, "/unlimited", 100, -1, -1)
+	assert.Equal(t, []FieldError{
+		{Pointer: "/short", Message: "string length must be at least 4"},
+		{Pointer: "/long", Message: "array must have at most 2 item(s)"},
+	}, problems)
+}
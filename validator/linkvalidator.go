@@ -0,0 +1,163 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go"
+)
+
+// LinkValidator validates the links of an event against the link rules
+// declared in the event's definition, i.e. it checks that required links
+// are present, that link types that may only occur once don't occur multiple
+// times, that only link types known to the event type are used, and that
+// the event doesn't link to itself.
+//
+// The validator can't check the types of the link targets since that would
+// require access to the target events.
+type LinkValidator struct{}
+
+// NewLinkValidator returns a new LinkValidator.
+func NewLinkValidator() *LinkValidator {
+	return &LinkValidator{}
+}
+
+// Validate checks the links of the given event. Events whose type and version
+// don't have any known link rules pass the validation without any checks.
+func (lv *LinkValidator) Validate(ctx context.Context, event []byte) error {
+	var (
+		typ     string
+		version string
+		id      string
+	)
+	fields := gjson.GetManyBytes(event, "meta.type", "meta.version", "meta.id", "links")
+	if fields[0].Type == gjson.String {
+		typ = fields[0].String()
+	}
+	if typ == "" {
+		return fmt.Errorf("missing or invalid contents of meta.type field: %q", typ)
+	}
+	if fields[1].Type == gjson.String {
+		version = fields[1].String()
+	}
+	if version == "" {
+		return fmt.Errorf("missing or invalid contents of meta.version field: %q", version)
+	}
+	if fields[2].Type == gjson.String {
+		id = fields[2].String()
+	}
+
+	rules, err := eiffelevents.LinkRules(typ, version)
+	if err != nil {
+		// We don't know anything about this event so there's nothing to validate.
+		return nil
+	}
+
+	var problems []LinkProblem
+	linkCounts := make(map[eiffelevents.LinkType]int)
+	for i, link := range fields[3].Array() {
+		linkType := eiffelevents.LinkType(link.Get("type").String())
+		linkCounts[linkType]++
+		if _, ok := eiffelevents.FindLinkRule(typ, version, linkType); !ok {
+			problems = append(problems, LinkProblem{
+				Index:    i,
+				LinkType: linkType,
+				Message:  fmt.Sprintf("link type %q isn't allowed in %s %s", linkType, typ, version),
+			})
+		}
+		if target := link.Get("target").String(); id != "" && target == id {
+			problems = append(problems, LinkProblem{
+				Index:    i,
+				LinkType: linkType,
+				Message:  fmt.Sprintf("link of type %q points to the event itself", linkType),
+			})
+		}
+	}
+	for _, rule := range rules {
+		count := linkCounts[rule.Type]
+		if rule.Required && count == 0 {
+			problems = append(problems, LinkProblem{
+				Index:    -1,
+				LinkType: rule.Type,
+				Message:  fmt.Sprintf("required link of type %q is missing", rule.Type),
+			})
+		}
+		if !rule.Multiple && count > 1 {
+			problems = append(problems, LinkProblem{
+				Index:    -1,
+				LinkType: rule.Type,
+				Message:  fmt.Sprintf("link type %q may only occur once but occurs %d times", rule.Type, count),
+			})
+		}
+	}
+	if len(problems) > 0 {
+		return &LinkValidationError{problems: problems}
+	}
+	return nil
+}
+
+// LinkProblem describes a single problem found by LinkValidator.
+type LinkProblem struct {
+	// Index is the index of the offending link in the links array,
+	// or -1 if the problem doesn't concern a particular link
+	// (e.g. a missing link).
+	Index int
+
+	// LinkType is the type of the link that the problem concerns.
+	LinkType eiffelevents.LinkType
+
+	// Message is a human-readable description of the problem.
+	Message string
+}
+
+func (lp LinkProblem) String() string {
+	if lp.Index >= 0 {
+		return fmt.Sprintf("links.%d: %s", lp.Index, lp.Message)
+	}
+	return "links: " + lp.Message
+}
+
+// LinkValidationError indicates that the event's links didn't adhere
+// to the link rules of the event type.
+type LinkValidationError struct {
+	problems []LinkProblem
+}
+
+// Problems returns all problems that were found during the validation.
+func (ve *LinkValidationError) Problems() []LinkProblem {
+	return ve.problems
+}
+
+func (ve *LinkValidationError) Error() string {
+	var s strings.Builder
+	s.WriteString("The event failed the link validation with the following error(s):")
+	for _, p := range ve.problems {
+		s.WriteByte('\n')
+		s.WriteString(p.String())
+	}
+	return s.String()
+}
+
+func (ve *LinkValidationError) Is(target error) bool {
+	_, ok := target.(*LinkValidationError)
+	return ok
+}
@@ -0,0 +1,95 @@
+// Copyright Axis Communications AB.
+//
+// For a full list of individual contributors, please see the commit history.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkValidator(t *testing.T) {
+	testcases := []struct {
+		name             string
+		event            string
+		expectedProblems []LinkProblem
+	}{
+		{
+			name: "Valid links",
+			event: `{"meta": {"type": "EiffelActivityFinishedEvent", "version": "3.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"},
+				"links": [
+					{"type": "ACTIVITY_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"},
+					{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"},
+					{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee3"}
+				]}`,
+		},
+		{
+			name:  "Missing required link",
+			event: `{"meta": {"type": "EiffelActivityFinishedEvent", "version": "3.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"}, "links": []}`,
+			expectedProblems: []LinkProblem{
+				{Index: -1, LinkType: "ACTIVITY_EXECUTION", Message: `required link of type "ACTIVITY_EXECUTION" is missing`},
+			},
+		},
+		{
+			name: "Single link type occurring multiple times",
+			event: `{"meta": {"type": "EiffelTestCaseFinishedEvent", "version": "3.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"},
+				"links": [
+					{"type": "TEST_CASE_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"},
+					{"type": "TEST_CASE_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"}
+				]}`,
+			expectedProblems: []LinkProblem{
+				{Index: -1, LinkType: "TEST_CASE_EXECUTION", Message: `link type "TEST_CASE_EXECUTION" may only occur once but occurs 2 times`},
+			},
+		},
+		{
+			name: "Unknown link type and self-link",
+			event: `{"meta": {"type": "EiffelActivityFinishedEvent", "version": "3.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"},
+				"links": [
+					{"type": "ACTIVITY_EXECUTION", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee1"},
+					{"type": "BOGUS", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee2"},
+					{"type": "CAUSE", "target": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"}
+				]}`,
+			expectedProblems: []LinkProblem{
+				{Index: 1, LinkType: "BOGUS", Message: `link type "BOGUS" isn't allowed in EiffelActivityFinishedEvent 3.0.0`},
+				{Index: 2, LinkType: "CAUSE", Message: `link of type "CAUSE" points to the event itself`},
+			},
+		},
+		{
+			name:  "Unknown event type is ignored",
+			event: `{"meta": {"type": "EiffelBogusEvent", "version": "1.0.0", "id": "aaaaaaaa-bbbb-5ccc-8ddd-eeeeeeeeeee0"}, "links": []}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewLinkValidator().Validate(t.Context(), []byte(tc.event))
+			if tc.expectedProblems == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, &LinkValidationError{})
+			var lve *LinkValidationError
+			require.ErrorAs(t, err, &lve)
+			assert.Equal(t, tc.expectedProblems, lve.Problems())
+		})
+	}
+}
+
+func TestLinkValidatorInvalidMeta(t *testing.T) {
+	err := NewLinkValidator().Validate(t.Context(), []byte(`{"meta": {"version": "1.0.0"}}`))
+	assert.ErrorContains(t, err, "meta.type")
+}
@@ -26,6 +26,8 @@ import (
 
 	"github.com/tidwall/gjson"
 	"github.com/xeipuuv/gojsonschema"
+
+	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go"
 )
 
 var ErrSchemaMissing = errors.New("no schema found by any of the configured schema locators")
@@ -94,7 +96,7 @@ func (sv *SchemaValidator) Validate(ctx context.Context, event []byte) error {
 		return fmt.Errorf("error validating event: %w", err)
 	}
 	if !result.Valid() {
-		return &SchemaValidationError{errors: result.Errors()}
+		return newSchemaValidationError(result.Errors())
 	}
 	return nil
 }
@@ -144,21 +146,37 @@ func (sv *SchemaValidator) getSchema(ctx context.Context, eventType string, vers
 }
 
 // SchemaValidationError indicates that the event failed validation against the JSON schema.
-type SchemaValidationError struct {
-	errors []gojsonschema.ResultError
-}
-
-func (ve *SchemaValidationError) Error() string {
-	var s strings.Builder
-	s.WriteString("The event failed the schema validation with the following error(s):")
-	for _, re := range ve.errors {
-		s.WriteByte('\n')
-		s.WriteString(re.String())
+// It's the same type as the one returned by the Validate methods of the event structs.
+type SchemaValidationError = eiffelevents.SchemaValidationError
+
+// newSchemaValidationError converts the errors reported by gojsonschema
+// into a SchemaValidationError.
+func newSchemaValidationError(resultErrors []gojsonschema.ResultError) *SchemaValidationError {
+	problems := make([]eiffelevents.FieldError, 0, len(resultErrors))
+	for _, re := range resultErrors {
+		problems = append(problems, eiffelevents.FieldError{
+			Pointer: resultErrorPointer(re),
+			Message: re.Description(),
+		})
 	}
-	return s.String()
+	return eiffelevents.NewSchemaValidationError(problems)
 }
 
-func (ve *SchemaValidationError) Is(target error) bool {
-	_, ok := target.(*SchemaValidationError)
-	return ok
+// resultErrorPointer returns the location of a gojsonschema.ResultError
+// as an RFC 6901 JSON pointer. Errors about missing properties are
+// reported by gojsonschema for the parent object, but the returned
+// pointer points to the missing property itself.
+func resultErrorPointer(re gojsonschema.ResultError) string {
+	const sep = "\x00"
+	// The first token is always "(root)".
+	tokens := strings.Split(re.Context().String(sep), sep)[1:]
+	if property, ok := re.Details()["property"].(string); ok && re.Type() == "required" {
+		tokens = append(tokens, property)
+	}
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return sb.String()
 }
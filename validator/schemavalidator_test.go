@@ -22,12 +22,15 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/sjson"
 
+	eiffeleventsroot "github.com/eiffel-community/eiffelevents-sdk-go"
 	eiffelevents "github.com/eiffel-community/eiffelevents-sdk-go/editions/lyon"
 )
 
@@ -109,6 +112,107 @@ func TestSchemaValidator(t *testing.T) {
 	}
 }
 
+func TestSchemaValidatorMatchesValidateMethod(t *testing.T) {
+	event, err := eiffelevents.NewCompositionDefined()
+	require.NoError(t, err)
+	event.Data.Name = "name-of-composition"
+	event.Meta.ID = "not-a-uuid"
+
+	var structErr *SchemaValidationError
+	require.ErrorAs(t, event.Validate(), &structErr)
+	var schemaErr *SchemaValidationError
+	require.ErrorAs(t, NewSchemaValidator(NewBundledSchemaLocator()).Validate(t.Context(), []byte(event.String())), &schemaErr)
+
+	require.Len(t, structErr.Problems(), 1)
+	require.Len(t, schemaErr.Problems(), 1)
+	assert.Equal(t, "/meta/id", structErr.Problems()[0].Pointer)
+	assert.Equal(t, structErr.Problems()[0].Pointer, schemaErr.Problems()[0].Pointer)
+}
+
+// TestSchemaValidatorAgreesWithValidateMethod checks that the Validate
+// method of the event structs reports problems at the same locations as
+// the schema validator for the same invalid events, in particular for
+// mandatory fields that are missing in nested objects and array elements.
+func TestSchemaValidatorAgreesWithValidateMethod(t *testing.T) {
+	testcases := []struct {
+		name             string
+		edit             func(event []byte) ([]byte, error)
+		expectedPointers []string
+	}{
+		{
+			name: "Valid event",
+			edit: func(event []byte) ([]byte, error) { return event, nil },
+		},
+		{
+			name: "Missing mandatory string with pattern",
+			edit: func(event []byte) ([]byte, error) {
+				return sjson.DeleteBytes(event, "data.identity")
+			},
+			expectedPointers: []string{"/data/identity"},
+		},
+		{
+			name: "Missing mandatory string without constraints in array element",
+			edit: func(event []byte) ([]byte, error) {
+				return sjson.SetRawBytes(event, "data.fileInformation", []byte(`[{"name": "a.txt"}, {}]`))
+			},
+			expectedPointers: []string{"/data/fileInformation/1/name"},
+		},
+		{
+			name: "Missing mandatory strings in link",
+			edit: func(event []byte) ([]byte, error) {
+				return sjson.SetRawBytes(event, "links", []byte(`[{}]`))
+			},
+			expectedPointers: []string{"/links/0/target", "/links/0/type"},
+		},
+		{
+			name: "Missing mandatory value of any type in array element",
+			edit: func(event []byte) ([]byte, error) {
+				return sjson.SetRawBytes(event, "data.customData", []byte(`[{"key": "k"}]`))
+			},
+			expectedPointers: []string{"/data/customData/0/value"},
+		},
+		{
+			name: "Invalid optional enum",
+			edit: func(event []byte) ([]byte, error) {
+				return sjson.SetBytes(event, "data.requiresImplementation", "SOME")
+			},
+			expectedPointers: []string{"/data/requiresImplementation"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := eiffelevents.NewArtifactCreated()
+			require.NoError(t, err)
+			event.Data.Identity = "pkg:generic/artifact@1.0"
+			eventJSON, err := tc.edit([]byte(event.String()))
+			require.NoError(t, err)
+
+			var structPointers []string
+			parsed, err := eiffeleventsroot.UnmarshalAny(eventJSON)
+			require.NoError(t, err)
+			var structErr *SchemaValidationError
+			if err := parsed.(interface{ Validate() error }).Validate(); err != nil {
+				require.ErrorAs(t, err, &structErr)
+				for _, problem := range structErr.Problems() {
+					structPointers = append(structPointers, problem.Pointer)
+				}
+			}
+			var schemaPointers []string
+			var schemaErr *SchemaValidationError
+			if err := NewSchemaValidator(NewBundledSchemaLocator()).Validate(t.Context(), eventJSON); err != nil {
+				require.ErrorAs(t, err, &schemaErr)
+				for _, problem := range schemaErr.Problems() {
+					schemaPointers = append(schemaPointers, problem.Pointer)
+				}
+			}
+			sort.Strings(structPointers)
+			sort.Strings(schemaPointers)
+			assert.Equal(t, tc.expectedPointers, structPointers)
+			assert.Equal(t, tc.expectedPointers, schemaPointers)
+		})
+	}
+}
+
 // fixedSchemaLocator returns a fixed string as the schema every time it's asked.
 type fixedSchemaLocator struct {
 	schema string